@@ -8,6 +8,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
+	"github.com/spf13/cast"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
@@ -80,6 +81,9 @@ import (
 	certkeeper "github.com/certikfoundation/shentu/x/cert/keeper"
 	certtypes "github.com/certikfoundation/shentu/x/cert/types"
 	"github.com/certikfoundation/shentu/x/cvm"
+	cvmante "github.com/certikfoundation/shentu/x/cvm/ante"
+	cvmclient "github.com/certikfoundation/shentu/x/cvm/client"
+	"github.com/certikfoundation/shentu/x/cvm/client/ethrpc"
 	cvmkeeper "github.com/certikfoundation/shentu/x/cvm/keeper"
 	cvmtypes "github.com/certikfoundation/shentu/x/cvm/types"
 	distr "github.com/certikfoundation/shentu/x/distribution"
@@ -132,6 +136,9 @@ var (
 			upgradeclient.CancelProposalHandler,
 			certclient.ProposalHandler,
 			shieldclient.ProposalHandler,
+			cvmclient.ProposalHandler,
+			cvmclient.FreezeProposalHandler,
+			cvmclient.UnfreezeProposalHandler,
 		),
 		params.AppModuleBasic{},
 		slashing.AppModuleBasic{},
@@ -156,6 +163,7 @@ var (
 		oracletypes.ModuleName:         {authtypes.Burner},
 		shieldtypes.ModuleName:         {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		cvmtypes.ModuleName:            nil,
 	}
 )
 
@@ -168,6 +176,10 @@ type CertiKApp struct {
 
 	invCheckPeriod uint
 
+	// Ethereum JSON-RPC gateway of the CVM served on the API server
+	jsonRPC        bool
+	jsonRPCChainID uint64
+
 	// keys to access the substores
 	keys    map[string]*sdk.KVStoreKey
 	tkeys   map[string]*sdk.TransientStoreKey
@@ -242,6 +254,7 @@ func NewCertiKApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 
 	tks := []string{
 		paramstypes.TStoreKey,
+		cvmtypes.TStoreKey,
 	}
 	tkeys := sdk.NewTransientStoreKeys(tks...)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -253,6 +266,8 @@ func NewCertiKApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
 		invCheckPeriod:    invCheckPeriod,
+		jsonRPC:           cast.ToBool(appOpts.Get(cvm.FlagJSONRPC)),
+		jsonRPCChainID:    cast.ToUint64(appOpts.Get(cvm.FlagJSONRPCChainID)),
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
@@ -301,12 +316,16 @@ func NewCertiKApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	app.cvmKeeper = cvmkeeper.NewKeeper(
 		appCodec,
 		keys[cvmtypes.StoreKey],
+		tkeys[cvmtypes.TStoreKey],
 		app.accountKeeper,
 		app.bankKeeper,
 		app.distrKeeper,
 		&app.certKeeper,
+		&app.oracleKeeper,
+		&app.shieldKeeper,
 		&app.stakingKeeper,
 		app.GetSubspace(cvmtypes.ModuleName),
+		app.MsgServiceRouter(),
 	)
 	app.oracleKeeper = oraclekeeper.NewKeeper(
 		appCodec,
@@ -374,7 +393,8 @@ func NewCertiKApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper)).
 		AddRoute(shieldtypes.RouterKey, shield.NewShieldClaimProposalHandler(app.shieldKeeper)).
-		AddRoute(certtypes.RouterKey, cert.NewCertifierUpdateProposalHandler(app.certKeeper))
+		AddRoute(certtypes.RouterKey, cert.NewCertifierUpdateProposalHandler(app.certKeeper)).
+		AddRoute(cvmtypes.RouterKey, cvm.NewProposalHandler(app.cvmKeeper))
 
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -400,9 +420,10 @@ func NewCertiKApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	// note replicate if you do not need to test core IBC or light clients.
 	mockModule := ibcmock.NewAppModule(scopedIBCMockKeeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	// Create static IBC router, add transfer route, then set and seal it. The transfers are routed
+	// through the CVM middleware, which calls contracts on their receipt.
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, cvm.NewIBCMiddleware(transferModule, app.cvmKeeper))
 	ibcRouter.AddRoute(ibcmock.ModuleName, mockModule)
 	app.ibcKeeper.SetRouter(ibcRouter)
 
@@ -517,8 +538,8 @@ func NewCertiKApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	app.SetBeginBlocker(app.BeginBlocker)
 	// The AnteHandler handles signature verification and transaction pre-processing
 	app.SetAnteHandler(
-		ante.NewAnteHandler(
-			app.accountKeeper, app.bankKeeper, ante.DefaultSigVerificationGasConsumer,
+		cvmante.NewAnteHandler(
+			app.accountKeeper, app.bankKeeper, app.cvmKeeper, ante.DefaultSigVerificationGasConsumer,
 			encodingConfig.TxConfig.SignModeHandler(),
 		),
 	)
@@ -669,6 +690,10 @@ func (app *CertiKApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.API
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	if app.jsonRPC {
+		ethrpc.RegisterRoutes(clientCtx, apiSvr.Router, app.jsonRPCChainID)
+	}
+
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
 		RegisterSwaggerAPI(clientCtx, apiSvr.Router)
@@ -100,9 +100,21 @@ func migrateCVM(oldGenState CVMGenesisState) *cvmtypes.GenesisState {
 		}
 		newMetas[i] = newMeta
 	}
+	// Existing chains opt in to the oracle checks of the calls by governance.
+	oracleCheckParams := cvmtypes.DefaultOracleCheckParams()
+	oracleCheckParams.Enabled = false
 	return &cvmtypes.GenesisState{
-		GasRate:   oldGenState.GasRate,
-		Contracts: newContracts,
-		Metadatas: newMetas,
+		GasRate:                 oldGenState.GasRate,
+		Contracts:               newContracts,
+		Metadatas:               newMetas,
+		ReceiptParams:           cvmtypes.DefaultReceiptParams(),
+		TransactionGasLimit:     cvmtypes.DefaultTransactionGasLimit,
+		GasSchedule:             cvmtypes.DefaultGasSchedule(),
+		Forks:                   cvmtypes.DefaultForks(),
+		CertifierFreezeDuration: cvmtypes.DefaultCertifierFreezeDuration,
+		OracleCheckParams:       oracleCheckParams,
+		DispatchAllowlist:       cvmtypes.DefaultDispatchAllowlist(),
+		ScheduleBlockGasLimit:   cvmtypes.DefaultScheduleBlockGasLimit,
+		NextScheduledCallId:     1,
 	}
 }
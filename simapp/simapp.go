@@ -85,6 +85,9 @@ import (
 	certkeeper "github.com/certikfoundation/shentu/x/cert/keeper"
 	certtypes "github.com/certikfoundation/shentu/x/cert/types"
 	"github.com/certikfoundation/shentu/x/cvm"
+	cvmante "github.com/certikfoundation/shentu/x/cvm/ante"
+	cvmclient "github.com/certikfoundation/shentu/x/cvm/client"
+	"github.com/certikfoundation/shentu/x/cvm/client/ethrpc"
 	cvmkeeper "github.com/certikfoundation/shentu/x/cvm/keeper"
 	cvmtypes "github.com/certikfoundation/shentu/x/cvm/types"
 	distr "github.com/certikfoundation/shentu/x/distribution"
@@ -134,6 +137,9 @@ var (
 			upgradeclient.CancelProposalHandler,
 			certclient.ProposalHandler,
 			shieldclient.ProposalHandler,
+			cvmclient.ProposalHandler,
+			cvmclient.FreezeProposalHandler,
+			cvmclient.UnfreezeProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -158,6 +164,7 @@ var (
 		oracletypes.ModuleName:         {authtypes.Burner},
 		shieldtypes.ModuleName:         {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		cvmtypes.ModuleName:            nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -180,6 +187,10 @@ type SimApp struct {
 
 	invCheckPeriod uint
 
+	// Ethereum JSON-RPC gateway of the CVM served on the API server
+	jsonRPC        bool
+	jsonRPCChainID uint64
+
 	// keys to access the substores
 	keys    map[string]*sdk.KVStoreKey
 	tkeys   map[string]*sdk.TransientStoreKey
@@ -257,6 +268,7 @@ func NewSimApp(
 
 	tks := []string{
 		paramstypes.TStoreKey,
+		cvmtypes.TStoreKey,
 	}
 
 	tkeys := sdk.NewTransientStoreKeys(tks...)
@@ -269,6 +281,8 @@ func NewSimApp(
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
 		invCheckPeriod:    invCheckPeriod,
+		jsonRPC:           cast.ToBool(appOpts.Get(cvm.FlagJSONRPC)),
+		jsonRPCChainID:    cast.ToUint64(appOpts.Get(cvm.FlagJSONRPCChainID)),
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
@@ -317,12 +331,16 @@ func NewSimApp(
 	app.CVMKeeper = cvmkeeper.NewKeeper(
 		appCodec,
 		keys[cvmtypes.StoreKey],
+		tkeys[cvmtypes.TStoreKey],
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.DistrKeeper,
 		&app.CertKeeper,
+		&app.OracleKeeper,
+		&app.ShieldKeeper,
 		&app.StakingKeeper,
 		app.GetSubspace(cvmtypes.ModuleName),
+		app.MsgServiceRouter(),
 	)
 	app.OracleKeeper = oraclekeeper.NewKeeper(
 		appCodec,
@@ -396,7 +414,8 @@ func NewSimApp(
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(shieldtypes.RouterKey, shield.NewShieldClaimProposalHandler(app.ShieldKeeper)).
-		AddRoute(certtypes.RouterKey, cert.NewCertifierUpdateProposalHandler(app.CertKeeper))
+		AddRoute(certtypes.RouterKey, cert.NewCertifierUpdateProposalHandler(app.CertKeeper)).
+		AddRoute(cvmtypes.RouterKey, cvm.NewProposalHandler(app.CVMKeeper))
 
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -422,9 +441,10 @@ func NewSimApp(
 	// note replicate if you do not need to test core IBC or light clients.
 	mockModule := ibcmock.NewAppModule(scopedIBCMockKeeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	// Create static IBC router, add transfer route, then set and seal it. The transfers are routed
+	// through the CVM middleware, which calls contracts on their receipt.
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, cvm.NewIBCMiddleware(transferModule, app.CVMKeeper))
 	ibcRouter.AddRoute(ibcmock.ModuleName, mockModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -460,6 +480,7 @@ func NewSimApp(
 		cert.NewAppModule(app.CertKeeper, app.AccountKeeper, app.BankKeeper),
 		oracle.NewAppModule(app.OracleKeeper, app.BankKeeper),
 		shield.NewAppModule(app.ShieldKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
+		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
 	)
 
@@ -467,7 +488,7 @@ func NewSimApp(
 	// there is nothing left over in the validator fee pool, so as to
 	// keep the CanWithdrawInvariant invariant.
 	app.mm.SetOrderBeginBlockers(upgradetypes.ModuleName, sdkminttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName, evidencetypes.ModuleName,
-		oracletypes.ModuleName, cvmtypes.ModuleName, shieldtypes.ModuleName)
+		oracletypes.ModuleName, cvmtypes.ModuleName, stakingtypes.ModuleName, shieldtypes.ModuleName, ibchost.ModuleName)
 
 	// NOTE: Shield endblocker comes before staking because it queries
 	// unbonding delegations that staking endblocker deletes.
@@ -476,6 +497,7 @@ func NewSimApp(
 	// NOTE: genutil moodule must occur after staking so that pools
 	// are properly initialized with tokens from genesis accounts.
 	app.mm.SetOrderInitGenesis(
+		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
 		distrtypes.ModuleName,
 		stakingtypes.ModuleName,
@@ -487,9 +509,11 @@ func NewSimApp(
 		shieldtypes.ModuleName,
 		crisistypes.ModuleName,
 		certtypes.ModuleName,
+		ibchost.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		oracletypes.ModuleName,
+		ibctransfertypes.ModuleName,
 	)
 
 	app.mm.SetOrderExportGenesis(
@@ -538,8 +562,8 @@ func NewSimApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	// The AnteHandler handles signature verification and transaction pre-processing
 	app.SetAnteHandler(
-		ante.NewAnteHandler(
-			app.AccountKeeper, app.BankKeeper, ante.DefaultSigVerificationGasConsumer,
+		cvmante.NewAnteHandler(
+			app.AccountKeeper, app.BankKeeper, app.CVMKeeper, ante.DefaultSigVerificationGasConsumer,
 			encodingConfig.TxConfig.SignModeHandler(),
 		),
 	)
@@ -700,6 +724,10 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	if app.jsonRPC {
+		ethrpc.RegisterRoutes(clientCtx, apiSvr.Router, app.jsonRPCChainID)
+	}
+
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
 		RegisterSwaggerAPI(clientCtx, apiSvr.Router)
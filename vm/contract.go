@@ -29,7 +29,50 @@ type CVMContract struct {
 
 // Call executes the CVM contract call with the given state of the blockchain and parameters.
 func (c *CVMContract) Call(state engine.State, params engine.CallParams) ([]byte, error) {
-	return engine.Call(state, params, c.execute)
+	// The accesses and the logs of a failed call are discarded with its call frame.
+	snapshot := c.access.snapshot()
+	logs := &logBuffer{EventSink: state.EventSink}
+	state.EventSink = logs
+	output, err := c.call(state, params)
+	if err != nil {
+		c.access.revertTo(snapshot)
+		return output, err
+	}
+	return output, logs.flush()
+}
+
+// logBuffer holds the logs of a call frame, which are passed to the event sink of the parent frame
+// only when the frame succeeds. Call events are passed through.
+type logBuffer struct {
+	exec.EventSink
+	logs []*exec.LogEvent
+}
+
+func (b *logBuffer) Log(log *exec.LogEvent) error {
+	b.logs = append(b.logs, log)
+	return nil
+}
+
+// flush passes the logs held to the event sink of the parent frame.
+func (b *logBuffer) flush() error {
+	for _, log := range b.logs {
+		if err := b.EventSink.Log(log); err != nil {
+			return err
+		}
+	}
+	b.logs = nil
+	return nil
+}
+
+func (c *CVMContract) call(state engine.State, params engine.CallParams) ([]byte, error) {
+	if c.tracer == nil {
+		return engine.Call(state, params, c.execute)
+	}
+	return engine.Call(state, params, func(st engine.State, params engine.CallParams) ([]byte, error) {
+		output, err := c.execute(st, params)
+		c.tracer.CaptureExit(int(st.CallFrame.CallStackDepth())+1, output, err)
+		return output, err
+	})
 }
 
 // execute executes the EVM code passed in the appropriate context.
@@ -58,6 +101,9 @@ func (c *CVMContract) execute(st engine.State, params engine.CallParams) ([]byte
 	stack := NewStack(maybe, c.options.DataStackInitialCapacity, c.options.DataStackMaxDepth, params.Gas)
 	memory := c.options.MemoryProvider(maybe)
 	gasMem := memory.(gasMemory)
+	if c.tracer != nil {
+		memory = tracingMemory{Memory: memory, tracer: c.tracer}
+	}
 
 	// TODO: enable refund
 	//defer func() {
@@ -78,7 +124,24 @@ func (c *CVMContract) execute(st engine.State, params engine.CallParams) ([]byte
 		// CVM GAS CONSUMPTION
 		// Look up an instruction's gas cost in op_table and consumes gas using useGasNegative() function.
 		// An instruction can have either static gas or dynamic gas.
-		gaserr := engine.UseGasNegative(params.Gas, gasLookUp(op, *st.CallFrame, params.Callee, stack, maybe, &gasMem))
+		gas := params.Gas.Uint64()
+		if op == SSTORE && c.rules.IsIstanbul && gas <= c.gasSchedule.SstoreSentry {
+			// SSTORE cannot be run with the gas stipend of a call (EIP-2200).
+			return nil, errors.Codes.InsufficientGas
+		}
+		gasCost := gasLookUp(c.instructions, op, *st.CallFrame, params.Callee, stack, maybe, &gasMem)
+		if c.tracer != nil {
+			c.tracer.CaptureStep(Step{
+				Pc:      pc,
+				Op:      op,
+				Gas:     gas,
+				GasCost: gasCost,
+				Depth:   int(st.CallFrame.CallStackDepth()) + 1,
+				Stack:   stackItems(stack, params.Gas),
+				Address: params.Callee,
+			})
+		}
+		gaserr := engine.UseGasNegative(params.Gas, gasCost)
 		if gaserr != nil {
 			return nil, gaserr
 		}
@@ -509,6 +572,16 @@ func (c *CVMContract) execute(st engine.State, params engine.CallParams) ([]byte
 			stack.PushBigInt(id)
 			c.debugf(" => %X\n", id)
 
+		case SELFBALANCE: // 0x47
+			if !c.rules.IsIstanbul {
+				c.debugf("(pc) %-3v Unknown opcode %v\n", pc, op)
+				maybe.PushError(errors.Errorf(errors.Codes.Generic, "unknown opcode %v", op))
+				return nil, maybe.Error()
+			}
+			balance := engine.MustGetAccount(st.CallFrame, maybe, params.Callee).Balance
+			stack.Push64(balance)
+			c.debugf(" => %v (%v)\n", balance, params.Callee)
+
 		case POP: // 0x50
 			popped := stack.Pop()
 			c.debugf(" => 0x%v\n", popped)
@@ -540,7 +613,9 @@ func (c *CVMContract) execute(st engine.State, params engine.CallParams) ([]byte
 		case SSTORE: // 0x55
 			loc, data := stack.Pop(), stack.Pop()
 			maybe.PushError(engine.UseGasNegative(params.Gas, engine.GasStorageUpdate))
-			maybe.PushError(st.CallFrame.SetStorage(params.Callee, loc, data.Bytes()))
+			if !maybe.PushError(st.CallFrame.SetStorage(params.Callee, loc, data.Bytes())) && c.tracer != nil {
+				c.tracer.CaptureStorageWrite(params.Callee, loc, data.Bytes())
+			}
 			c.debugf("%v {%v := %v}\n", params.Callee, loc, data)
 
 		case JUMP: // 0x56
@@ -631,6 +706,9 @@ func (c *CVMContract) execute(st engine.State, params engine.CallParams) ([]byte
 				code := engine.MustGetAccount(st.CallFrame, maybe, params.Callee).EVMCode
 				newAccountAddress = crypto.NewContractAddress2(params.Callee, salt, code)
 			}
+			if c.rules.IsBerlin {
+				c.access.addAddress(newAccountAddress)
+			}
 
 			// Check the CreateContract permission for this account
 			if maybe.PushError(engine.EnsurePermission(st.CallFrame, params.Callee, permission.CreateContract)) {
@@ -644,6 +722,7 @@ func (c *CVMContract) execute(st engine.State, params engine.CallParams) ([]byte
 
 			// Run the input to get the contract code.
 			// NOTE: no need to copy 'input' as per Call contract.
+			nativeEffects := c.nativeEffectCount()
 			ret, callErr := c.Contract(input).Call(
 				engine.State{
 					CallFrame:  childCallFrame,
@@ -658,6 +737,11 @@ func (c *CVMContract) execute(st engine.State, params engine.CallParams) ([]byte
 					Value:  *contractValue,
 					Gas:    params.Gas,
 				})
+			if callErr != nil && c.nativeEffectCount() != nativeEffects {
+				// Natives changed state outside of the discarded call frame, fail the whole execution.
+				maybe.PushError(callErr)
+				continue
+			}
 			if callErr != nil {
 				stack.Push(Zero256)
 				// Note we both set the return buffer and return the result normally in order to service the error to
@@ -696,6 +780,7 @@ func (c *CVMContract) execute(st engine.State, params engine.CallParams) ([]byte
 			c.debugf(" => %v\n", target)
 
 			var err error
+			nativeEffects := c.nativeEffectCount()
 			returnData, err = engine.CallFromSite(st, c.externalDispatcher, params, engine.CallParams{
 				CallType: callTypeFromOpCode(op),
 				Callee:   target,
@@ -714,7 +799,10 @@ func (c *CVMContract) execute(st engine.State, params engine.CallParams) ([]byte
 			}
 
 			code := errors.GetCode(err)
-			if code == errors.Codes.None || code == errors.Codes.ExecutionReverted {
+			if err != nil && c.nativeEffectCount() != nativeEffects {
+				// Natives changed state outside of the discarded call frame, fail the whole execution.
+				maybe.PushError(err)
+			} else if code == errors.Codes.None || code == errors.Codes.ExecutionReverted {
 				memory.Write(retOffset, RightPadBytes(returnData, int(retSize)))
 			} else {
 				maybe.PushError(err)
@@ -786,18 +874,19 @@ func (c *CVMContract) jump(to uint64, pc *uint64) error {
 	return nil
 }
 
-// gasLookup calculates the gas cost of a given opcode, based on the CVM state and stack values.
-func gasLookUp(op OpCode, state engine.CallFrame, addr crypto.Address, st *Stack, err *errors.Maybe, dynMem *gasMemory) uint64 {
-	gas := instructionSet[op].staticGas
-	if instructionSet[op].dynamicGas == nil {
+// gasLookup calculates the gas cost of a given opcode from the instruction set, based on the CVM state and stack values.
+func gasLookUp(instructions *[256]instruction, op OpCode, state engine.CallFrame, addr crypto.Address, st *Stack,
+	err *errors.Maybe, dynMem *gasMemory) uint64 {
+	gas := instructions[op].staticGas
+	if instructions[op].dynamicGas == nil {
 		return gas
 	}
 
 	var mem uint64
-	if instructionSet[op].memSize != 0 {
-		// memSizef := instructionSet[op].memSize
+	if instructions[op].memSize != 0 {
+		// memSizef := instructions[op].memSize
 		var of bool
-		if mem, of = calcMemSize(instructionSet[op].memSize, st); of {
+		if mem, of = calcMemSize(instructions[op].memSize, st); of {
 			err.PushError(errors.Codes.IntegerOverflow)
 		}
 
@@ -806,7 +895,7 @@ func gasLookUp(op OpCode, state engine.CallFrame, addr crypto.Address, st *Stack
 		}
 	}
 	// else mem = 0 by declaration
-	dynGas, err2 := instructionSet[op].dynamicGas(state, addr, st, dynMem, mem)
+	dynGas, err2 := instructions[op].dynamicGas(state, addr, st, dynMem, mem)
 	if err2 != nil {
 		err.PushError(err2)
 	}
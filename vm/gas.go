@@ -56,13 +56,111 @@ const (
 	SelfdestructRefundGas uint64 = 24000 // Refunded following a suicide operation.
 	MemoryGas             uint64 = 3     // Times the address of the (highest referenced byte in memory + 1). NOTE: referencing happens on read, write and in instructions such as RETURN and CALL.
 
-	ExtcodeCopyBase = 20
+	ExtcodeCopyBase uint64 = 20
+
+	// Istanbul
+	SstoreSentryGas uint64 = 2300 // Minimum gas left required by SSTORE (EIP-2200).
+
+	// Berlin
+	ColdSloadCost         uint64 = 2100 // Once per SLOAD or SSTORE of a storage slot not accessed yet (EIP-2929).
+	ColdAccountAccessCost uint64 = 2600 // Once per access of an account not accessed yet (EIP-2929).
+	WarmStorageReadCost   uint64 = 100  // Once per access of an account or storage slot already accessed (EIP-2929).
 )
 
 const (
 	uint64Length = 8
 )
 
+// GasSchedule is the gas schedule of the CVM opcodes.
+type GasSchedule struct {
+	Base                 uint64
+	VeryLow              uint64
+	Low                  uint64
+	Mid                  uint64
+	High                 uint64
+	ExtStep              uint64
+	ExtcodeSize          uint64
+	ExtcodeCopy          uint64
+	ExtcodeCopyBase      uint64
+	ExtcodeHash          uint64
+	Balance              uint64
+	Sload                uint64
+	Calls                uint64
+	Call                 uint64
+	Create               uint64
+	Selfdestruct         uint64
+	CreateBySelfdestruct uint64
+	SelfdestructRefund   uint64
+	Exp                  uint64
+	ExpByte              uint64
+	CallValueTransfer    uint64
+	CallNewAccount       uint64
+	Memory               uint64
+	QuadCoeffDiv         uint64
+	Log                  uint64
+	LogData              uint64
+	LogTopic             uint64
+	Sha3                 uint64
+	Sha3Word             uint64
+	Copy                 uint64
+	SstoreSet            uint64
+	SstoreReset          uint64
+	SstoreClear          uint64
+	SstoreRefund         uint64
+	SstoreNoop           uint64
+	Jumpdest             uint64
+	SstoreSentry         uint64
+	ColdSload            uint64
+	ColdAccountAccess    uint64
+	WarmStorageRead      uint64
+}
+
+// DefaultGasSchedule returns the gas schedule based on the Ethereum yellow paper.
+func DefaultGasSchedule() GasSchedule {
+	return GasSchedule{
+		Base:                 GasBase,
+		VeryLow:              GasVeryLow,
+		Low:                  GasLow,
+		Mid:                  GasMid,
+		High:                 GasHigh,
+		ExtStep:              GasExtStep,
+		ExtcodeSize:          GasExtcodeSize,
+		ExtcodeCopy:          GasExtcodeCopy,
+		ExtcodeCopyBase:      ExtcodeCopyBase,
+		ExtcodeHash:          GasExtcodeHash,
+		Balance:              GasBalance,
+		Sload:                GasSLoad,
+		Calls:                GasCalls,
+		Call:                 CallGas,
+		Create:               CreateGas,
+		Selfdestruct:         GasSelfdestruct,
+		CreateBySelfdestruct: GasCreateBySelfdestruct,
+		SelfdestructRefund:   SelfdestructRefundGas,
+		Exp:                  ExpGas,
+		ExpByte:              GasExpByte,
+		CallValueTransfer:    CallValueTransferGas,
+		CallNewAccount:       CallNewAccountGas,
+		Memory:               MemoryGas,
+		QuadCoeffDiv:         QuadCoeffDiv,
+		Log:                  LogGas,
+		LogData:              LogDataGas,
+		LogTopic:             LogTopicGas,
+		Sha3:                 Sha3Gas,
+		Sha3Word:             Sha3WordGas,
+		Copy:                 CopyGas,
+		SstoreSet:            SstoreSetGas,
+		SstoreReset:          SstoreResetGas,
+		SstoreClear:          SstoreClearGas,
+		SstoreRefund:         SstoreRefundGas,
+		SstoreNoop:           NetSstoreNoopGas,
+		Jumpdest:             JumpdestGas,
+		SstoreSentry:         SstoreSentryGas,
+		ColdSload:            ColdSloadCost,
+		ColdAccountAccess:    ColdAccountAccessCost,
+		WarmStorageRead:      WarmStorageReadCost,
+	}
+}
+
 var errGasUintOverflow = errors.New("gas uint64 overflow")
 
 // gasMemory is a custom memory type, with track of last gas cost and refund counter.
@@ -74,7 +172,7 @@ type gasMemory struct {
 
 // memGasCost calculates the additional gas cost based on memory usage.
 // It is reused in multiple of the subsequent functions.
-func memGasCost(mem *gasMemory, newMemSize uint64) (uint64, error) {
+func (gs *GasSchedule) memGasCost(mem *gasMemory, newMemSize uint64) (uint64, error) {
 	if newMemSize == 0 {
 		return 0, nil
 	}
@@ -93,8 +191,8 @@ func memGasCost(mem *gasMemory, newMemSize uint64) (uint64, error) {
 		return 0, nil
 	}
 	square := newMemSizeWords * newMemSizeWords
-	linCoef := newMemSizeWords * MemoryGas
-	quadCoef := square / QuadCoeffDiv
+	linCoef := newMemSizeWords * gs.Memory
+	quadCoef := square / gs.QuadCoeffDiv
 	newTotalFee := linCoef + quadCoef
 
 	fee := newTotalFee - mem.lastGasCost
@@ -104,8 +202,8 @@ func memGasCost(mem *gasMemory, newMemSize uint64) (uint64, error) {
 }
 
 // memoryGas handles all simple dynamic gas calculation that depends on memory size of the operation.
-func memoryGas(mem *gasMemory, memorySize uint64, gasAdd uint64) (uint64, error) {
-	gas, err := memGasCost(mem, memorySize)
+func (gs *GasSchedule) memoryGas(mem *gasMemory, memorySize uint64, gasAdd uint64) (uint64, error) {
+	gas, err := gs.memGasCost(mem, memorySize)
 	if err != nil {
 		return gas, err
 	}
@@ -119,8 +217,8 @@ func memoryGas(mem *gasMemory, memorySize uint64, gasAdd uint64) (uint64, error)
 }
 
 // copyGas handles all dynamic gas calculation that copies data.
-func copyGas(stack *Stack, mem *gasMemory, memorySize uint64, n int, gasAdd uint64) (uint64, error) {
-	gas, err := memGasCost(mem, memorySize)
+func (gs *GasSchedule) copyGas(stack *Stack, mem *gasMemory, memorySize uint64, n int, gasAdd uint64) (uint64, error) {
+	gas, err := gs.memGasCost(mem, memorySize)
 	if err != nil {
 		return 0, err
 	}
@@ -139,60 +237,41 @@ func copyGas(stack *Stack, mem *gasMemory, memorySize uint64, n int, gasAdd uint
 	return gas, nil
 }
 
-func onlyMemGasCost(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
-	return memGasCost(mem, memorySize)
+func (gs *GasSchedule) onlyMemGasCost(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
+	return gs.memGasCost(mem, memorySize)
 }
 
-func onlyMemoryGas(addGas uint64) gasFunc {
+func (gs *GasSchedule) onlyMemoryGas(addGas uint64) gasFunc {
 	return func(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
-		return memoryGas(mem, memorySize, addGas)
+		return gs.memoryGas(mem, memorySize, addGas)
 	}
 }
 
-func onlyCopyGas(stackPos int, gasBase uint64, gasAdd uint64) gasFunc {
+func (gs *GasSchedule) onlyCopyGas(stackPos int, gasBase uint64, gasAdd uint64) gasFunc {
 	return func(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
-		return copyGas(stack, mem, memorySize, stackPos, gasAdd)
+		return gs.copyGas(stack, mem, memorySize, stackPos, gasAdd)
 	}
 }
 
-var (
-	gasMLoad   = onlyMemGasCost
-	gasReturn  = onlyMemGasCost
-	gasRevert  = onlyMemGasCost
-	gasMStore8 = onlyMemGasCost
-	gasMStore  = onlyMemGasCost
-	gasCreate  = onlyMemGasCost
-
-	gasDelegateCall = onlyMemoryGas(GasCalls)
-	gasStaticCall   = onlyMemoryGas(GasCalls)
-
-	gasCreate2        = onlyCopyGas(2, CreateGas, Sha3WordGas)
-	gasCallDataCopy   = onlyCopyGas(2, GasVeryLow, CopyGas)
-	gasReturnDataCopy = onlyCopyGas(2, GasVeryLow, CopyGas)
-	gasCodeCopy       = onlyCopyGas(2, GasVeryLow, CopyGas)
-	gasExtCodeCopy    = onlyCopyGas(3, GasExtcodeCopy, CopyGas)
-	gasSha3           = onlyCopyGas(1, Sha3Gas, Sha3WordGas)
-)
-
-func makeGasLog(n uint64) gasFunc {
+func (gs *GasSchedule) makeGasLog(n uint64) gasFunc {
 	return func(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
 		requestedSize, of := bigToUint64(Get(stack, 1))
 		if of {
 			return 0, errGasUintOverflow
 		}
 
-		gas, err := memGasCost(mem, memorySize)
+		gas, err := gs.memGasCost(mem, memorySize)
 		if err != nil {
 			return 0, err
 		}
-		if gas, of = SafeAdd(gas, LogGas); of {
+		if gas, of = SafeAdd(gas, gs.Log); of {
 			return 0, errGasUintOverflow
 		}
-		if gas, of = SafeAdd(gas, n*LogTopicGas); of {
+		if gas, of = SafeAdd(gas, n*gs.LogTopic); of {
 			return 0, errGasUintOverflow
 		}
 
-		memorySizeGas, of := SafeMul(requestedSize, LogDataGas)
+		memorySizeGas, of := SafeMul(requestedSize, gs.LogData)
 		if of {
 			return 0, errGasUintOverflow
 		}
@@ -203,47 +282,54 @@ func makeGasLog(n uint64) gasFunc {
 	}
 }
 
-func gasExp(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
+func (gs *GasSchedule) gasExp(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
 	expByteLen := uint64(len(Get(stack, 1).Bytes()))
-	gas := expByteLen * GasExpByte // no overflow check required. Max is 256 * GasExpByte gas
+	gas := expByteLen * gs.ExpByte // no overflow check required. Max is 256 * ExpByte gas
 
-	gas, of := SafeAdd(gas, ExpGas)
+	gas, of := SafeAdd(gas, gs.Exp)
 	if of {
 		return 0, errGasUintOverflow
 	}
 	return gas, nil
 }
 
-func gasCall(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
-	var (
-		gas            = GasCalls
-		transfersValue = Get(stack, 2).Sign() != 0
-		address2       = crypto.AddressFromWord256(GetWord256(stack, 1))
-		// eip158         = vm.ChainConfig().IsEIP158(vm.BlockNumber)
-	)
-	acc, _ := st.GetAccount(address2)
-	if transfersValue && acc == nil {
-		gas += CallNewAccountGas
-	}
-	if transfersValue {
-		gas += CallValueTransferGas
+func (gs *GasSchedule) makeGasCall(base uint64) gasFunc {
+	return func(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
+		var (
+			gas            = base
+			transfersValue = Get(stack, 2).Sign() != 0
+			address2       = crypto.AddressFromWord256(GetWord256(stack, 1))
+			// eip158         = vm.ChainConfig().IsEIP158(vm.BlockNumber)
+		)
+		acc, _ := st.GetAccount(address2)
+		if transfersValue && acc == nil {
+			gas += gs.CallNewAccount
+		}
+		if transfersValue {
+			gas += gs.CallValueTransfer
+		}
+		return gs.memoryGas(mem, memorySize, gas)
 	}
-	return memoryGas(mem, memorySize, gas)
 }
 
-func gasCallCode(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
-	gas := GasCalls
-	if Get(stack, 2).Sign() != 0 {
-		gas += CallValueTransferGas
+func (gs *GasSchedule) makeGasCallCode(base uint64) gasFunc {
+	return func(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
+		gas := base
+		if Get(stack, 2).Sign() != 0 {
+			gas += gs.CallValueTransfer
+		}
+		return gs.memoryGas(mem, memorySize, gas)
 	}
-	return memoryGas(mem, memorySize, gas)
 }
 
-func gasSStore(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
+func (gs *GasSchedule) gasSStore(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
 	var (
 		x, y       = GetWord256(stack, 0), GetWord256(stack, 1)
 		current, _ = st.GetStorage(address, x)
 	)
+	// Unset slots read as nil storage, so setting them is charged as a CHANGE. The net gas metering
+	// of Istanbul does not have that flaw, this one is kept so that older blocks replay identically.
+	//
 	// The SStore takes some situations of the change of the stored value's state.
 	// The resulting gas price is decided by the change of the state.
 	//	1. From a zero-value address to a non-zero value         (NEW VALUE)
@@ -253,38 +339,142 @@ func gasSStore(st engine.CallFrame, address crypto.Address, stack *Stack, mem *g
 
 	switch {
 	case bytes.Equal(current, binary.Zero256.Bytes()) && y != binary.Zero256: // 0 => non 0
-		return SstoreSetGas, nil
+		return gs.SstoreSet, nil
 	case !bytes.Equal(current, binary.Zero256.Bytes()) && y == binary.Zero256: // non 0 => 0
-		mem.refund += SstoreRefundGas
-		return SstoreClearGas, nil
+		mem.refund += gs.SstoreRefund
+		return gs.SstoreClear, nil
 
 	// Not too much complications with dirty state, but state noop added.
 	case bytes.Equal(current, y.Bytes()):
-		return NetSstoreNoopGas, nil
+		return gs.SstoreNoop, nil
 	default: // non 0 => non 0 (or 0 => 0)
-		return SstoreResetGas, nil
+		return gs.SstoreReset, nil
 	}
 }
 
 // gasSelfdestruct is called when contract self-destructs, freeing CVM memory.
 // When contract successfully kills itself, some amount of gas is refunded.
-func gasSelfdestruct(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
-	gas := GasSelfdestruct
+func (gs *GasSchedule) gasSelfdestruct(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
+	gas := gs.Selfdestruct
 
 	address2 := crypto.AddressFromWord256(GetWord256(stack, 0))
 
 	// if empty and transfers value
 	acc, err := st.GetAccount(address2)
 	if acc == nil {
-		gas += GasCreateBySelfdestruct
+		gas += gs.CreateBySelfdestruct
 	}
 	if err != nil {
 		return gas, err
 	}
 	refund := mem.refund
-	mem.refund += SelfdestructRefundGas
+	mem.refund += gs.SelfdestructRefund
 	if mem.refund < refund {
 		return gas, errGasUintOverflow
 	}
 	return gas, nil
 }
+
+// makeGasSStoreEIP2200 meters SSTORE by the original, current and new value of the slot (EIP-2200).
+// With the Berlin rules, the first access of the slot costs ColdSload on top, and the SLOAD part of
+// the costs becomes WarmStorageRead (EIP-2929).
+func (gs *GasSchedule) makeGasSStoreEIP2200(access *accessList, isBerlin bool) gasFunc {
+	return func(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
+		key, value := GetWord256(stack, 0), GetWord256(stack, 1)
+		data, err := st.GetStorage(address, key)
+		if err != nil {
+			return 0, err
+		}
+		current := binary.LeftPadWord256(data)
+		original := access.original(address, key, current)
+
+		var gas uint64
+		sload, reset := gs.Sload, gs.SstoreReset
+		if isBerlin {
+			if !access.addSlot(address, key) {
+				gas = gs.ColdSload
+			}
+			sload, reset = gs.WarmStorageRead, subGas(gs.SstoreReset, gs.ColdSload)
+		}
+
+		switch {
+		case current == value: // NOOP
+			return gas + sload, nil
+		case original == current && original == binary.Zero256: // 0 => non 0 (NEW VALUE)
+			return gas + gs.SstoreSet, nil
+		case original == current: // non 0 => non 0 or 0 (CHANGE or DELETE)
+			if value == binary.Zero256 {
+				mem.addRefund(gs.SstoreRefund)
+			}
+			return gas + reset, nil
+		}
+
+		// The slot has already been changed in the transaction (dirty).
+		if original != binary.Zero256 {
+			if current == binary.Zero256 {
+				mem.subRefund(gs.SstoreRefund)
+			} else if value == binary.Zero256 {
+				mem.addRefund(gs.SstoreRefund)
+			}
+		}
+		if original == value {
+			if original == binary.Zero256 {
+				mem.addRefund(subGas(gs.SstoreSet, sload))
+			} else {
+				mem.addRefund(subGas(reset, sload))
+			}
+		}
+		return gas + sload, nil
+	}
+}
+
+// makeGasSLoadEIP2929 charges SLOAD by whether the slot has been accessed in the transaction (EIP-2929).
+func (gs *GasSchedule) makeGasSLoadEIP2929(access *accessList) gasFunc {
+	return func(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
+		if access.addSlot(address, GetWord256(stack, 0)) {
+			return gs.WarmStorageRead, nil
+		}
+		return gs.ColdSload, nil
+	}
+}
+
+// withColdAccountAccess adds the cost to the gas of the instruction when the account at the position
+// of the stack has not been accessed in the transaction (EIP-2929).
+func withColdAccountAccess(access *accessList, n int, cost uint64, gasFn gasFunc) gasFunc {
+	return func(st engine.CallFrame, address crypto.Address, stack *Stack, mem *gasMemory, memorySize uint64) (uint64, error) {
+		var gas uint64
+		if gasFn != nil {
+			var err error
+			if gas, err = gasFn(st, address, stack, mem, memorySize); err != nil {
+				return 0, err
+			}
+		}
+		if access.addAddress(crypto.AddressFromWord256(GetWord256(stack, n))) {
+			return gas, nil
+		}
+		gas, of := SafeAdd(gas, cost)
+		if of {
+			return 0, errGasUintOverflow
+		}
+		return gas, nil
+	}
+}
+
+// addRefund adds the gas to the refund counter.
+func (mem *gasMemory) addRefund(gas uint64) {
+	mem.refund += gas
+}
+
+// subRefund removes the gas from the refund counter. The counter is kept per call frame, so the
+// gas may have been added to another one.
+func (mem *gasMemory) subRefund(gas uint64) {
+	mem.refund = subGas(mem.refund, gas)
+}
+
+// subGas subtracts the gas costs, returning zero if the schedule makes the difference negative.
+func subGas(x, y uint64) uint64 {
+	if x < y {
+		return 0
+	}
+	return x - y
+}
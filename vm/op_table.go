@@ -42,456 +42,547 @@ type instruction struct {
 	memSize uint
 }
 
-var instructionSet = [256]instruction{
-	STOP: {
-		staticGas: 0,
-	},
-	ADD: {
-		staticGas: GasVeryLow,
-	},
-	MUL: {
-		staticGas: GasLow,
-	},
-	SUB: {
-		staticGas: GasVeryLow,
-	},
-	DIV: {
-		staticGas: GasLow,
-	},
-	SDIV: {
-		staticGas: GasLow,
-	},
-	MOD: {
-		staticGas: GasLow,
-	},
-	SMOD: {
-		staticGas: GasLow,
-	},
-	ADDMOD: {
-		staticGas: GasMid,
-	},
-	MULMOD: {
-		staticGas: GasMid,
-	},
-	EXP: {
-		dynamicGas: gasExp,
-	},
-	SIGNEXTEND: {
-		staticGas: GasLow,
-	},
-	LT: {
-		staticGas: GasVeryLow,
-	},
-	GT: {
-		staticGas: GasVeryLow,
-	},
-	SLT: {
-		staticGas: GasVeryLow,
-	},
-	SGT: {
-		staticGas: GasVeryLow,
-	},
-	EQ: {
-		staticGas: GasVeryLow,
-	},
-	ISZERO: {
-		staticGas: GasVeryLow,
-	},
-	AND: {
-		staticGas: GasVeryLow,
-	},
-	XOR: {
-		staticGas: GasVeryLow,
-	},
-	OR: {
-		staticGas: GasVeryLow,
-	},
-	NOT: {
-		staticGas: GasVeryLow,
-	},
-	BYTE: {
-		staticGas: GasVeryLow,
-	},
-	SHA3: {
-		staticGas:  Sha3Gas,
-		dynamicGas: gasSha3,
-		memSize:    memorySha3,
-	},
-	ADDRESS: {
-		staticGas: GasBase,
-	},
-	BALANCE: {
-		staticGas: GasBalance,
-	},
-	ORIGIN: {
-		staticGas: GasBase,
-	},
-	CALLER: {
-		staticGas: GasBase,
-	},
-	CALLVALUE: {
-		staticGas: GasBase,
-	},
-	CALLDATALOAD: {
-		staticGas: GasVeryLow,
-	},
-	CALLDATASIZE: {
-		staticGas: GasBase,
-	},
-	CALLDATACOPY: {
-		staticGas:  GasVeryLow,
-		dynamicGas: gasCallDataCopy,
-		memSize:    memoryCallDataCopy,
-	},
-	CODESIZE: {
-		staticGas: GasBase,
-	},
-	CODECOPY: {
-		staticGas:  GasVeryLow,
-		dynamicGas: gasCodeCopy,
-		memSize:    memoryCodeCopy,
-	},
-	GASPRICE_DEPRECATED: {
-		staticGas: GasBase,
-	},
-	EXTCODESIZE: {
-		staticGas: GasExtcodeSize,
-	},
-	EXTCODECOPY: {
-		staticGas:  ExtcodeCopyBase,
-		dynamicGas: gasExtCodeCopy,
-		memSize:    memoryExtCodeCopy,
-	},
-	BLOCKHASH: {
-		staticGas: GasExtStep,
-	},
-	COINBASE: {
-		staticGas: GasBase,
-	},
-	TIMESTAMP: {
-		staticGas: GasBase,
-	},
-	BLOCKHEIGHT: {
-		staticGas: GasBase,
-	},
-	DIFFICULTY: {
-		staticGas: GasBase,
-	},
-	GASLIMIT: {
-		staticGas: GasBase,
-	},
-	POP: {
-		staticGas: GasBase,
-	},
-	MLOAD: {
-		staticGas:  GasVeryLow,
-		dynamicGas: gasMLoad,
-		memSize:    memoryMLoad,
-	},
-	MSTORE: {
-		staticGas:  GasVeryLow,
-		dynamicGas: gasMStore,
-		memSize:    memoryMStore,
-	},
-	MSTORE8: {
-		staticGas:  GasVeryLow,
-		dynamicGas: gasMStore8,
-		memSize:    memoryMStore8,
-	},
-	SLOAD: {
-		staticGas: GasSLoad,
-	},
-	SSTORE: {
-		dynamicGas: gasSStore,
-	},
-	JUMP: {
-		staticGas: GasMid,
-	},
-	JUMPI: {
-		staticGas: GasHigh,
-	},
-	PC: {
-		staticGas: GasBase,
-	},
-	MSIZE: {
-		staticGas: GasBase,
-	},
-	GAS: {
-		staticGas: GasBase,
-	},
-	JUMPDEST: {
-		staticGas: JumpdestGas,
-	},
-	PUSH1: {
-		staticGas: GasVeryLow,
-	},
-	PUSH2: {
-		staticGas: GasVeryLow,
-	},
-	PUSH3: {
-		staticGas: GasVeryLow,
-	},
-	PUSH4: {
-		staticGas: GasVeryLow,
-	},
-	PUSH5: {
-		staticGas: GasVeryLow,
-	},
-	PUSH6: {
-		staticGas: GasVeryLow,
-	},
-	PUSH7: {
-		staticGas: GasVeryLow,
-	},
-	PUSH8: {
-		staticGas: GasVeryLow,
-	},
-	PUSH9: {
-		staticGas: GasVeryLow,
-	},
-	PUSH10: {
-		staticGas: GasVeryLow,
-	},
-	PUSH11: {
-		staticGas: GasVeryLow,
-	},
-	PUSH12: {
-		staticGas: GasVeryLow,
-	},
-	PUSH13: {
-		staticGas: GasVeryLow,
-	},
-	PUSH14: {
-		staticGas: GasVeryLow,
-	},
-	PUSH15: {
-		staticGas: GasVeryLow,
-	},
-	PUSH16: {
-		staticGas: GasVeryLow,
-	},
-	PUSH17: {
-		staticGas: GasVeryLow,
-	},
-	PUSH18: {
-		staticGas: GasVeryLow,
-	},
-	PUSH19: {
-		staticGas: GasVeryLow,
-	},
-	PUSH20: {
-		staticGas: GasVeryLow,
-	},
-	PUSH21: {
-		staticGas: GasVeryLow,
-	},
-	PUSH22: {
-		staticGas: GasVeryLow,
-	},
-	PUSH23: {
-		staticGas: GasVeryLow,
-	},
-	PUSH24: {
-		staticGas: GasVeryLow,
-	},
-	PUSH25: {
-		staticGas: GasVeryLow,
-	},
-	PUSH26: {
-		staticGas: GasVeryLow,
-	},
-	PUSH27: {
-		staticGas: GasVeryLow,
-	},
-	PUSH28: {
-		staticGas: GasVeryLow,
-	},
-	PUSH29: {
-		staticGas: GasVeryLow,
-	},
-	PUSH30: {
-		staticGas: GasVeryLow,
-	},
-	PUSH31: {
-		staticGas: GasVeryLow,
-	},
-	PUSH32: {
-		staticGas: GasVeryLow,
-	},
-	DUP1: {
-		staticGas: GasVeryLow,
-	},
-	DUP2: {
-		staticGas: GasVeryLow,
-	},
-	DUP3: {
-		staticGas: GasVeryLow,
-	},
-	DUP4: {
-		staticGas: GasVeryLow,
-	},
-	DUP5: {
-		staticGas: GasVeryLow,
-	},
-	DUP6: {
-		staticGas: GasVeryLow,
-	},
-	DUP7: {
-		staticGas: GasVeryLow,
-	},
-	DUP8: {
-		staticGas: GasVeryLow,
-	},
-	DUP9: {
-		staticGas: GasVeryLow,
-	},
-	DUP10: {
-		staticGas: GasVeryLow,
-	},
-	DUP11: {
-		staticGas: GasVeryLow,
-	},
-	DUP12: {
-		staticGas: GasVeryLow,
-	},
-	DUP13: {
-		staticGas: GasVeryLow,
-	},
-	DUP14: {
-		staticGas: GasVeryLow,
-	},
-	DUP15: {
-		staticGas: GasVeryLow,
-	},
-	DUP16: {
-		staticGas: GasVeryLow,
-	},
-	SWAP1: {
-		staticGas: GasVeryLow,
-	},
-	SWAP2: {
-		staticGas: GasVeryLow,
-	},
-	SWAP3: {
-		staticGas: GasVeryLow,
-	},
-	SWAP4: {
-		staticGas: GasVeryLow,
-	},
-	SWAP5: {
-		staticGas: GasVeryLow,
-	},
-	SWAP6: {
-		staticGas: GasVeryLow,
-	},
-	SWAP7: {
-		staticGas: GasVeryLow,
-	},
-	SWAP8: {
-		staticGas: GasVeryLow,
-	},
-	SWAP9: {
-		staticGas: GasVeryLow,
-	},
-	SWAP10: {
-		staticGas: GasVeryLow,
-	},
-	SWAP11: {
-		staticGas: GasVeryLow,
-	},
-	SWAP12: {
-		staticGas: GasVeryLow,
-	},
-	SWAP13: {
-		staticGas: GasVeryLow,
-	},
-	SWAP14: {
-		staticGas: GasVeryLow,
-	},
-	SWAP15: {
-		staticGas: GasVeryLow,
-	},
-	SWAP16: {
-		staticGas: GasVeryLow,
-	},
-	LOG0: {
-		dynamicGas: makeGasLog(0),
-		memSize:    memoryLog,
-	},
-	LOG1: {
-		dynamicGas: makeGasLog(1),
-		memSize:    memoryLog,
-	},
-	LOG2: {
-		dynamicGas: makeGasLog(2),
-		memSize:    memoryLog,
-	},
-	LOG3: {
-		dynamicGas: makeGasLog(3),
-		memSize:    memoryLog,
-	},
-	LOG4: {
-		dynamicGas: makeGasLog(4),
-		memSize:    memoryLog,
-	},
-	CREATE: {
-		staticGas:  CreateGas,
-		dynamicGas: gasCreate,
-		memSize:    memoryCreate,
-	},
-	CALL: {
-		staticGas:  CallGas,
-		dynamicGas: gasCall,
-		memSize:    memoryCall,
-	},
-	CALLCODE: {
-		staticGas:  CallGas,
-		dynamicGas: gasCallCode,
-		memSize:    memoryCall,
-	},
-	RETURN: {
-		dynamicGas: gasReturn,
-		memSize:    memoryReturn,
-	},
-	SELFDESTRUCT: {
-		dynamicGas: gasSelfdestruct,
-	},
-	// Ethereum Homestead instruction set
-	DELEGATECALL: {
-		dynamicGas: gasDelegateCall,
-		memSize:    memoryDelegateCall,
-	},
-	// Ethereum Byzantium instruction set
-	STATICCALL: {
-		dynamicGas: gasStaticCall,
-		memSize:    memoryStaticCall,
-	},
-	RETURNDATASIZE: {
-		staticGas: GasBase,
-	},
-	RETURNDATACOPY: {
-		dynamicGas: gasReturnDataCopy,
-		memSize:    memoryReturnDataCopy,
-	},
-	REVERT: {
-		dynamicGas: gasRevert,
-		memSize:    memoryRevert,
-	},
-	// Ethereum Constantinople instruction set
-	SHL: {
-		staticGas: GasVeryLow,
-	},
-	SHR: {
-		staticGas: GasVeryLow,
-	},
-	SAR: {
-		staticGas: GasVeryLow,
-	},
-	EXTCODEHASH: {
-		staticGas: GasExtcodeHash,
-	},
-	CREATE2: {
-		dynamicGas: gasCreate2,
-		memSize:    memoryCreate2,
-	},
+// defaultInstructionSet is the instruction set with the default gas schedule and no upgrade rules.
+var defaultInstructionSet = func() *[256]instruction {
+	gs := DefaultGasSchedule()
+	return newInstructionSet(&gs, Rules{}, nil)
+}()
+
+// newInstructionSet returns the instruction set with the gas costs of the gas schedule and the rules.
+// The access list tracks the accesses of the transaction for the gas costs of the upgrades.
+func newInstructionSet(gs *GasSchedule, rules Rules, access *accessList) *[256]instruction {
+	var (
+		gasMLoad   = gs.onlyMemGasCost
+		gasReturn  = gs.onlyMemGasCost
+		gasRevert  = gs.onlyMemGasCost
+		gasMStore8 = gs.onlyMemGasCost
+		gasMStore  = gs.onlyMemGasCost
+		gasCreate  = gs.onlyMemGasCost
+
+		gasDelegateCall = gs.onlyMemoryGas(gs.Calls)
+		gasStaticCall   = gs.onlyMemoryGas(gs.Calls)
+
+		gasCreate2        = gs.onlyCopyGas(2, gs.Create, gs.Sha3Word)
+		gasCallDataCopy   = gs.onlyCopyGas(2, gs.VeryLow, gs.Copy)
+		gasReturnDataCopy = gs.onlyCopyGas(2, gs.VeryLow, gs.Copy)
+		gasCodeCopy       = gs.onlyCopyGas(2, gs.VeryLow, gs.Copy)
+		gasExtCodeCopy    = gs.onlyCopyGas(3, gs.ExtcodeCopy, gs.Copy)
+		gasSha3           = gs.onlyCopyGas(1, gs.Sha3, gs.Sha3Word)
+	)
+
+	instructions := &[256]instruction{
+		STOP: {
+			staticGas: 0,
+		},
+		ADD: {
+			staticGas: gs.VeryLow,
+		},
+		MUL: {
+			staticGas: gs.Low,
+		},
+		SUB: {
+			staticGas: gs.VeryLow,
+		},
+		DIV: {
+			staticGas: gs.Low,
+		},
+		SDIV: {
+			staticGas: gs.Low,
+		},
+		MOD: {
+			staticGas: gs.Low,
+		},
+		SMOD: {
+			staticGas: gs.Low,
+		},
+		ADDMOD: {
+			staticGas: gs.Mid,
+		},
+		MULMOD: {
+			staticGas: gs.Mid,
+		},
+		EXP: {
+			dynamicGas: gs.gasExp,
+		},
+		SIGNEXTEND: {
+			staticGas: gs.Low,
+		},
+		LT: {
+			staticGas: gs.VeryLow,
+		},
+		GT: {
+			staticGas: gs.VeryLow,
+		},
+		SLT: {
+			staticGas: gs.VeryLow,
+		},
+		SGT: {
+			staticGas: gs.VeryLow,
+		},
+		EQ: {
+			staticGas: gs.VeryLow,
+		},
+		ISZERO: {
+			staticGas: gs.VeryLow,
+		},
+		AND: {
+			staticGas: gs.VeryLow,
+		},
+		XOR: {
+			staticGas: gs.VeryLow,
+		},
+		OR: {
+			staticGas: gs.VeryLow,
+		},
+		NOT: {
+			staticGas: gs.VeryLow,
+		},
+		BYTE: {
+			staticGas: gs.VeryLow,
+		},
+		SHA3: {
+			staticGas:  gs.Sha3,
+			dynamicGas: gasSha3,
+			memSize:    memorySha3,
+		},
+		ADDRESS: {
+			staticGas: gs.Base,
+		},
+		BALANCE: {
+			staticGas: gs.Balance,
+		},
+		ORIGIN: {
+			staticGas: gs.Base,
+		},
+		CALLER: {
+			staticGas: gs.Base,
+		},
+		CALLVALUE: {
+			staticGas: gs.Base,
+		},
+		CALLDATALOAD: {
+			staticGas: gs.VeryLow,
+		},
+		CALLDATASIZE: {
+			staticGas: gs.Base,
+		},
+		CALLDATACOPY: {
+			staticGas:  gs.VeryLow,
+			dynamicGas: gasCallDataCopy,
+			memSize:    memoryCallDataCopy,
+		},
+		CODESIZE: {
+			staticGas: gs.Base,
+		},
+		CODECOPY: {
+			staticGas:  gs.VeryLow,
+			dynamicGas: gasCodeCopy,
+			memSize:    memoryCodeCopy,
+		},
+		GASPRICE_DEPRECATED: {
+			staticGas: gs.Base,
+		},
+		EXTCODESIZE: {
+			staticGas: gs.ExtcodeSize,
+		},
+		EXTCODECOPY: {
+			staticGas:  gs.ExtcodeCopyBase,
+			dynamicGas: gasExtCodeCopy,
+			memSize:    memoryExtCodeCopy,
+		},
+		BLOCKHASH: {
+			staticGas: gs.ExtStep,
+		},
+		COINBASE: {
+			staticGas: gs.Base,
+		},
+		TIMESTAMP: {
+			staticGas: gs.Base,
+		},
+		BLOCKHEIGHT: {
+			staticGas: gs.Base,
+		},
+		DIFFICULTY: {
+			staticGas: gs.Base,
+		},
+		GASLIMIT: {
+			staticGas: gs.Base,
+		},
+		POP: {
+			staticGas: gs.Base,
+		},
+		MLOAD: {
+			staticGas:  gs.VeryLow,
+			dynamicGas: gasMLoad,
+			memSize:    memoryMLoad,
+		},
+		MSTORE: {
+			staticGas:  gs.VeryLow,
+			dynamicGas: gasMStore,
+			memSize:    memoryMStore,
+		},
+		MSTORE8: {
+			staticGas:  gs.VeryLow,
+			dynamicGas: gasMStore8,
+			memSize:    memoryMStore8,
+		},
+		SLOAD: {
+			staticGas: gs.Sload,
+		},
+		SSTORE: {
+			dynamicGas: gs.gasSStore,
+		},
+		JUMP: {
+			staticGas: gs.Mid,
+		},
+		JUMPI: {
+			staticGas: gs.High,
+		},
+		PC: {
+			staticGas: gs.Base,
+		},
+		MSIZE: {
+			staticGas: gs.Base,
+		},
+		GAS: {
+			staticGas: gs.Base,
+		},
+		JUMPDEST: {
+			staticGas: gs.Jumpdest,
+		},
+		PUSH1: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH2: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH3: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH4: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH5: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH6: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH7: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH8: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH9: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH10: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH11: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH12: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH13: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH14: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH15: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH16: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH17: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH18: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH19: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH20: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH21: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH22: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH23: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH24: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH25: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH26: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH27: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH28: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH29: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH30: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH31: {
+			staticGas: gs.VeryLow,
+		},
+		PUSH32: {
+			staticGas: gs.VeryLow,
+		},
+		DUP1: {
+			staticGas: gs.VeryLow,
+		},
+		DUP2: {
+			staticGas: gs.VeryLow,
+		},
+		DUP3: {
+			staticGas: gs.VeryLow,
+		},
+		DUP4: {
+			staticGas: gs.VeryLow,
+		},
+		DUP5: {
+			staticGas: gs.VeryLow,
+		},
+		DUP6: {
+			staticGas: gs.VeryLow,
+		},
+		DUP7: {
+			staticGas: gs.VeryLow,
+		},
+		DUP8: {
+			staticGas: gs.VeryLow,
+		},
+		DUP9: {
+			staticGas: gs.VeryLow,
+		},
+		DUP10: {
+			staticGas: gs.VeryLow,
+		},
+		DUP11: {
+			staticGas: gs.VeryLow,
+		},
+		DUP12: {
+			staticGas: gs.VeryLow,
+		},
+		DUP13: {
+			staticGas: gs.VeryLow,
+		},
+		DUP14: {
+			staticGas: gs.VeryLow,
+		},
+		DUP15: {
+			staticGas: gs.VeryLow,
+		},
+		DUP16: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP1: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP2: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP3: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP4: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP5: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP6: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP7: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP8: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP9: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP10: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP11: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP12: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP13: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP14: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP15: {
+			staticGas: gs.VeryLow,
+		},
+		SWAP16: {
+			staticGas: gs.VeryLow,
+		},
+		LOG0: {
+			dynamicGas: gs.makeGasLog(0),
+			memSize:    memoryLog,
+		},
+		LOG1: {
+			dynamicGas: gs.makeGasLog(1),
+			memSize:    memoryLog,
+		},
+		LOG2: {
+			dynamicGas: gs.makeGasLog(2),
+			memSize:    memoryLog,
+		},
+		LOG3: {
+			dynamicGas: gs.makeGasLog(3),
+			memSize:    memoryLog,
+		},
+		LOG4: {
+			dynamicGas: gs.makeGasLog(4),
+			memSize:    memoryLog,
+		},
+		CREATE: {
+			staticGas:  gs.Create,
+			dynamicGas: gasCreate,
+			memSize:    memoryCreate,
+		},
+		CALL: {
+			staticGas:  gs.Call,
+			dynamicGas: gs.makeGasCall(gs.Calls),
+			memSize:    memoryCall,
+		},
+		CALLCODE: {
+			staticGas:  gs.Call,
+			dynamicGas: gs.makeGasCallCode(gs.Calls),
+			memSize:    memoryCall,
+		},
+		RETURN: {
+			dynamicGas: gasReturn,
+			memSize:    memoryReturn,
+		},
+		SELFDESTRUCT: {
+			dynamicGas: gs.gasSelfdestruct,
+		},
+		// Ethereum Homestead instruction set
+		DELEGATECALL: {
+			dynamicGas: gasDelegateCall,
+			memSize:    memoryDelegateCall,
+		},
+		// Ethereum Byzantium instruction set
+		STATICCALL: {
+			dynamicGas: gasStaticCall,
+			memSize:    memoryStaticCall,
+		},
+		RETURNDATASIZE: {
+			staticGas: gs.Base,
+		},
+		RETURNDATACOPY: {
+			dynamicGas: gasReturnDataCopy,
+			memSize:    memoryReturnDataCopy,
+		},
+		REVERT: {
+			dynamicGas: gasRevert,
+			memSize:    memoryRevert,
+		},
+		// Ethereum Constantinople instruction set
+		SHL: {
+			staticGas: gs.VeryLow,
+		},
+		SHR: {
+			staticGas: gs.VeryLow,
+		},
+		SAR: {
+			staticGas: gs.VeryLow,
+		},
+		EXTCODEHASH: {
+			staticGas: gs.ExtcodeHash,
+		},
+		CREATE2: {
+			dynamicGas: gasCreate2,
+			memSize:    memoryCreate2,
+		},
+	}
+
+	// Ethereum Istanbul instruction set
+	if rules.IsIstanbul {
+		instructions[CHAINID] = instruction{
+			staticGas: gs.Base,
+		}
+		instructions[SELFBALANCE] = instruction{
+			staticGas: gs.Low,
+		}
+		instructions[SSTORE] = instruction{
+			dynamicGas: gs.makeGasSStoreEIP2200(access, rules.IsBerlin),
+		}
+	}
+
+	// Ethereum Berlin instruction set
+	if rules.IsBerlin {
+		coldAccountAccess := subGas(gs.ColdAccountAccess, gs.WarmStorageRead)
+		instructions[SLOAD] = instruction{
+			dynamicGas: gs.makeGasSLoadEIP2929(access),
+		}
+		instructions[BALANCE] = instruction{
+			staticGas:  gs.WarmStorageRead,
+			dynamicGas: withColdAccountAccess(access, 0, coldAccountAccess, nil),
+		}
+		instructions[EXTCODESIZE] = instruction{
+			staticGas:  gs.WarmStorageRead,
+			dynamicGas: withColdAccountAccess(access, 0, coldAccountAccess, nil),
+		}
+		instructions[EXTCODEHASH] = instruction{
+			staticGas:  gs.WarmStorageRead,
+			dynamicGas: withColdAccountAccess(access, 0, coldAccountAccess, nil),
+		}
+		instructions[EXTCODECOPY] = instruction{
+			staticGas:  gs.WarmStorageRead,
+			dynamicGas: withColdAccountAccess(access, 0, coldAccountAccess, gasExtCodeCopy),
+			memSize:    memoryExtCodeCopy,
+		}
+		instructions[CALL] = instruction{
+			staticGas:  gs.WarmStorageRead,
+			dynamicGas: withColdAccountAccess(access, 1, coldAccountAccess, gs.makeGasCall(0)),
+			memSize:    memoryCall,
+		}
+		instructions[CALLCODE] = instruction{
+			staticGas:  gs.WarmStorageRead,
+			dynamicGas: withColdAccountAccess(access, 1, coldAccountAccess, gs.makeGasCallCode(0)),
+			memSize:    memoryCall,
+		}
+		instructions[DELEGATECALL] = instruction{
+			staticGas:  gs.WarmStorageRead,
+			dynamicGas: withColdAccountAccess(access, 1, coldAccountAccess, gs.onlyMemoryGas(0)),
+			memSize:    memoryDelegateCall,
+		}
+		instructions[STATICCALL] = instruction{
+			staticGas:  gs.WarmStorageRead,
+			dynamicGas: withColdAccountAccess(access, 1, coldAccountAccess, gs.onlyMemoryGas(0)),
+			memSize:    memoryStaticCall,
+		}
+		instructions[SELFDESTRUCT] = instruction{
+			dynamicGas: withColdAccountAccess(access, 0, gs.ColdAccountAccess, gs.gasSelfdestruct),
+		}
+	}
+	return instructions
 }
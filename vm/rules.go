@@ -0,0 +1,114 @@
+package vm
+
+import (
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+)
+
+// SELFBALANCE pushes the balance of the executing contract (EIP-1884). Burrow does not define it.
+const SELFBALANCE OpCode = 0x47
+
+// Rules are the protocol upgrades the CVM applies to an execution. The zero value keeps the
+// original CVM behavior, so that blocks executed before an upgrade replay identically.
+type Rules struct {
+	// IsIstanbul enables CHAINID and SELFBALANCE with their gas costs, and the net gas
+	// metering of SSTORE (EIP-2200).
+	IsIstanbul bool
+	// IsBerlin enables the warm and cold access costs of accounts and storage (EIP-2929).
+	IsBerlin bool
+}
+
+// opName returns the name of the opcode, including the ones Burrow does not define.
+func opName(op OpCode) string {
+	if op == SELFBALANCE {
+		return "SELFBALANCE"
+	}
+	return op.String()
+}
+
+type storageSlot struct {
+	address crypto.Address
+	key     Word256
+}
+
+// accessList tracks the accounts and storage slots accessed in a transaction (EIP-2929), and
+// the values the slots held before the transaction for the net gas metering of SSTORE (EIP-2200).
+type accessList struct {
+	// natives are always accessed, like the precompiles of the EVM.
+	natives   engine.Natives
+	addresses map[crypto.Address]struct{}
+	slots     map[storageSlot]struct{}
+	// journal lists the accesses in order, so that the ones of reverted call frames can be undone.
+	journal []interface{}
+
+	originals map[storageSlot]Word256
+}
+
+func newAccessList(natives engine.Natives) *accessList {
+	list := &accessList{natives: natives}
+	list.reset()
+	return list
+}
+
+// reset clears the access list for a new transaction.
+func (l *accessList) reset() {
+	l.addresses = make(map[crypto.Address]struct{})
+	l.slots = make(map[storageSlot]struct{})
+	l.journal = nil
+	l.originals = make(map[storageSlot]Word256)
+}
+
+// addAddress adds the address to the access list and returns whether it was already accessed.
+func (l *accessList) addAddress(address crypto.Address) bool {
+	if l.natives != nil && l.natives.GetByAddress(address) != nil {
+		return true
+	}
+	if _, ok := l.addresses[address]; ok {
+		return true
+	}
+	l.addresses[address] = struct{}{}
+	l.journal = append(l.journal, address)
+	return false
+}
+
+// addSlot adds the storage slot to the access list and returns whether it was already accessed.
+func (l *accessList) addSlot(address crypto.Address, key Word256) bool {
+	slot := storageSlot{address: address, key: key}
+	if _, ok := l.slots[slot]; ok {
+		return true
+	}
+	l.slots[slot] = struct{}{}
+	l.journal = append(l.journal, slot)
+	return false
+}
+
+// original returns the value of the storage slot before the transaction, given its current value.
+// The first value seen for a slot is its original one, as SSTORE records it before any write.
+func (l *accessList) original(address crypto.Address, key Word256, current Word256) Word256 {
+	slot := storageSlot{address: address, key: key}
+	if value, ok := l.originals[slot]; ok {
+		return value
+	}
+	l.originals[slot] = current
+	return current
+}
+
+// snapshot returns the position of the journal to revert to.
+func (l *accessList) snapshot() int {
+	return len(l.journal)
+}
+
+// revertTo removes the accesses made since the snapshot.
+func (l *accessList) revertTo(snapshot int) {
+	for _, entry := range l.journal[snapshot:] {
+		switch entry := entry.(type) {
+		case crypto.Address:
+			delete(l.addresses, entry)
+		case storageSlot:
+			delete(l.slots, entry)
+		}
+	}
+	l.journal = l.journal[:snapshot]
+}
@@ -0,0 +1,225 @@
+package vm
+
+import (
+	"encoding/hex"
+	"math"
+	"math/big"
+
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	. "github.com/hyperledger/burrow/execution/evm"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+)
+
+// Tracer receives the opcode-level steps of CVM executions.
+type Tracer interface {
+	// CaptureStep is called before an opcode is executed.
+	CaptureStep(step Step)
+	// CaptureMemoryWrite is called when the executing opcode writes to memory.
+	CaptureMemoryWrite(offset uint64, data []byte)
+	// CaptureStorageWrite is called when the executing opcode writes to storage.
+	CaptureStorageWrite(address crypto.Address, key Word256, value []byte)
+	// CaptureExit is called when a call frame returns.
+	CaptureExit(depth int, output []byte, err error)
+}
+
+// Step is the state of a call frame before an opcode is executed.
+type Step struct {
+	Pc      uint64
+	Op      OpCode
+	Gas     uint64
+	GasCost uint64
+	// Depth is the depth of the call frame, starting at 1 like in Ethereum traces.
+	Depth int
+	// Stack holds the stack items from the bottom to the top.
+	Stack   []Word256
+	Address crypto.Address
+}
+
+// SetTracer sets the tracer receiving the steps of the executions. Tracing is disabled with a nil tracer.
+func (vm *CVM) SetTracer(tracer Tracer) {
+	vm.tracer = tracer
+}
+
+// stackItems returns the items of the stack from the bottom to the top. Reading the stack pops and
+// pushes its items, so the gas used by the stack operations is restored afterwards.
+func stackItems(stack *Stack, gas *big.Int) []Word256 {
+	remaining := new(big.Int).Set(gas)
+	gas.SetUint64(math.MaxUint64)
+	items := make([]Word256, stack.Len())
+	for i := len(items) - 1; i >= 0; i-- {
+		items[i] = stack.Pop()
+	}
+	for _, item := range items {
+		stack.Push(item)
+	}
+	gas.Set(remaining)
+	return items
+}
+
+// tracingMemory reports the memory writes of an execution to a tracer.
+type tracingMemory struct {
+	engine.Memory
+	tracer Tracer
+}
+
+func (mem tracingMemory) Write(offset *big.Int, value []byte) {
+	mem.Memory.Write(offset, value)
+	// Failed writes leave the memory capacity below the end of the write.
+	end := new(big.Int).Add(offset, big.NewInt(int64(len(value))))
+	if end.Cmp(mem.Capacity()) <= 0 {
+		mem.tracer.CaptureMemoryWrite(offset.Uint64(), value)
+	}
+}
+
+// StructLoggerConfig selects the parts of the state recorded by a StructLogger.
+type StructLoggerConfig struct {
+	DisableStack   bool
+	DisableMemory  bool
+	DisableStorage bool
+}
+
+// StructLog is a step of an execution in the struct log format of Ethereum debug traces.
+type StructLog struct {
+	Pc      uint64            `json:"pc"`
+	Op      string            `json:"op"`
+	Gas     uint64            `json:"gas"`
+	GasCost uint64            `json:"gasCost"`
+	Depth   int               `json:"depth"`
+	Error   string            `json:"error,omitempty"`
+	Stack   []string          `json:"stack,omitempty"`
+	Memory  []string          `json:"memory,omitempty"`
+	Storage map[string]string `json:"storage,omitempty"`
+}
+
+// ExecutionResult is the trace of an execution in the format of Ethereum debug traces.
+type ExecutionResult struct {
+	Gas         uint64      `json:"gas"`
+	Failed      bool        `json:"failed"`
+	ReturnValue string      `json:"returnValue"`
+	StructLogs  []StructLog `json:"structLogs"`
+}
+
+// StructLogger is a Tracer recording the steps of an execution as struct logs. It keeps the memory of
+// each call frame up to date from the memory writes and the storage of each contract from the storage writes.
+// Besides the gas schedule, the CVM charges stack operations, so the gas cost of a step is taken from the
+// gas left at the next step of its frame when it is known.
+type StructLogger struct {
+	config  StructLoggerConfig
+	logs    []StructLog
+	frames  []structLoggerFrame
+	storage map[crypto.Address]map[string]string
+}
+
+// structLoggerFrame is the state of a call frame traced by a StructLogger.
+type structLoggerFrame struct {
+	memory []byte
+	// last is the index of the last struct log of the frame, -1 if there is none.
+	last   int
+	lastOp OpCode
+}
+
+var _ Tracer = &StructLogger{}
+
+// NewStructLogger returns a StructLogger recording the state selected by the config.
+func NewStructLogger(config StructLoggerConfig) *StructLogger {
+	return &StructLogger{
+		config:  config,
+		storage: make(map[crypto.Address]map[string]string),
+	}
+}
+
+// CaptureStep implements the Tracer interface.
+func (l *StructLogger) CaptureStep(step Step) {
+	// Enter a new call frame or return to the calling frame.
+	for len(l.frames) < step.Depth {
+		l.frames = append(l.frames, structLoggerFrame{last: -1})
+	}
+	l.frames = l.frames[:step.Depth]
+	frame := &l.frames[step.Depth-1]
+	// The gas of calls and creations also covers the executions of the new frames.
+	if frame.last >= 0 && !isCallOrCreate(frame.lastOp) {
+		if prev := &l.logs[frame.last]; prev.Gas >= step.Gas {
+			prev.GasCost = prev.Gas - step.Gas
+		}
+	}
+	frame.last, frame.lastOp = len(l.logs), step.Op
+
+	log := StructLog{
+		Pc:      step.Pc,
+		Op:      opName(step.Op),
+		Gas:     step.Gas,
+		GasCost: step.GasCost,
+		Depth:   step.Depth,
+	}
+	if !l.config.DisableStack {
+		log.Stack = make([]string, len(step.Stack))
+		for i, item := range step.Stack {
+			log.Stack[i] = "0x" + new(big.Int).SetBytes(item.Bytes()).Text(16)
+		}
+	}
+	if !l.config.DisableMemory {
+		for i := 0; i < len(frame.memory); i += 32 {
+			log.Memory = append(log.Memory, hex.EncodeToString(frame.memory[i:i+32]))
+		}
+	}
+	l.logs = append(l.logs, log)
+}
+
+// CaptureMemoryWrite implements the Tracer interface.
+func (l *StructLogger) CaptureMemoryWrite(offset uint64, data []byte) {
+	if len(l.frames) == 0 || len(data) == 0 {
+		return
+	}
+	frame := &l.frames[len(l.frames)-1]
+	if end := toWordSize(offset+uint64(len(data))) * 32; end > uint64(len(frame.memory)) {
+		frame.memory = append(frame.memory, make([]byte, end-uint64(len(frame.memory)))...)
+	}
+	copy(frame.memory[offset:], data)
+}
+
+// CaptureStorageWrite implements the Tracer interface.
+func (l *StructLogger) CaptureStorageWrite(address crypto.Address, key Word256, value []byte) {
+	if l.config.DisableStorage || len(l.logs) == 0 {
+		return
+	}
+	storage, ok := l.storage[address]
+	if !ok {
+		storage = make(map[string]string)
+		l.storage[address] = storage
+	}
+	storage[hex.EncodeToString(key.Bytes())] = hex.EncodeToString(LeftPadWord256(value).Bytes())
+
+	// Like Ethereum traces, the storing step shows the storage of the contract written so far.
+	log := &l.logs[len(l.logs)-1]
+	log.Storage = make(map[string]string, len(storage))
+	for k, v := range storage {
+		log.Storage[k] = v
+	}
+}
+
+// CaptureExit implements the Tracer interface.
+func (l *StructLogger) CaptureExit(depth int, output []byte, err error) {
+	if err == nil || len(l.logs) == 0 {
+		return
+	}
+	// The error is reported by the last step of the frame.
+	log := &l.logs[len(l.logs)-1]
+	if log.Depth == depth && log.Error == "" {
+		log.Error = err.Error()
+	}
+}
+
+func isCallOrCreate(op OpCode) bool {
+	switch op {
+	case CALL, CALLCODE, DELEGATECALL, STATICCALL, CREATE, CREATE2:
+		return true
+	}
+	return false
+}
+
+// StructLogs returns the recorded struct logs.
+func (l *StructLogger) StructLogs() []StructLog {
+	return l.logs
+}
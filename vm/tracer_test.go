@@ -0,0 +1,127 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/execution/engine"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStructLogger(t *testing.T) {
+	st := acmstate.NewMemoryState()
+	caller := newAccount(t, st, "1")
+
+	// The callee stores 7 at slot 1, then reverts.
+	callee := makeAccountWithCode(t, st, "callee", MustSplice(PUSH1, 7, PUSH1, 1, SSTORE, PUSH1, 0, DUP1, REVERT))
+	// The contract writes 0x2a to memory, calls the callee, then returns the memory word.
+	code := MustSplice(PUSH1, 0x2a, PUSH1, 0, MSTORE,
+		PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH20, callee, PUSH2, 0xff, 0xff, CALL,
+		POP, returnWord())
+	contract := makeAccountWithCode(t, st, "contract", code)
+
+	run := func(config StructLoggerConfig) ([]byte, []StructLog) {
+		vm := NewCVM(engine.Options{MemoryProvider: testDDMP})
+		logger := NewStructLogger(config)
+		vm.SetTracer(logger)
+		out, err := vm.Execute(st, new(blockchain), exec.NewNoopEventSink(), engine.CallParams{
+			Caller: caller,
+			Callee: contract,
+			Gas:    big.NewInt(100000),
+		}, code)
+		require.NoError(t, err)
+		return out, logger.StructLogs()
+	}
+
+	out, logs := run(StructLoggerConfig{})
+	require.Equal(t, byte(0x2a), out[31])
+
+	var ops []string
+	for _, log := range logs {
+		ops = append(ops, log.Op)
+	}
+	require.Equal(t, []string{
+		"PUSH1", "PUSH1", "MSTORE", "PUSH1", "PUSH1", "PUSH1", "PUSH1", "PUSH1", "PUSH20", "PUSH2", "CALL",
+		"PUSH1", "PUSH1", "SSTORE", "PUSH1", "DUP1", "REVERT",
+		"POP", "PUSH1", "PUSH1", "RETURN",
+	}, ops)
+
+	t.Run("steps", func(t *testing.T) {
+		require.Equal(t, StructLog{Pc: 0, Op: "PUSH1", Gas: 100000, GasCost: 4, Depth: 1, Stack: []string{}}, logs[0])
+		require.Equal(t, uint64(2), logs[1].Pc)
+		require.Equal(t, []string{"0x2a"}, logs[1].Stack)
+		require.Equal(t, []string{"0x2a", "0x0"}, logs[2].Stack)
+		// The gas cost of a step is the gas used until the next step of its frame.
+		for i := 1; i < 11; i++ {
+			require.Equal(t, logs[i-1].Gas-logs[i-1].GasCost, logs[i].Gas, i)
+		}
+		require.Equal(t, logs[11].Gas-logs[11].GasCost, logs[12].Gas)
+		require.Equal(t, logs[17].Gas-logs[17].GasCost, logs[18].Gas)
+	})
+
+	t.Run("depth", func(t *testing.T) {
+		for i, log := range logs {
+			depth := 1
+			if i >= 11 && i <= 16 {
+				depth = 2
+			}
+			require.Equal(t, depth, log.Depth, i)
+		}
+		require.Equal(t, uint64(0), logs[11].Pc)
+	})
+
+	t.Run("memory", func(t *testing.T) {
+		require.Empty(t, logs[2].Memory)
+		word := "000000000000000000000000000000000000000000000000000000000000002a"
+		require.Equal(t, []string{word}, logs[3].Memory)
+		// The callee has its own memory.
+		require.Empty(t, logs[12].Memory)
+		require.Equal(t, []string{word}, logs[17].Memory)
+	})
+
+	t.Run("storage", func(t *testing.T) {
+		require.Empty(t, logs[12].Storage)
+		require.Equal(t, map[string]string{
+			"0000000000000000000000000000000000000000000000000000000000000001": "0000000000000000000000000000000000000000000000000000000000000007",
+		}, logs[13].Storage)
+		require.Empty(t, logs[14].Storage)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for i, log := range logs {
+			if i == 16 {
+				require.NotEmpty(t, log.Error)
+			} else {
+				require.Empty(t, log.Error, i)
+			}
+		}
+	})
+
+	t.Run("config", func(t *testing.T) {
+		_, logs := run(StructLoggerConfig{DisableStack: true, DisableMemory: true, DisableStorage: true})
+		require.Len(t, logs, len(ops))
+		for _, log := range logs {
+			require.Nil(t, log.Stack)
+			require.Nil(t, log.Memory)
+			require.Nil(t, log.Storage)
+		}
+	})
+
+	t.Run("gas", func(t *testing.T) {
+		// Tracing does not change the gas used.
+		vm := NewCVM(engine.Options{MemoryProvider: testDDMP})
+		params := engine.CallParams{Caller: caller, Callee: contract, Gas: big.NewInt(100000)}
+		_, err := vm.Execute(st, new(blockchain), exec.NewNoopEventSink(), params, code)
+		require.NoError(t, err)
+
+		vm.SetTracer(NewStructLogger(StructLoggerConfig{}))
+		tracedParams := engine.CallParams{Caller: caller, Callee: contract, Gas: big.NewInt(100000)}
+		_, err = vm.Execute(st, new(blockchain), exec.NewNoopEventSink(), tracedParams, code)
+		require.NoError(t, err)
+		require.Equal(t, params.Gas, tracedParams.Gas)
+	})
+}
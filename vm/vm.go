@@ -5,6 +5,7 @@ import (
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
@@ -24,6 +25,34 @@ type CVM struct {
 
 	// After execution, refund counter is set to the memory refund value
 	refund uint64
+
+	// Tracks state changes made by natives outside of the CVM state
+	nativeEffects *NativeEffects
+
+	// Receives the steps of the executions
+	tracer Tracer
+
+	// Instruction set with the gas costs of the gas schedule and the rules
+	instructions *[256]instruction
+	gasSchedule  GasSchedule
+	rules        Rules
+
+	// Tracks the accounts and storage slots accessed in the transaction
+	access *accessList
+
+	// Reports whether calls into an account are rejected
+	isFrozen func(address crypto.Address) bool
+}
+
+// NativeEffects counts the state changes natives make outside of the CVM state, e.g. in other
+// modules. Those changes are not discarded together with the call frame of a failed call.
+type NativeEffects struct {
+	count uint64
+}
+
+// Record records a state change made by a native outside of the CVM state.
+func (e *NativeEffects) Record() {
+	e.count++
 }
 
 func NewCVM(options engine.Options) *CVM {
@@ -38,7 +67,10 @@ func NewCVM(options engine.Options) *CVM {
 		options.Natives = native.MustDefaultNatives()
 	}
 	vm := &CVM{
-		options: options,
+		options:      options,
+		instructions: defaultInstructionSet,
+		gasSchedule:  DefaultGasSchedule(),
+		access:       newAccessList(options.Natives),
 	}
 	vm.logger = options.Logger.WithScope("NewVM").With("evm_nonce", options.Nonce)
 	vm.externalDispatcher = engine.Dispatchers{&vm.Externals, options.Natives, vm}
@@ -70,6 +102,11 @@ func (vm *CVM) Execute(st acmstate.ReaderWriter, blockchain engine.Blockchain, e
 		EventSink:  eventSink,
 	}
 
+	// The caller and callee of the transaction are accessed from its start
+	vm.access.reset()
+	vm.access.addAddress(params.Caller)
+	vm.access.addAddress(params.Callee)
+
 	output, err := vm.Contract(code).Call(state, params)
 	if err == nil {
 		// Only sync back when there was no exception
@@ -86,6 +123,40 @@ func (vm *CVM) SetNonce(nonce []byte) {
 	vm.sequence = 0
 }
 
+// SetNativeEffects sets the tracker of state changes made by natives outside of the CVM state.
+// A nested call that fails after any such change fails the whole execution, as the changes
+// cannot be reverted with its call frame.
+func (vm *CVM) SetNativeEffects(effects *NativeEffects) {
+	vm.nativeEffects = effects
+}
+
+// SetGasSchedule sets the gas schedule the opcodes are charged with.
+func (vm *CVM) SetGasSchedule(schedule GasSchedule) {
+	vm.gasSchedule = schedule
+	vm.instructions = newInstructionSet(&schedule, vm.rules, vm.access)
+}
+
+// SetRules sets the protocol upgrades applied to the executions.
+func (vm *CVM) SetRules(rules Rules) {
+	schedule := vm.gasSchedule
+	vm.rules = rules
+	vm.instructions = newInstructionSet(&schedule, rules, vm.access)
+}
+
+// SetFrozenCheck sets the check of the accounts whose calls are rejected. The nested calls into
+// those accounts fail with a PermissionDenied error, which fails the whole execution.
+func (vm *CVM) SetFrozenCheck(isFrozen func(address crypto.Address) bool) {
+	vm.isFrozen = isFrozen
+}
+
+// nativeEffectCount returns the number of state changes natives have made outside of the CVM state.
+func (vm *CVM) nativeEffectCount() uint64 {
+	if vm.nativeEffects == nil {
+		return 0
+	}
+	return vm.nativeEffects.count
+}
+
 // SetLogger sets the logger for the CVM instance.
 func (vm *CVM) SetLogger(logger *logging.Logger) {
 	vm.logger = logger
@@ -93,6 +164,11 @@ func (vm *CVM) SetLogger(logger *logging.Logger) {
 
 // Dispatch dispatches an account to be used externally from another engine.
 func (vm *CVM) Dispatch(acc *acm.Account) engine.Callable {
+	if vm.isFrozen != nil && vm.isFrozen(acc.Address) {
+		return engine.CallableFunc(func(engine.State, engine.CallParams) ([]byte, error) {
+			return nil, errors.Errorf(errors.Codes.PermissionDenied, "contract %v is frozen", acc.Address)
+		})
+	}
 	// Let the EVM handle code-less (e.g. those created by a call) contracts (so only return nil if there is _other_ non-EVM code)
 	if len(acc.EVMCode) == 0 && len(acc.Code()) != 0 {
 		return nil
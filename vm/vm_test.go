@@ -6,6 +6,7 @@ package vm
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"math/big"
 	"reflect"
 	"testing"
@@ -23,6 +24,7 @@ import (
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/native"
 	"github.com/hyperledger/burrow/execution/solidity"
+	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -6159,6 +6161,254 @@ func (b *blockchain) ChainID() string {
 	return b.chainid
 }
 
+func TestNativeEffects(t *testing.T) {
+	effects := &NativeEffects{}
+	nativeAddress := crypto.Address{0x99}
+	natives := native.MustDefaultNatives().MustFunction("effect", nativeAddress, permission.None,
+		func(ctx native.Context) ([]byte, error) {
+			effects.Record()
+			return nil, nil
+		})
+
+	// inner calls the native and reverts, outer calls inner and returns whether it succeeded.
+	callCode := func(address crypto.Address) []byte {
+		return MustSplice(PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH20, address, GAS, CALL)
+	}
+	st := acmstate.NewMemoryState()
+	inner := makeAccountWithCode(t, st, "inner", MustSplice(callCode(nativeAddress), POP, PUSH1, 0, PUSH1, 0, REVERT))
+	outerCode := MustSplice(callCode(inner), return1())
+	outer := makeAccountWithCode(t, st, "outer", outerCode)
+	caller := newAccount(t, st, "caller")
+
+	t.Run("WithoutTracking", func(t *testing.T) {
+		vm := NewCVM(engine.Options{Natives: natives})
+		output, err := vm.Execute(st, new(blockchain), exec.NewNoopEventSink(), engine.CallParams{
+			Caller: caller,
+			Callee: outer,
+			Gas:    big.NewInt(100000),
+		}, outerCode)
+		require.NoError(t, err)
+		require.Equal(t, Zero256.Bytes(), output)
+	})
+
+	t.Run("WithTracking", func(t *testing.T) {
+		vm := NewCVM(engine.Options{Natives: natives})
+		vm.SetNativeEffects(effects)
+		_, err := vm.Execute(st, new(blockchain), exec.NewNoopEventSink(), engine.CallParams{
+			Caller: caller,
+			Callee: outer,
+			Gas:    big.NewInt(100000),
+		}, outerCode)
+		require.Equal(t, errors.Codes.ExecutionReverted, errors.GetCode(err))
+	})
+}
+
+func TestGasSchedule(t *testing.T) {
+	code := MustSplice(PUSH1, 7, PUSH1, 3, MUL, STOP)
+	run := func(schedule *GasSchedule) uint64 {
+		st := acmstate.NewMemoryState()
+		vm := NewCVM(engine.Options{MemoryProvider: testDDMP})
+		if schedule != nil {
+			vm.SetGasSchedule(*schedule)
+		}
+		params := engine.CallParams{
+			Caller: newAccount(t, st, "caller"),
+			Callee: makeAccountWithCode(t, st, "callee", code),
+			Gas:    big.NewInt(100000),
+		}
+		_, err := vm.Execute(st, new(blockchain), exec.NewNoopEventSink(), params, code)
+		require.NoError(t, err)
+		return 100000 - params.Gas.Uint64()
+	}
+
+	defaultSchedule := DefaultGasSchedule()
+	require.Equal(t, run(nil), run(&defaultSchedule))
+
+	schedule := DefaultGasSchedule()
+	schedule.VeryLow += 10
+	schedule.Low += 100
+	require.Equal(t, run(nil)+2*10+100, run(&schedule))
+}
+
+func TestIstanbulOpcodes(t *testing.T) {
+	st := acmstate.NewMemoryState()
+	caller := newAccount(t, st, "caller")
+	var callees int
+	run := func(rules Rules, code []byte) ([]byte, *gasCostTracer, error) {
+		callees++
+		callee := makeAccountWithCode(t, st, fmt.Sprintf("callee%d", callees), code)
+		vm := NewCVM(engine.Options{MemoryProvider: testDDMP})
+		vm.SetRules(rules)
+		tracer := newGasCostTracer()
+		vm.SetTracer(tracer)
+		output, err := vm.Execute(st, &blockchain{chainid: "certik-1"}, exec.NewNoopEventSink(), engine.CallParams{
+			Caller: caller,
+			Callee: callee,
+			Gas:    big.NewInt(100000),
+		}, code)
+		return output, tracer, err
+	}
+
+	t.Run("CHAINID", func(t *testing.T) {
+		code := MustSplice(CHAINID, return1())
+		output, tracer, err := run(Rules{}, code)
+		require.NoError(t, err)
+		require.Equal(t, LeftPadBytes(crypto.GetEthChainID("certik-1").Bytes(), 32), output)
+		require.Equal(t, []uint64{0}, tracer.costs[CHAINID])
+
+		output, tracer, err = run(Rules{IsIstanbul: true}, code)
+		require.NoError(t, err)
+		require.Equal(t, LeftPadBytes(crypto.GetEthChainID("certik-1").Bytes(), 32), output)
+		require.Equal(t, []uint64{GasBase}, tracer.costs[CHAINID])
+	})
+
+	t.Run("SELFBALANCE", func(t *testing.T) {
+		code := MustSplice(SELFBALANCE, return1())
+		_, _, err := run(Rules{}, code)
+		require.Equal(t, errors.Codes.Generic, errors.GetCode(err))
+
+		output, tracer, err := run(Rules{IsIstanbul: true}, code)
+		require.NoError(t, err)
+		require.Equal(t, Uint64ToWord256(9999999).Bytes(), output)
+		require.Equal(t, []uint64{GasLow}, tracer.costs[SELFBALANCE])
+	})
+}
+
+func TestSStoreEIP2200(t *testing.T) {
+	// The costs of two SSTOREs of slot 0, starting from the original value (EIP-2200 test cases).
+	tests := []struct {
+		original  byte
+		values    [2]byte
+		costs     []uint64
+		costsCold []uint64
+	}{
+		{0, [2]byte{0, 0}, []uint64{800, 800}, []uint64{2200, 100}},
+		{0, [2]byte{0, 1}, []uint64{800, 20000}, []uint64{2200, 20000}},
+		{0, [2]byte{1, 0}, []uint64{20000, 800}, []uint64{22100, 100}},
+		{0, [2]byte{1, 2}, []uint64{20000, 800}, []uint64{22100, 100}},
+		{0, [2]byte{1, 1}, []uint64{20000, 800}, []uint64{22100, 100}},
+		{1, [2]byte{0, 0}, []uint64{5000, 800}, []uint64{5000, 100}},
+		{1, [2]byte{0, 1}, []uint64{5000, 800}, []uint64{5000, 100}},
+		{1, [2]byte{2, 0}, []uint64{5000, 800}, []uint64{5000, 100}},
+		{1, [2]byte{2, 3}, []uint64{5000, 800}, []uint64{5000, 100}},
+		{1, [2]byte{1, 2}, []uint64{800, 5000}, []uint64{2200, 2900}},
+		{1, [2]byte{1, 1}, []uint64{800, 800}, []uint64{2200, 100}},
+	}
+	run := func(rules Rules, original byte, values [2]byte, gas int64) ([]uint64, error) {
+		st := acmstate.NewMemoryState()
+		code := MustSplice(PUSH1, values[0], PUSH1, 0, SSTORE, PUSH1, values[1], PUSH1, 0, SSTORE, STOP)
+		callee := makeAccountWithCode(t, st, "callee", code)
+		if original != 0 {
+			require.NoError(t, st.SetStorage(callee, Zero256, Int64ToWord256(int64(original)).Bytes()))
+		}
+		vm := NewCVM(engine.Options{MemoryProvider: testDDMP})
+		vm.SetRules(rules)
+		tracer := newGasCostTracer()
+		vm.SetTracer(tracer)
+		_, err := vm.Execute(st, new(blockchain), exec.NewNoopEventSink(), engine.CallParams{
+			Caller: newAccount(t, st, "caller"),
+			Callee: callee,
+			Gas:    big.NewInt(gas),
+		}, code)
+		return tracer.costs[SSTORE], err
+	}
+
+	for _, tt := range tests {
+		costs, err := run(Rules{IsIstanbul: true}, tt.original, tt.values, 100000)
+		require.NoError(t, err)
+		require.Equal(t, tt.costs, costs, "%d -> %d -> %d", tt.original, tt.values[0], tt.values[1])
+
+		costs, err = run(Rules{IsIstanbul: true, IsBerlin: true}, tt.original, tt.values, 100000)
+		require.NoError(t, err)
+		require.Equal(t, tt.costsCold, costs, "%d -> %d -> %d", tt.original, tt.values[0], tt.values[1])
+	}
+
+	t.Run("unset slots are charged as changes before Istanbul", func(t *testing.T) {
+		costs, err := run(Rules{}, 0, [2]byte{1, 2}, 100000)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{SstoreResetGas, SstoreResetGas}, costs)
+	})
+
+	t.Run("SSTORE requires more gas than the call stipend", func(t *testing.T) {
+		_, err := run(Rules{IsIstanbul: true}, 0, [2]byte{1, 1}, 2300)
+		require.Equal(t, errors.Codes.InsufficientGas, errors.GetCode(err))
+	})
+}
+
+func TestAccessListEIP2929(t *testing.T) {
+	st := acmstate.NewMemoryState()
+	caller := newAccount(t, st, "caller")
+	account := newAccount(t, st, "account")
+	precompile := crypto.AddressFromWord256(Int64ToWord256(2))
+	require.NotNil(t, native.MustDefaultNatives().GetByAddress(precompile))
+
+	var callees int
+	run := func(rules Rules, code []byte) *gasCostTracer {
+		callees++
+		callee := makeAccountWithCode(t, st, fmt.Sprintf("callee%d", callees), code)
+		vm := NewCVM(engine.Options{MemoryProvider: testDDMP})
+		vm.SetRules(rules)
+		tracer := newGasCostTracer()
+		vm.SetTracer(tracer)
+		_, err := vm.Execute(st, new(blockchain), exec.NewNoopEventSink(), engine.CallParams{
+			Caller: caller,
+			Callee: callee,
+			Gas:    big.NewInt(100000),
+		}, code)
+		require.NoError(t, err)
+		return tracer
+	}
+	balance := func(address crypto.Address) []byte {
+		return MustSplice(PUSH20, address, BALANCE, POP)
+	}
+	berlin := Rules{IsIstanbul: true, IsBerlin: true}
+
+	t.Run("storage slots", func(t *testing.T) {
+		code := MustSplice(PUSH1, 0, SLOAD, POP, PUSH1, 0, SLOAD, POP, PUSH1, 1, SLOAD, POP, STOP)
+		require.Equal(t, []uint64{GasSLoad, GasSLoad, GasSLoad}, run(Rules{IsIstanbul: true}, code).costs[SLOAD])
+		require.Equal(t, []uint64{ColdSloadCost, WarmStorageReadCost, ColdSloadCost}, run(berlin, code).costs[SLOAD])
+	})
+
+	t.Run("accounts", func(t *testing.T) {
+		code := MustSplice(balance(account), balance(account), balance(caller), balance(precompile), STOP)
+		require.Equal(t, []uint64{GasBalance, GasBalance, GasBalance, GasBalance}, run(Rules{IsIstanbul: true}, code).costs[BALANCE])
+		require.Equal(t, []uint64{ColdAccountAccessCost, WarmStorageReadCost, WarmStorageReadCost, WarmStorageReadCost},
+			run(berlin, code).costs[BALANCE])
+	})
+
+	t.Run("accesses of failed calls are discarded", func(t *testing.T) {
+		callCode := func(address crypto.Address) []byte {
+			return MustSplice(PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH20, address, GAS, CALL, POP)
+		}
+		succeeding := makeAccountWithCode(t, st, "succeeding", MustSplice(balance(account), STOP))
+		reverting := makeAccountWithCode(t, st, "reverting", MustSplice(balance(account), PUSH1, 0, DUP1, REVERT))
+
+		costs := run(berlin, MustSplice(callCode(succeeding), balance(account), STOP)).costs[BALANCE]
+		require.Equal(t, []uint64{ColdAccountAccessCost, WarmStorageReadCost}, costs)
+		costs = run(berlin, MustSplice(callCode(reverting), balance(account), STOP)).costs[BALANCE]
+		require.Equal(t, []uint64{ColdAccountAccessCost, ColdAccountAccessCost}, costs)
+	})
+}
+
+// gasCostTracer records the gas costs of the executed opcodes.
+type gasCostTracer struct {
+	costs map[OpCode][]uint64
+}
+
+func newGasCostTracer() *gasCostTracer {
+	return &gasCostTracer{costs: make(map[OpCode][]uint64)}
+}
+
+func (t *gasCostTracer) CaptureStep(step Step) {
+	t.costs[step.Op] = append(t.costs[step.Op], step.GasCost)
+}
+
+func (t *gasCostTracer) CaptureMemoryWrite(offset uint64, data []byte) {}
+
+func (t *gasCostTracer) CaptureStorageWrite(address crypto.Address, key Word256, value []byte) {}
+
+func (t *gasCostTracer) CaptureExit(depth int, output []byte, err error) {}
+
 // helpers
 
 func newAccount(t testing.TB, st acmstate.ReaderWriter, name string) crypto.Address {
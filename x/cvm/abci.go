@@ -6,11 +6,18 @@ import (
 	"github.com/certikfoundation/shentu/x/cvm/keeper"
 )
 
-// BeginBlocker stores previous block's hash into the k-v store.
+// BeginBlocker stores previous block's hash into the k-v store and runs the scheduled calls due at
+// the block.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	k.StoreLastBlockHash(ctx)
+	k.RunScheduledCalls(ctx)
 }
 
 // EndBlocker ends the block by sending all coins stored at the zero address to the community pool.
+// It also prunes the receipts that are older than the retention period and the expired freezes
+// of contracts.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.SweepZeroAddress(ctx)
+	k.PruneReceipts(ctx)
+	k.PruneFrozenContracts(ctx)
 }
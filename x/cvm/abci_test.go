@@ -0,0 +1,66 @@
+package cvm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/cvm"
+	"github.com/certikfoundation/shentu/x/cvm/keeper"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+func TestEndBlockerSweep(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(10000))
+	k := app.CVMKeeper
+	querier := keeper.Querier{Keeper: k}
+	denom := app.StakingKeeper.BondDenom(ctx)
+	burned := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+
+	sweep := func() []sdk.Event {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		cvm.EndBlocker(ctx, k)
+		return ctx.EventManager().Events()
+	}
+
+	t.Run("nothing to sweep", func(t *testing.T) {
+		require.Empty(t, sweep())
+		require.True(t, k.GetSweptFunds(ctx).IsZero())
+	})
+
+	t.Run("sweep the coins burned to the zero address", func(t *testing.T) {
+		pool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+		for i := 0; i < 2; i++ {
+			require.NoError(t, app.BankKeeper.SendCoins(ctx, addrs[0], keeper.ZeroAddress(), burned))
+			events := sweep()
+			require.Equal(t, types.EventTypeSweep, events[len(events)-1].Type)
+			require.True(t, app.BankKeeper.GetAllBalances(ctx, keeper.ZeroAddress()).IsZero())
+		}
+
+		swept := burned.Add(burned...)
+		require.Equal(t, pool.Add(sdk.NewDecCoinsFromCoins(swept...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+		res, err := querier.SweptFunds(sdk.WrapSDKContext(ctx), &types.QuerySweptFundsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, swept, res.Amount)
+	})
+
+	t.Run("invariant", func(t *testing.T) {
+		msg, broken := keeper.SweptFundsInvariant(k)(ctx)
+		require.False(t, broken, msg)
+
+		// Coins sent to the zero address within a block do not break the invariant before they are swept.
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, addrs[0], keeper.ZeroAddress(), burned))
+		msg, broken = keeper.SweptFundsInvariant(k)(ctx)
+		require.False(t, broken, msg)
+		sweep()
+		msg, broken = keeper.SweptFundsInvariant(k)(ctx)
+		require.False(t, broken, msg)
+	})
+}
@@ -0,0 +1,48 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/cvm/keeper"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// OracleScoreDecorator rejects the transactions calling contracts that the security oracle scores
+// below the threshold score, unless the calls or the multi-calls accept the risk. Only the top-level
+// callees of the messages are checked: the contracts they call in turn are not, so a low scored
+// contract can still be reached through a proxy or any other contract calling it.
+type OracleScoreDecorator struct {
+	k keeper.Keeper
+}
+
+// NewOracleScoreDecorator returns a new OracleScoreDecorator.
+func NewOracleScoreDecorator(k keeper.Keeper) OracleScoreDecorator {
+	return OracleScoreDecorator{k: k}
+}
+
+// AnteHandle checks the oracle scores of the callees of the calls in the transaction.
+func (d OracleScoreDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		var calls []types.MultiCallEntry
+		switch msg := msg.(type) {
+		case *types.MsgCall:
+			if !msg.AcceptRisk {
+				calls = []types.MultiCallEntry{{Callee: msg.Callee, Data: msg.Data}}
+			}
+		case *types.MsgMultiCall:
+			if !msg.AcceptRisk {
+				calls = msg.Calls
+			}
+		}
+		for _, call := range calls {
+			callee, err := sdk.AccAddressFromBech32(call.Callee)
+			if err != nil {
+				return ctx, err
+			}
+			if err := d.k.CheckOracleScore(ctx, callee, call.Data); err != nil {
+				return ctx, err
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
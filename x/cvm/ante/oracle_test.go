@@ -0,0 +1,118 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/cvm/ante"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+	oracletypes "github.com/certikfoundation/shentu/x/oracle/types"
+)
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func TestOracleScoreDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(10000))
+	caller, contract := addrs[0], addrs[1]
+	selector := []byte{0xa9, 0x05, 0x9c, 0xbb}
+
+	setTask := func(ctx sdk.Context, function string, status oracletypes.TaskStatus, result int64) {
+		app.OracleKeeper.SetTask(ctx, oracletypes.Task{
+			Contract: contract.String(),
+			Function: function,
+			Result:   sdk.NewInt(result),
+			Status:   status,
+		})
+	}
+	anteHandler := sdk.ChainAnteDecorators(ante.NewOracleScoreDecorator(app.CVMKeeper))
+	check := func(ctx sdk.Context, data []byte, acceptRisk bool) error {
+		msg := types.NewMsgCall(caller.String(), contract.String(), 0, data)
+		msg.AcceptRisk = acceptRisk
+		_, err := anteHandler(ctx, mockTx{msgs: []sdk.Msg{&msg}}, false)
+		return err
+	}
+	// The multi-call checks the contract in its second call.
+	checkMultiCall := func(ctx sdk.Context, data []byte, acceptRisk bool) error {
+		msg := types.NewMsgMultiCall(caller.String(), []types.MultiCallEntry{
+			{Callee: caller.String()},
+			{Callee: contract.String(), Data: data},
+		}, true)
+		msg.AcceptRisk = acceptRisk
+		_, err := anteHandler(ctx, mockTx{msgs: []sdk.Msg{&msg}}, false)
+		return err
+	}
+
+	tests := []struct {
+		name     string
+		malleate func(ctx sdk.Context)
+		data     []byte
+		expected bool
+	}{
+		{"no task", func(ctx sdk.Context) {}, selector, true},
+		{"low score", func(ctx sdk.Context) {
+			setTask(ctx, "", oracletypes.TaskStatusSucceeded, 30)
+		}, selector, false},
+		{"high score", func(ctx sdk.Context) {
+			setTask(ctx, "", oracletypes.TaskStatusSucceeded, 50)
+		}, selector, true},
+		{"pending task", func(ctx sdk.Context) {
+			setTask(ctx, "", oracletypes.TaskStatusPending, 30)
+		}, selector, true},
+		{"failed task", func(ctx sdk.Context) {
+			setTask(ctx, "", oracletypes.TaskStatusFailed, 30)
+		}, selector, true},
+		{"high function score over low contract score", func(ctx sdk.Context) {
+			setTask(ctx, "", oracletypes.TaskStatusSucceeded, 30)
+			setTask(ctx, "0xa9059cbb", oracletypes.TaskStatusSucceeded, 80)
+		}, selector, true},
+		{"low function score over high contract score", func(ctx sdk.Context) {
+			setTask(ctx, "", oracletypes.TaskStatusSucceeded, 80)
+			setTask(ctx, "0xa9059cbb", oracletypes.TaskStatusSucceeded, 30)
+		}, selector, false},
+		{"low score of another function", func(ctx sdk.Context) {
+			setTask(ctx, "0xa9059cbb", oracletypes.TaskStatusSucceeded, 30)
+		}, nil, true},
+		{"lower cvm threshold score", func(ctx sdk.Context) {
+			setTask(ctx, "", oracletypes.TaskStatusSucceeded, 30)
+			app.CVMKeeper.SetOracleCheckParams(ctx, types.OracleCheckParams{Enabled: true, ThresholdScore: sdk.NewInt(20)})
+		}, selector, true},
+		{"higher oracle threshold score", func(ctx sdk.Context) {
+			setTask(ctx, "", oracletypes.TaskStatusSucceeded, 50)
+			taskParams := oracletypes.DefaultTaskParams()
+			taskParams.ThresholdScore = sdk.NewInt(60)
+			app.OracleKeeper.SetTaskParams(ctx, taskParams)
+		}, selector, false},
+		{"disabled", func(ctx sdk.Context) {
+			setTask(ctx, "", oracletypes.TaskStatusSucceeded, 30)
+			app.CVMKeeper.SetOracleCheckParams(ctx, types.OracleCheckParams{ThresholdScore: sdk.ZeroInt()})
+		}, selector, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			tc.malleate(ctx)
+			for _, check := range []func(sdk.Context, []byte, bool) error{check, checkMultiCall} {
+				err := check(ctx, tc.data, false)
+				if tc.expected {
+					require.NoError(t, err)
+				} else {
+					require.ErrorIs(t, err, types.ErrOracleScoreTooLow)
+				}
+				require.NoError(t, check(ctx, tc.data, true))
+			}
+		})
+	}
+}
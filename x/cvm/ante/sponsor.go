@@ -0,0 +1,56 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/certikfoundation/shentu/x/cvm/keeper"
+)
+
+// DeductFeeDecorator deducts the fees of transactions as the DeductFeeDecorator of the SDK, except
+// for the transactions of sponsored calls, whose fees are deducted from the sponsors of the
+// called contracts instead of the callers. See keeper.GetTxSponsorship.
+type DeductFeeDecorator struct {
+	ak         ante.AccountKeeper
+	bankKeeper authtypes.BankKeeper
+	k          keeper.Keeper
+	deductFee  ante.DeductFeeDecorator
+}
+
+// NewDeductFeeDecorator returns a new DeductFeeDecorator.
+func NewDeductFeeDecorator(ak ante.AccountKeeper, bk authtypes.BankKeeper, k keeper.Keeper) DeductFeeDecorator {
+	return DeductFeeDecorator{
+		ak:         ak,
+		bankKeeper: bk,
+		k:          k,
+		deductFee:  ante.NewDeductFeeDecorator(ak, bk),
+	}
+}
+
+// AnteHandle deducts the fees of the transaction from the sponsor or the fee payer.
+func (d DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return d.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+	sponsorship, ok := d.k.GetTxSponsorship(ctx, feeTx.GetMsgs(), feeTx.FeePayer(), feeTx.GetFee())
+	if !ok {
+		return d.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if addr := d.ak.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		panic("fee collector module account has not been set")
+	}
+	sponsor, err := sdk.AccAddressFromBech32(sponsorship.Sponsor)
+	if err != nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sponsorship.Sponsor)
+	}
+	if err := ante.DeductFees(d.bankKeeper, ctx, d.ak.GetAccount(ctx, sponsor), feeTx.GetFee()); err != nil {
+		return ctx, err
+	}
+	d.k.UseSponsorship(ctx, sponsorship, feeTx.FeePayer(), feeTx.GetFee())
+
+	return next(ctx, tx, simulate)
+}
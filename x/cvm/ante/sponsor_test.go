@@ -0,0 +1,126 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/hyperledger/burrow/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/cvm/ante"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+type mockFeeTx struct {
+	mockTx
+	fee sdk.Coins
+}
+
+func (tx mockFeeTx) GetGas() uint64             { return 200000 }
+func (tx mockFeeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx mockFeeTx) FeeGranter() sdk.AccAddress { return nil }
+func (tx mockFeeTx) FeePayer() sdk.AccAddress   { return tx.msgs[0].GetSigners()[0] }
+
+func TestDeductFeeDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(10000))
+	user, sponsor, contract, other := addrs[0], addrs[1], addrs[2], addrs[3]
+	denom := app.StakingKeeper.BondDenom(ctx)
+	address := crypto.MustAddressFromBytes(contract)
+	transfer := []byte{0xa9, 0x05, 0x9c, 0xbb, 0x01}
+	app.CVMKeeper.SetSponsorship(ctx, address, types.NewSponsorship(contract.String(), sponsor.String(),
+		sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), []string{"0xA9059CBB"}, now.Add(48*time.Hour)))
+
+	decorator := ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, app.CVMKeeper)
+	handler := sdk.ChainAnteDecorators(decorator)
+	call := func(caller, callee sdk.AccAddress, data []byte) sdk.Msg {
+		msg := types.NewMsgCall(caller.String(), callee.String(), 0, data)
+		return &msg
+	}
+	multiCall := func(caller sdk.AccAddress, callees ...sdk.AccAddress) sdk.Msg {
+		calls := make([]types.MultiCallEntry, len(callees))
+		for i, callee := range callees {
+			calls[i] = types.MultiCallEntry{Callee: callee.String(), Data: transfer}
+		}
+		msg := types.NewMsgMultiCall(caller.String(), calls, false)
+		return &msg
+	}
+	fee := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+	balance := func(ctx sdk.Context, addr sdk.AccAddress) int64 {
+		return app.BankKeeper.GetBalance(ctx, addr, denom).Amount.Int64()
+	}
+	userBalance, sponsorBalance := balance(ctx, user), balance(ctx, sponsor)
+
+	t.Run("the sponsor pays the fees of sponsored calls", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		tx := mockFeeTx{mockTx{[]sdk.Msg{call(user, contract, transfer), multiCall(user, contract, contract)}}, fee(60)}
+		_, err := handler(ctx, tx, false)
+		require.NoError(t, err)
+		require.Equal(t, userBalance, balance(ctx, user))
+		require.Equal(t, sponsorBalance-60, balance(ctx, sponsor))
+		require.Equal(t, fee(60), app.CVMKeeper.GetSponsorUsage(ctx, address, user).Spent)
+
+		// The rest of the daily cap does not cover the next fees, which the user pays.
+		_, err = handler(ctx, mockFeeTx{mockTx{[]sdk.Msg{call(user, contract, transfer)}}, fee(50)}, false)
+		require.NoError(t, err)
+		require.Equal(t, userBalance-50, balance(ctx, user))
+		require.Equal(t, sponsorBalance-60, balance(ctx, sponsor))
+
+		// The daily cap is reset the next day.
+		ctx = ctx.WithBlockTime(now.Add(24 * time.Hour))
+		_, err = handler(ctx, mockFeeTx{mockTx{[]sdk.Msg{call(user, contract, transfer)}}, fee(50)}, false)
+		require.NoError(t, err)
+		require.Equal(t, userBalance-50, balance(ctx, user))
+		require.Equal(t, sponsorBalance-110, balance(ctx, sponsor))
+		require.Equal(t, fee(50), app.CVMKeeper.GetSponsorUsage(ctx, address, user).Spent)
+	})
+
+	t.Run("the fee payer pays the fees of other transactions", func(t *testing.T) {
+		send := banktypes.NewMsgSend(user, other, fee(1))
+		tests := []struct {
+			name string
+			ctx  sdk.Context
+			tx   mockFeeTx
+		}{
+			{"other function", ctx, mockFeeTx{mockTx{[]sdk.Msg{call(user, contract, []byte{1, 2, 3, 4})}}, fee(10)}},
+			{"no function", ctx, mockFeeTx{mockTx{[]sdk.Msg{call(user, contract, nil)}}, fee(10)}},
+			{"other contract", ctx, mockFeeTx{mockTx{[]sdk.Msg{call(user, other, transfer)}}, fee(10)}},
+			{"several contracts", ctx, mockFeeTx{mockTx{[]sdk.Msg{call(user, contract, transfer), call(user, other, transfer)}}, fee(10)}},
+			{"several contracts in a multi-call", ctx, mockFeeTx{mockTx{[]sdk.Msg{multiCall(user, contract, other)}}, fee(10)}},
+			{"other message", ctx, mockFeeTx{mockTx{[]sdk.Msg{call(user, contract, transfer), send}}, fee(10)}},
+			{"other denom", ctx, mockFeeTx{mockTx{[]sdk.Msg{call(user, contract, transfer)}}, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}},
+			{"over the daily cap", ctx, mockFeeTx{mockTx{[]sdk.Msg{call(user, contract, transfer)}}, fee(101)}},
+			{"expired", ctx.WithBlockTime(now.Add(48 * time.Hour)), mockFeeTx{mockTx{[]sdk.Msg{call(user, contract, transfer)}}, fee(10)}},
+		}
+		for _, tc := range tests {
+			ctx, _ := tc.ctx.CacheContext()
+			require.NoError(t, app.BankKeeper.AddCoins(ctx, user, tc.tx.fee), tc.name)
+			_, err := handler(ctx, tc.tx, false)
+			require.NoError(t, err, tc.name)
+			require.Equal(t, sponsorBalance, balance(ctx, sponsor), tc.name)
+			require.True(t, app.CVMKeeper.GetSponsorUsage(ctx, address, user).Spent.Empty(), tc.name)
+		}
+	})
+
+	t.Run("the sponsor lacks the fees", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, sponsor, feeCollector, fee(sponsorBalance-10)))
+		_, err := handler(ctx, mockFeeTx{mockTx{[]sdk.Msg{call(user, contract, transfer)}}, fee(20)}, false)
+		require.NoError(t, err)
+		require.Equal(t, userBalance-20, balance(ctx, user))
+		require.Equal(t, int64(10), balance(ctx, sponsor))
+	})
+}
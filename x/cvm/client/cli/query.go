@@ -2,9 +2,12 @@
 package cli
 
 import (
+	"context"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -12,12 +15,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 
 	"github.com/certikfoundation/shentu/x/cvm/types"
 )
 
 const (
-	FlagCaller = "caller"
+	FlagCaller     = "caller"
+	FlagAddress    = "address"
+	FlagTopic      = "topic"
+	FlagFromHeight = "from-height"
+	FlagToHeight   = "to-height"
+
+	FlagDisableStack   = "disable-stack"
+	FlagDisableMemory  = "disable-memory"
+	FlagDisableStorage = "disable-storage"
+
+	// storageDumpPageLimit is the number of storage slots queried at once by the storage dump.
+	storageDumpPageLimit = 1000
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -32,9 +48,25 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountCmd(),
 		GetCmdCode(),
 		GetCmdStorage(),
+		GetCmdStorageDump(),
 		GetCmdAbi(),
+		GetCmdAdmin(),
+		GetCmdVerifiedContract(),
+		GetCmdFrozenContract(),
+		GetCmdFrozenContracts(),
+		GetCmdSponsorship(),
+		GetCmdSponsorUsage(),
+		GetCmdSponsorUsages(),
+		GetCmdScheduledCall(),
+		GetCmdScheduledCalls(),
+		GetCmdSweptFunds(),
+		GetCmdDeployAddress(),
 		GetCmdMeta(),
 		GetCmdView(),
+		GetCmdTraceCall(),
+		GetCmdLogs(),
+		GetCmdReceipt(),
+		GetCmdReceipts(),
 		GetCmdAddressTranslate(),
 	)
 
@@ -100,6 +132,222 @@ func GetCmdView() *cobra.Command {
 	return cmd
 }
 
+// GetCmdTraceCall returns the CVM call trace query command.
+func GetCmdTraceCall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace-call <address> <function> [<params>...]",
+		Short: "Trace a CVM contract call replayed on the state of the queried height",
+		Long: strings.TrimSpace(`Trace a CVM contract call replayed on the state of the queried height.
+The trace is printed as JSON in the struct log format of Ethereum debug traces.
+
+Example:
+$ certik query cvm trace-call <address> transfer 0x1234 10 --caller <caller> --height 1000
+`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			caller, err := cmd.Flags().GetString(FlagCaller)
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(caller); err != nil {
+				return err
+			}
+
+			callee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			_, data, err := parseCallCmd(clientCtx, args[0], callee, args[1], args[2:])
+			if err != nil {
+				return err
+			}
+
+			value, err := cmd.Flags().GetUint64(FlagValue)
+			if err != nil {
+				return err
+			}
+			disableStack, _ := cmd.Flags().GetBool(FlagDisableStack)
+			disableMemory, _ := cmd.Flags().GetBool(FlagDisableMemory)
+			disableStorage, _ := cmd.Flags().GetBool(FlagDisableStorage)
+
+			res, err := queryClient.DebugTraceCall(cmd.Context(), &types.QueryDebugTraceCallRequest{
+				Caller:         caller,
+				Callee:         args[0],
+				Value:          value,
+				Data:           data,
+				DisableStack:   disableStack,
+				DisableMemory:  disableMemory,
+				DisableStorage: disableStorage,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(res.Trace + "\n")
+		},
+	}
+
+	cmd.Flags().String(FlagCaller, "", "caller of the traced call")
+	cmd.Flags().Uint64(FlagValue, 0, "value sent with the traced call")
+	cmd.Flags().Bool(FlagDisableStack, false, "do not record the stack in the trace")
+	cmd.Flags().Bool(FlagDisableMemory, false, "do not record the memory in the trace")
+	cmd.Flags().Bool(FlagDisableStorage, false, "do not record the storage in the trace")
+	_ = cmd.MarkFlagRequired(FlagCaller)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdLogs returns the CVM transaction logs query command.
+func GetCmdLogs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs <tx-hash>",
+		Short: "Get the CVM logs of a transaction decoded with the contract ABIs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			txRes, err := authclient.QueryTx(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			logs := []types.Log{}
+			for _, msgLog := range txRes.Logs {
+				for _, event := range msgLog.Events {
+					if event.Type != types.EventTypeCVMEvent {
+						continue
+					}
+					eventLogs, err := types.ParseLogEvents(event.Attributes)
+					if err != nil {
+						return err
+					}
+					logs = append(logs, eventLogs...)
+				}
+			}
+
+			res, err := queryClient.DecodeLogs(cmd.Context(), &types.QueryDecodeLogsRequest{
+				Logs: logs,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdReceipt returns the CVM receipt query command.
+func GetCmdReceipt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "receipt <tx-hash> [<msg-index>]",
+		Short: "Get the receipt of a CVM message, the first message of the transaction by default",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var msgIndex uint64
+			if len(args) > 1 {
+				if msgIndex, err = strconv.ParseUint(args[1], 10, 32); err != nil {
+					return fmt.Errorf("invalid message index %s: %w", args[1], err)
+				}
+			}
+
+			res, err := queryClient.Receipt(cmd.Context(), &types.QueryReceiptRequest{
+				TxHash:   args[0],
+				MsgIndex: uint32(msgIndex),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdReceipts returns the CVM receipts query command.
+func GetCmdReceipts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "receipts [<flags>]",
+		Short: "List CVM receipts in a height range, optionally filtered by contract address or log topic",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			address, err := cmd.Flags().GetString(FlagAddress)
+			if err != nil {
+				return err
+			}
+			topic, err := cmd.Flags().GetString(FlagTopic)
+			if err != nil {
+				return err
+			}
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+			toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Receipts(cmd.Context(), &types.QueryReceiptsRequest{
+				Address:    address,
+				Topic:      strings.TrimPrefix(topic, "0x"),
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "receipts involving the contract address")
+	cmd.Flags().String(FlagTopic, "", "receipts with a log of the hex encoded topic")
+	cmd.Flags().Int64(FlagFromHeight, 0, "first height of the range")
+	cmd.Flags().Int64(FlagToHeight, 0, "last height of the range, the latest height by default")
+	flags.AddPaginationFlagsToCmd(cmd, "receipts")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdCode returns the CVM code query command.
 func GetCmdCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -167,6 +415,465 @@ func GetCmdStorage() *cobra.Command {
 	return cmd
 }
 
+// GetCmdStorageDump returns the command dumping the storage of a CVM contract, or the changes of the
+// storage between two heights.
+func GetCmdStorageDump() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage-dump <address>",
+		Short: "Dump the storage of a CVM contract, or diff it between two heights",
+		Long: strings.TrimSpace(`Dump the storage of a CVM contract at the --height height, the latest height by default.
+
+With --from-height, output the changes of the storage from that height to the --to-height height,
+the latest height by default, instead.
+
+Example:
+$ certik query cvm storage-dump <address> --height 1000
+$ certik query cvm storage-dump <address> --from-height 1000 --to-height 2000
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+			toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+			if err != nil {
+				return err
+			}
+
+			if fromHeight == 0 {
+				if clientCtx.Height == 0 {
+					if clientCtx.Height, err = latestHeight(cmd.Context(), clientCtx); err != nil {
+						return err
+					}
+				}
+				storage, err := queryContractStorage(cmd.Context(), clientCtx, args[0])
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(&types.QueryContractStorageResponse{Storage: storage})
+			}
+
+			if toHeight == 0 {
+				if toHeight, err = latestHeight(cmd.Context(), clientCtx); err != nil {
+					return err
+				}
+			}
+			from, err := queryContractStorage(cmd.Context(), clientCtx.WithHeight(fromHeight), args[0])
+			if err != nil {
+				return err
+			}
+			to, err := queryContractStorage(cmd.Context(), clientCtx.WithHeight(toHeight), args[0])
+			if err != nil {
+				return err
+			}
+			diff, err := json.Marshal(StorageDiff{
+				Address:    args[0],
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+				Changes:    DiffStorage(from, to),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(diff)
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "height to diff the storage from")
+	cmd.Flags().Int64(FlagToHeight, 0, "height to diff the storage to, the latest height by default")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// latestHeight returns the latest height of the node, at which the pages of a storage are queried
+// rather than at the latest height of each query.
+func latestHeight(ctx context.Context, clientCtx client.Context) (int64, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+	status, err := node.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// queryContractStorage queries all the pages of the storage of a contract.
+func queryContractStorage(ctx context.Context, clientCtx client.Context, address string) ([]types.Storage, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	var storage []types.Storage
+	var key []byte
+	for {
+		res, err := queryClient.ContractStorage(ctx, &types.QueryContractStorageRequest{
+			Address:    address,
+			Pagination: &query.PageRequest{Key: key, Limit: storageDumpPageLimit},
+		})
+		if err != nil {
+			return nil, err
+		}
+		storage = append(storage, res.Storage...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return storage, nil
+		}
+		key = res.Pagination.NextKey
+	}
+}
+
+// GetCmdAdmin returns the CVM contract admin query command.
+func GetCmdAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin <address>",
+		Short: "Get CVM contract admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Admin(cmd.Context(), &types.QueryAdminRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDeployAddress returns the CVM salted deployment address query command.
+func GetCmdDeployAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy-address <deployer> <salt> <code-hash>",
+		Short: "Precompute the address of a CVM contract deployed with a salt",
+		Long: strings.TrimSpace(`Precompute the address of a CVM contract deployed with a salt, and check whether an account already
+exists at the address, in which case the deployment fails. The salt is hex encoded and left-padded to 32 bytes, and the
+code hash is the hex encoded Keccak-256 hash of the deployed code, including the constructor arguments.
+
+Example:
+$ certik query cvm deploy-address <deployer> 0x01 <code-hash>
+`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salt, err := parseSalt(args[1])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DeployAddress(cmd.Context(), &types.QueryDeployAddressRequest{
+				Deployer: args[0],
+				Salt:     hex.EncodeToString(salt),
+				CodeHash: args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdVerifiedContract returns the CVM verified contract query command.
+func GetCmdVerifiedContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verified-contract <address>",
+		Short: "Get the verified source code of a CVM contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VerifiedContract(cmd.Context(), &types.QueryVerifiedContractRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdFrozenContract returns the CVM frozen contract query command.
+func GetCmdFrozenContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-contract <address>",
+		Short: "Get the freeze of a frozen CVM contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FrozenContract(cmd.Context(), &types.QueryFrozenContractRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdFrozenContracts returns the CVM frozen contracts query command.
+func GetCmdFrozenContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-contracts",
+		Short: "Get the freezes of the frozen CVM contracts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FrozenContracts(cmd.Context(), &types.QueryFrozenContractsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen-contracts")
+	return cmd
+}
+
+// GetCmdSponsorship returns the CVM contract sponsorship query command.
+func GetCmdSponsorship() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsorship <address>",
+		Short: "Get the sponsorship of the calls into a CVM contract and the balance of its sponsor",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Sponsorship(cmd.Context(), &types.QuerySponsorshipRequest{Contract: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSponsorUsage returns the CVM contract sponsor usage query command.
+func GetCmdSponsorUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsor-usage <address> <user>",
+		Short: "Get the fees the sponsor of a CVM contract paid for the calls of a user today",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SponsorUsage(cmd.Context(), &types.QuerySponsorUsageRequest{
+				Contract: args[0],
+				User:     args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSponsorUsages returns the CVM contract sponsor usages query command.
+func GetCmdSponsorUsages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsor-usages <address>",
+		Short: "Get the latest fees the sponsor of a CVM contract paid for the calls of each user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SponsorUsages(cmd.Context(), &types.QuerySponsorUsagesRequest{
+				Contract:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sponsor-usages")
+	return cmd
+}
+
+// GetCmdScheduledCall returns the query command of a scheduled call.
+func GetCmdScheduledCall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-call <id>",
+		Short: "Get a scheduled CVM contract call",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ScheduledCall(cmd.Context(), &types.QueryScheduledCallRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdScheduledCalls returns the query command of the scheduled calls.
+func GetCmdScheduledCalls() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-calls",
+		Short: "Get the scheduled CVM contract calls, optionally of a caller",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			caller, err := cmd.Flags().GetString(FlagCaller)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ScheduledCalls(cmd.Context(), &types.QueryScheduledCallsRequest{
+				Caller:     caller,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagCaller, "", "caller of the scheduled calls")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled-calls")
+	return cmd
+}
+
+// GetCmdSweptFunds returns the query command of the coins swept from the zero address.
+func GetCmdSweptFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swept-funds",
+		Short: "Get the total coins swept from the CVM zero address to the community pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SweptFunds(cmd.Context(), &types.QuerySweptFundsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdAbi returns the CVM code ABI query command.
 func GetCmdAbi() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,13 +1,18 @@
 package cli
 
 import (
+	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -16,9 +21,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	authtxb "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/hyperledger/burrow/crypto"
+	burrowerrors "github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs/payload"
@@ -37,6 +45,27 @@ const (
 	FlagEWASM    = "ewasm"
 	FlagRuntime  = "runtime"
 	FlagMetadata = "metadata"
+	FlagAdmin    = "admin"
+	FlagSalt     = "salt"
+
+	FlagSolc      = "solc"
+	FlagOptimize  = "optimize"
+	FlagLibraries = "libraries"
+
+	FlagAcceptRisk = "accept-risk"
+	FlagAtomic     = "atomic"
+
+	FlagCompilerVersion  = "compiler-version"
+	FlagCompilerSettings = "compiler-settings"
+
+	FlagSelectors = "selectors"
+	FlagExpiry    = "expiry"
+
+	FlagInterval   = "interval"
+	FlagMaxRuns    = "max-runs"
+	FlagGasLimit   = "gas-limit"
+	FlagPrepaidGas = "prepaid-gas"
+	FlagPrepaidFee = "prepaid-fee"
 )
 
 var (
@@ -57,7 +86,16 @@ func NewTxCmd() *cobra.Command {
 
 	ctkTxCmd.AddCommand(
 		GetCmdCall(),
+		GetCmdMultiCall(),
 		GetCmdDeploy(),
+		GetCmdMigrate(),
+		GetCmdVerifyContract(),
+		GetCmdFreezeContract(),
+		GetCmdUnfreezeContract(),
+		GetCmdSetSponsorship(),
+		GetCmdRevokeSponsorship(),
+		GetCmdScheduleCall(),
+		GetCmdCancelScheduledCall(),
 	)
 
 	return ctkTxCmd
@@ -145,19 +183,163 @@ func GetCmdCall() *cobra.Command {
 			}
 			value := viper.GetUint64(FlagValue)
 			msg := types.NewMsgCall(from.String(), callee.String(), value, data)
+			msg.AcceptRisk = viper.GetBool(FlagAcceptRisk)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, &msg)
+			if err := checkSimulation(clientCtx, txf, &types.QuerySimulateRequest{
+				Caller: msg.Caller,
+				Callee: msg.Callee,
+				Value:  msg.Value,
+				Data:   msg.Data,
+			}); err != nil {
+				return err
+			}
+			return broadcastCallTx(clientCtx, txf, &msg)
 		},
 	}
 	cmd.Flags().Bool(FlagRaw, false,
 		"set this flag to submit raw calldata, otherwise it takes function name and parameters as args")
 	cmd.Flags().Uint64(FlagValue, 0, "Value sent with transaction")
+	cmd.Flags().Bool(FlagAcceptRisk, false,
+		"call the contract even if the security oracle scores it below the threshold score")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// GetCmdMultiCall returns the CVM multi-call transaction command.
+func GetCmdMultiCall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-call <calls-file>",
+		Short: "Call CVM contracts in order in a single message",
+		Long: strings.TrimSpace(`Call CVM contracts in order in a single message, whose calls share the gas of the transaction.
+The calls are read from a JSON file, where each call has either hex encoded raw call data or a function and its
+arguments encoded with the ABI of the callee. The failed calls are skipped, and their state changes discarded, unless
+--atomic is set, in which case the first failed call fails the whole message and its failure is printed
+instead of the results.
+
+Example:
+$ certik tx cvm multi-call calls.json --atomic --from <key>
+
+Where calls.json contains:
+[
+  {
+    "callee": "certik1fdyv6hpukqj6kqdtwc42qacq9lpxm0pn85w6l9",
+    "function": "approve",
+    "args": ["certik1rhu6cj4g3m6gpc7xhjjz7kzc6rrzqn0dfvh7zf", "100"]
+  },
+  {
+    "callee": "certik1rhu6cj4g3m6gpc7xhjjz7kzc6rrzqn0dfvh7zf",
+    "value": 10,
+    "data": "d0e30db0"
+  }
+]
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries, err := ParseMultiCallJSON(args[0])
+			if err != nil {
+				return err
+			}
+			calls := make([]types.MultiCallEntry, len(entries))
+			for i, entry := range entries {
+				callee, err := sdk.AccAddressFromBech32(entry.Callee)
+				if err != nil {
+					return err
+				}
+				var data []byte
+				switch {
+				case entry.Function != "" && entry.Data != "":
+					return fmt.Errorf("call %d has both raw data and a function", i)
+				case entry.Function != "":
+					if _, data, err = parseCallCmd(clientCtx, entry.Callee, callee, entry.Function, entry.Args); err != nil {
+						return err
+					}
+				default:
+					if data, err = hex.DecodeString(strings.TrimPrefix(entry.Data, "0x")); err != nil {
+						return err
+					}
+				}
+				calls[i] = types.MultiCallEntry{Callee: entry.Callee, Value: entry.Value, Data: data}
+			}
+			atomic, err := cmd.Flags().GetBool(FlagAtomic)
+			if err != nil {
+				return err
+			}
+			acceptRisk, err := cmd.Flags().GetBool(FlagAcceptRisk)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMultiCall(clientCtx.GetFromAddress().String(), calls, atomic)
+			msg.AcceptRisk = acceptRisk
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return broadcastCallTx(clientCtx, tx.NewFactoryCLI(clientCtx, cmd.Flags()), &msg)
+		},
+	}
+	cmd.Flags().Bool(FlagAtomic, false, "fail the whole message if any call fails")
+	cmd.Flags().Bool(FlagAcceptRisk, false,
+		"call the contracts even if the security oracle scores them below the threshold score")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// checkSimulation dry-runs the CVM execution when the gas is estimated with --gas auto, so that
+// a reverted execution is reported with its failure instead of a failed gas estimation.
+func checkSimulation(clientCtx client.Context, txf tx.Factory, req *types.QuerySimulateRequest) error {
+	if !txf.SimulateAndExecute() {
+		return nil
+	}
+	res, err := types.NewQueryClient(clientCtx).Simulate(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	if !res.Reverted {
+		return nil
+	}
+	failure := types.NewCallFailure(burrowerrors.Codes.ExecutionReverted, res.ReturnData)
+	if err := clientCtx.PrintProto(failure); err != nil {
+		return err
+	}
+	return failure
+}
+
+// broadcastCallTx generates or broadcasts a transaction of CVM calls like
+// tx.GenerateOrBroadcastTxWithFactory does. Failed transactions have no message responses, so the
+// failure of a failed call is decoded from the error of its transaction and printed after it.
+func broadcastCallTx(clientCtx client.Context, txf tx.Factory, msgs ...sdk.Msg) error {
+	if clientCtx.GenerateOnly {
+		return tx.GenerateTx(clientCtx, txf, msgs...)
+	}
+	var out bytes.Buffer
+	if err := tx.BroadcastTx(clientCtx.WithOutput(&out).WithOutputFormat("json"), txf, msgs...); err != nil {
+		return err
+	}
+	if out.Len() == 0 {
+		// The transaction was only simulated, or was not confirmed.
+		return nil
+	}
+	var res sdk.TxResponse
+	if err := clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &res); err != nil {
+		return err
+	}
+	if err := clientCtx.PrintProto(&res); err != nil {
+		return err
+	}
+	if failure := types.ParseCallFailure(res.Codespace, res.Code, res.RawLog); failure != nil {
+		return clientCtx.PrintProto(failure)
+	}
+	return nil
+}
+
 func parseCallCmd(cliCtx client.Context, calleeString string, calleeAddr sdk.AccAddress, function string, args []string) ([]byte, []byte, error) {
 	accGetter := authtxb.AccountRetriever{}
 	if err := accGetter.EnsureExists(cliCtx, calleeAddr); err != nil {
@@ -193,7 +375,16 @@ func GetCmdDeploy() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "deploy <filename>",
 		Short: "Deploy CVM contract(s)",
-		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`Deploy a CVM contract from a file of hex encoded bytecode, or from a Solidity file.
+Solidity .sol files are compiled with the standard JSON interface of the solc binary at --solc, and the contract to
+deploy is selected with --contract when the file has several. The addresses of the libraries the contract uses are
+linked with --libraries, and the ABI and the metadata of the compiled contracts are deployed along with it. The
+imports of the file are only read from its directory.
+
+Example:
+$ certik tx cvm deploy Token.sol --contract Token --libraries SafeMath:<address> --args 1000 --from <key>
+`),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, file []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -212,6 +403,18 @@ func GetCmdDeploy() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			for _, msg := range msgs {
+				deploy := msg.(*types.MsgDeploy)
+				if err := checkSimulation(clientCtx, txf, &types.QuerySimulateRequest{
+					Caller:    deploy.Caller,
+					Value:     deploy.Value,
+					Data:      deploy.Code,
+					IsEwasm:   deploy.IsEWASM,
+					IsRuntime: deploy.IsRuntime,
+				}); err != nil {
+					return err
+				}
+			}
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msgs...)
 		},
 	}
@@ -221,59 +424,643 @@ func GetCmdDeploy() *cobra.Command {
 	cmd.Flags().Bool(FlagEWASM, false, "compile solidity contract to EWASM")
 	cmd.Flags().Bool(FlagRuntime, false, "runtime code")
 	cmd.Flags().String(FlagMetadata, "", "the metadata files to be deployed along with the contract")
+	cmd.Flags().String(FlagContract, "", "name of the contract to deploy from a .sol file, optionally as <file>:<name>")
+	cmd.Flags().String(FlagSolc, compile.DefaultSolc, "path of the solc binary compiling .sol files")
+	cmd.Flags().Bool(FlagOptimize, false, "enable the solc optimizer")
+	cmd.Flags().String(FlagLibraries, "",
+		"addresses of the libraries linked into the contract of a .sol file, as <name>:<address>,...")
+	cmd.Flags().String(FlagAdmin, "", "optional admin allowed to migrate the contract code")
+	cmd.Flags().String(FlagSalt, "",
+		"optional hex encoded salt of up to 32 bytes deriving the contract address from the code like CREATE2")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-func appendDeployMsgs(cmd *cobra.Command, fileName string) ([]sdk.Msg, error) {
-	var msgs []sdk.Msg
-	clientCtx, err := client.GetClientTxContext(cmd)
-	if err != nil {
-		return []sdk.Msg{}, err
+// GetCmdMigrate returns the CVM contract migration transaction command.
+func GetCmdMigrate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate <address> <filename>",
+		Short: "Replace the code of a CVM contract with the runtime code in a file, keeping its storage",
+		Long: strings.TrimSpace(`Replace the code of a CVM contract with the hex encoded runtime code in a file.
+The storage and the balance of the contract are kept. The sender must be the admin of the contract.
+
+Example:
+$ certik tx cvm migrate <address> runtime.bytecode --abi runtime.abi --from <admin>
+`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			code, abiBytes, metas, err := readRuntimeCode(cmd, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMigrate(clientCtx.GetFromAddress().String(), args[0], code, string(abiBytes), metas)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
 	}
+	cmd.Flags().String(FlagABI, "", "name of ABI file of the new code")
+	cmd.Flags().String(FlagMetadata, "", "the metadata file of the new code")
+	flags.AddTxFlagsToCmd(cmd)
 
-	argsRaw := viper.GetString(FlagArgs)
-	arguments := strings.Split(argsRaw, ",")
-	code, err := ioutil.ReadFile(fileName)
-	codeStr := strings.Trim(string(code), "\n")
+	return cmd
+}
+
+// GetCmdVerifyContract returns the CVM contract verification transaction command.
+func GetCmdVerifyContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <address> <certificate-id> <source-file>...",
+		Short: "Submit the source code of a CVM contract bound to the compilation certificate of its code",
+		Long: strings.TrimSpace(`Submit the source files of a CVM contract with the compiler version and settings they were
+compiled with. The compilation certificate must certify the code hash of the contract and the compiler version.
+The sender must be the deployer or the admin of the contract, or a certifier. The source files are named as given.
+
+Example:
+$ certik tx cvm verify <address> 1 contracts/Token.sol contracts/Ownable.sol --compiler-version 0.8.4 \
+  --compiler-settings settings.json --from <admin>
+`),
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			certificateID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid certificate ID %s: %w", args[1], err)
+			}
+			var sourceFiles []types.SourceFile
+			for _, name := range args[2:] {
+				content, err := ioutil.ReadFile(name)
+				if err != nil {
+					return err
+				}
+				sourceFiles = append(sourceFiles, types.SourceFile{Name: name, Content: string(content)})
+			}
+			compilerVersion, err := cmd.Flags().GetString(FlagCompilerVersion)
+			if err != nil {
+				return err
+			}
+			var compilerSettings string
+			settingsFile, err := cmd.Flags().GetString(FlagCompilerSettings)
+			if err != nil {
+				return err
+			}
+			if settingsFile != "" {
+				settings, err := ioutil.ReadFile(settingsFile)
+				if err != nil {
+					return err
+				}
+				compilerSettings = string(settings)
+			}
+
+			msg := types.NewMsgVerifyContract(clientCtx.GetFromAddress().String(), args[0], sourceFiles,
+				compilerVersion, compilerSettings, certificateID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().String(FlagCompilerVersion, "", "version of the compiler the source files were compiled with")
+	cmd.Flags().String(FlagCompilerSettings, "", "JSON file of the compiler settings the source files were compiled with")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFreezeContract returns the CVM contract freeze transaction command.
+func GetCmdFreezeContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze <address> <reason>",
+		Short: "Freeze a CVM contract, so that its calls are rejected",
+		Long: strings.TrimSpace(`Freeze a CVM contract, e.g. while it is being exploited, so that its calls are rejected,
+including the calls from other contracts. The sender must be a certifier, and the freeze expires after the
+certifier freeze duration unless the governance takes it over.
+
+Example:
+$ certik tx cvm freeze <address> "the token contract is being exploited" --from <certifier>
+`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFreezeContract(clientCtx.GetFromAddress().String(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUnfreezeContract returns the CVM contract unfreeze transaction command.
+func GetCmdUnfreezeContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze <address>",
+		Short: "Unfreeze a CVM contract frozen by a certifier",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnfreezeContract(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSetSponsorship returns the CVM contract sponsorship transaction command.
+func GetCmdSetSponsorship() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsor <address> <sponsor> <daily-cap>",
+		Short: "Sponsor the fees of the calls into a CVM contract",
+		Long: strings.TrimSpace(`Set the sponsor paying the fees of the calls into a CVM contract, replacing its previous
+sponsorship. The fees of the transactions whose messages are all calls into the contract are deducted from the
+sponsor instead of the caller, up to the daily cap of each caller, for the functions of the --selectors
+selectors if set. The sponsor stops paying at the --expiry time if set. The callers still need accounts
+to sign their transactions, though their accounts need no balance.
+
+The sender must be the admin of the contract, and the sponsor signs the transaction as well if it is
+another account, e.g. by signing the transaction generated with --generate-only in turn.
+
+Example:
+$ certik tx cvm sponsor <address> <sponsor> 10000uctk --selectors 0xa9059cbb,0x095ea7b3 --expiry 2022-01-01T00:00:00Z --from <admin>
+`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			dailyCap, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+			selectors, err := cmd.Flags().GetStringSlice(FlagSelectors)
+			if err != nil {
+				return err
+			}
+			var expiry time.Time
+			if value, _ := cmd.Flags().GetString(FlagExpiry); value != "" {
+				if expiry, err = time.Parse(time.RFC3339, value); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetSponsorship(clientCtx.GetFromAddress().String(), args[0], args[1], dailyCap, selectors, expiry)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().StringSlice(FlagSelectors, nil, "function selectors of the sponsored calls, all the calls if empty")
+	cmd.Flags().String(FlagExpiry, "", "RFC 3339 time the sponsorship expires at")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRevokeSponsorship returns the CVM contract sponsorship revocation transaction command.
+func GetCmdRevokeSponsorship() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-sponsorship <address>",
+		Short: "Revoke the sponsorship of the calls into a CVM contract, as its admin or sponsor",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeSponsorship(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdScheduleCall returns the CVM scheduled call transaction command.
+func GetCmdScheduleCall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule <address> <function> [<params>...]",
+		Short: "Schedule a CVM contract call run periodically",
+		Long: strings.TrimSpace(`Schedule a CVM contract call run at the beginning of a block every --interval blocks, the first
+of which is an interval after the transaction, up to --max-runs times if set. Each run is limited to --gas-limit gas,
+and the runs are paid with --prepaid-gas gas, whose --prepaid-fee is held in escrow. Each run pays its share of the
+fee for the gas it uses, and the fee left is refunded when the call completes, expires or is canceled. The call
+expires when its prepaid gas does not cover another run.
+
+Example:
+$ certik tx cvm schedule <address> rebase --interval 100 --max-runs 10 --gas-limit 200000 --prepaid-gas 2000000 --prepaid-fee 2000uctk --from <key>
+`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			callee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			var data []byte
+			if raw, _ := cmd.Flags().GetBool(FlagRaw); raw {
+				if len(args) > 2 {
+					return errors.New("cvm schedule with --raw flag should only have one argument (raw calldata)")
+				}
+				if data, err = hex.DecodeString(args[1]); err != nil {
+					return err
+				}
+			} else if _, data, err = parseCallCmd(clientCtx, args[0], callee, args[1], args[2:]); err != nil {
+				return err
+			}
+			interval, err := cmd.Flags().GetUint64(FlagInterval)
+			if err != nil {
+				return err
+			}
+			maxRuns, err := cmd.Flags().GetUint64(FlagMaxRuns)
+			if err != nil {
+				return err
+			}
+			gasLimit, err := cmd.Flags().GetUint64(FlagGasLimit)
+			if err != nil {
+				return err
+			}
+			prepaidGas, err := cmd.Flags().GetUint64(FlagPrepaidGas)
+			if err != nil {
+				return err
+			}
+			prepaidFeeStr, err := cmd.Flags().GetString(FlagPrepaidFee)
+			if err != nil {
+				return err
+			}
+			prepaidFee, err := sdk.ParseCoinsNormalized(prepaidFeeStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgScheduleCall(clientCtx.GetFromAddress().String(), callee.String(), data, interval, maxRuns,
+				gasLimit, prepaidGas, prepaidFee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Bool(FlagRaw, false,
+		"set this flag to submit hex encoded raw calldata, otherwise it takes function name and parameters as args")
+	cmd.Flags().Uint64(FlagInterval, 0, "number of blocks between the runs of the call")
+	cmd.Flags().Uint64(FlagMaxRuns, 0, "maximum number of runs of the call, unlimited if zero")
+	cmd.Flags().Uint64(FlagGasLimit, 0, "gas limit of each run")
+	cmd.Flags().Uint64(FlagPrepaidGas, 0, "gas paid for the runs")
+	cmd.Flags().String(FlagPrepaidFee, "", "fee paid for the prepaid gas, held in escrow")
+	_ = cmd.MarkFlagRequired(FlagInterval)
+	_ = cmd.MarkFlagRequired(FlagGasLimit)
+	_ = cmd.MarkFlagRequired(FlagPrepaidGas)
+	_ = cmd.MarkFlagRequired(FlagPrepaidFee)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCancelScheduledCall returns the CVM scheduled call cancellation transaction command.
+func GetCmdCancelScheduledCall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-call <id>",
+		Short: "Cancel a scheduled CVM contract call, as its caller",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCancelScheduledCall(clientCtx.GetFromAddress().String(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitProposal implements the command to submit a contract migration proposal.
+func GetCmdSubmitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-migration <proposal-file>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a contract migration proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal replacing the code of a CVM contract along with an initial deposit.
+The storage and the balance of the contract are kept. The proposal details must be supplied via a JSON file,
+where the code is the hex encoded runtime code.
+
+Example:
+$ %s tx gov submit-proposal contract-migration <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+  "title": "Fix the token contract",
+  "description": "Why the token contract should be fixed",
+  "contract": "certik1fdyv6hpukqj6kqdtwc42qacq9lpxm0pn85w6l9",
+  "code": "6080604052...",
+  "abi": "[...]",
+  "deposit": [
+    {
+      "denom": "uctk",
+      "amount": "100"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseContractMigrationProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+			code, err := hex.DecodeString(strings.TrimPrefix(proposal.Code, "0x"))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewContractMigrationProposal(proposal.Title, proposal.Description, proposal.Contract, code, proposal.Abi, nil)
+			msg, err := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitFreezeProposal implements the command to submit a contract freeze proposal.
+func GetCmdSubmitFreezeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-freeze <proposal-file>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a contract freeze proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal freezing a CVM contract along with an initial deposit, so that its calls are
+rejected. The freeze replaces the freeze of a certifier. The proposal details must be supplied via a JSON file,
+where an empty duration freezes the contract until it is unfrozen.
+
+Example:
+$ %s tx gov submit-proposal contract-freeze <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+  "title": "Freeze the token contract",
+  "description": "Why the token contract should be frozen",
+  "contract": "certik1fdyv6hpukqj6kqdtwc42qacq9lpxm0pn85w6l9",
+  "reason": "the token contract is being exploited",
+  "duration": "720h",
+  "deposit": [
+    {
+      "denom": "uctk",
+      "amount": "100"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseContractFreezeProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+			var duration time.Duration
+			if proposal.Duration != "" {
+				if duration, err = time.ParseDuration(proposal.Duration); err != nil {
+					return err
+				}
+			}
+
+			content := types.NewContractFreezeProposal(proposal.Title, proposal.Description, proposal.Contract, proposal.Reason, duration)
+			msg, err := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitUnfreezeProposal implements the command to submit a contract unfreeze proposal.
+func GetCmdSubmitUnfreezeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-unfreeze <proposal-file>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a contract unfreeze proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal unfreezing a frozen CVM contract along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal contract-unfreeze <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+  "title": "Unfreeze the token contract",
+  "description": "Why the token contract should be unfrozen",
+  "contract": "certik1fdyv6hpukqj6kqdtwc42qacq9lpxm0pn85w6l9",
+  "deposit": [
+    {
+      "denom": "uctk",
+      "amount": "100"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseContractUnfreezeProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewContractUnfreezeProposal(proposal.Title, proposal.Description, proposal.Contract)
+			msg, err := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// readRuntimeCode reads hex encoded runtime code from a file, along with the ABI and metadata files set by the flags.
+func readRuntimeCode(cmd *cobra.Command, fileName string) ([]byte, []byte, []*payload.ContractMeta, error) {
+	bz, err := ioutil.ReadFile(fileName)
 	if err != nil {
-		return msgs, err
+		return nil, nil, nil, err
 	}
-	code, err = hex.DecodeString(codeStr)
+	code, err := hex.DecodeString(strings.TrimSpace(string(bz)))
 	if err != nil {
-		return msgs, err
+		return nil, nil, nil, err
+	}
+
+	var abiBytes []byte
+	abiFile, err := cmd.Flags().GetString(FlagABI)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if abiFile != "" {
+		if abiBytes, err = ioutil.ReadFile(abiFile); err != nil {
+			return nil, nil, nil, err
+		}
 	}
-	codehash := crypto.Keccak256(code)
 
-	value := viper.GetUint64(FlagValue)
-	metadataFile := viper.GetString(FlagMetadata)
 	var metas []*payload.ContractMeta
+	metadataFile, err := cmd.Flags().GetString(FlagMetadata)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 	if metadataFile != "" {
-		metadataBytes, err := ioutil.ReadFile(metadataFile)
+		metadata, err := ioutil.ReadFile(metadataFile)
 		if err != nil {
-			return msgs, err
+			return nil, nil, nil, err
 		}
-		metadataString := string(metadataBytes)
 		metas = append(metas, &payload.ContractMeta{
-			CodeHash: codehash,
-			Meta:     metadataString,
+			CodeHash: crypto.Keccak256(code),
+			Meta:     string(metadata),
 		})
 	}
+	return code, abiBytes, metas, nil
+}
 
-	abiFile, err := cmd.Flags().GetString(FlagABI)
+func appendDeployMsgs(cmd *cobra.Command, fileName string) ([]sdk.Msg, error) {
+	var msgs []sdk.Msg
+	clientCtx, err := client.GetClientTxContext(cmd)
 	if err != nil {
-		return msgs, err
+		return []sdk.Msg{}, err
 	}
-	var abiBytes []byte
-	if abiFile != "" {
-		abiBytes, err = ioutil.ReadFile(abiFile)
+
+	argsRaw := viper.GetString(FlagArgs)
+	arguments := strings.Split(argsRaw, ",")
+	value := viper.GetUint64(FlagValue)
+	logger := logging.NewNoopLogger()
+
+	var code, abiBytes []byte
+	var metas []*payload.ContractMeta
+	if filepath.Ext(fileName) == ".sol" {
+		if code, abiBytes, metas, err = compileSolidity(cmd, fileName, logger); err != nil {
+			return msgs, err
+		}
+	} else {
+		code, err = ioutil.ReadFile(fileName)
+		codeStr := strings.Trim(string(code), "\n")
 		if err != nil {
 			return msgs, err
 		}
-	}
+		code, err = hex.DecodeString(codeStr)
+		if err != nil {
+			return msgs, err
+		}
+		codehash := crypto.Keccak256(code)
 
-	logger := logging.NewNoopLogger()
+		metadataFile := viper.GetString(FlagMetadata)
+		if metadataFile != "" {
+			metadataBytes, err := ioutil.ReadFile(metadataFile)
+			if err != nil {
+				return msgs, err
+			}
+			metadataString := string(metadataBytes)
+			metas = append(metas, &payload.ContractMeta{
+				CodeHash: codehash,
+				Meta:     metadataString,
+			})
+		}
+
+		abiFile, err := cmd.Flags().GetString(FlagABI)
+		if err != nil {
+			return msgs, err
+		}
+		if abiFile != "" {
+			abiBytes, err = ioutil.ReadFile(abiFile)
+			if err != nil {
+				return msgs, err
+			}
+		}
+	}
 
 	if len(argsRaw) > 0 {
 		callArgsBytes, err := parseData("", abiBytes, arguments, logger)
@@ -285,6 +1072,12 @@ func appendDeployMsgs(cmd *cobra.Command, fileName string) ([]sdk.Msg, error) {
 	isEWASM := viper.GetBool(FlagEWASM)
 	isRuntime := viper.GetBool(FlagRuntime)
 	msg := types.NewMsgDeploy(clientCtx.GetFromAddress().String(), value, code, string(abiBytes), metas, isEWASM, isRuntime)
+	msg.Admin = viper.GetString(FlagAdmin)
+	if salt := viper.GetString(FlagSalt); salt != "" {
+		if msg.Salt, err = parseSalt(salt); err != nil {
+			return msgs, err
+		}
+	}
 	if err := msg.ValidateBasic(); err != nil {
 		return msgs, err
 	}
@@ -292,6 +1085,46 @@ func appendDeployMsgs(cmd *cobra.Command, fileName string) ([]sdk.Msg, error) {
 	return msgs, nil
 }
 
+// compileSolidity compiles a Solidity file with solc and returns the linked bytecode, the ABI and the
+// metadata of the contract selected by the flags.
+func compileSolidity(cmd *cobra.Command, fileName string, logger *logging.Logger) ([]byte, []byte, []*payload.ContractMeta, error) {
+	if viper.GetBool(FlagEWASM) || viper.GetBool(FlagRuntime) {
+		return nil, nil, nil, errors.New("solidity files can only be deployed as EVM creation code")
+	}
+	libraries, err := parseLibraries(viper.GetString(FlagLibraries))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	basename, workDir, err := compile.ResolveFilename(fileName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	resp, err := compile.SolidityEVM(viper.GetString(FlagSolc), basename, workDir, viper.GetBool(FlagOptimize), libraries,
+		logger)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if resp.Warning != "" {
+		_, _ = fmt.Fprintln(cmd.ErrOrStderr(), resp.Warning)
+	}
+	object, err := compile.SelectContract(resp, viper.GetString(FlagContract))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := compile.LinkContract(&object.Contract, libraries); err != nil {
+		return nil, nil, nil, fmt.Errorf("linking %s: %w", object.Objectname, err)
+	}
+	code, err := hex.DecodeString(object.Contract.Evm.Bytecode.Object)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	metas, err := compile.ContractMetas(&object.Contract, logger)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return code, object.Contract.Abi, metas, nil
+}
+
 func parseData(function string, abiSpec []byte, args []string, logger *logging.Logger) ([]byte, error) {
 	var params []interface{}
 
@@ -0,0 +1,205 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+type (
+	// ContractMigrationProposalJSON defines a ContractMigrationProposal with a deposit.
+	// The code is the hex encoded runtime code.
+	ContractMigrationProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		Contract    string    `json:"contract" yaml:"contract"`
+		Code        string    `json:"code" yaml:"code"`
+		Abi         string    `json:"abi" yaml:"abi"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
+
+	// ContractFreezeProposalJSON defines a ContractFreezeProposal with a deposit. The duration is
+	// parsed by time.ParseDuration, and an empty duration freezes the contract until it is unfrozen.
+	ContractFreezeProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		Contract    string    `json:"contract" yaml:"contract"`
+		Reason      string    `json:"reason" yaml:"reason"`
+		Duration    string    `json:"duration" yaml:"duration"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
+
+	// ContractUnfreezeProposalJSON defines a ContractUnfreezeProposal with a deposit.
+	ContractUnfreezeProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		Contract    string    `json:"contract" yaml:"contract"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
+
+	// MultiCallJSON defines a call of a multi-call message, whose data is either hex encoded raw
+	// call data or encoded from a function and its arguments with the ABI of the callee.
+	MultiCallJSON struct {
+		Callee   string   `json:"callee" yaml:"callee"`
+		Value    uint64   `json:"value" yaml:"value"`
+		Data     string   `json:"data" yaml:"data"`
+		Function string   `json:"function" yaml:"function"`
+		Args     []string `json:"args" yaml:"args"`
+	}
+
+	// StorageDiff defines the changes of the storage of a contract between two heights.
+	StorageDiff struct {
+		Address    string          `json:"address" yaml:"address"`
+		FromHeight int64           `json:"from_height" yaml:"from_height"`
+		ToHeight   int64           `json:"to_height" yaml:"to_height"`
+		Changes    []StorageChange `json:"changes" yaml:"changes"`
+	}
+
+	// StorageChange defines the change of a storage slot, where the hex encoded values are empty for
+	// the slots that are not set.
+	StorageChange struct {
+		Key  string `json:"key" yaml:"key"`
+		From string `json:"from" yaml:"from"`
+		To   string `json:"to" yaml:"to"`
+	}
+)
+
+// DiffStorage returns the changes from a storage to another in key order.
+func DiffStorage(from, to []types.Storage) []StorageChange {
+	values := make(map[string][]byte)
+	for _, slot := range from {
+		values[slot.Key.String()] = slot.Value
+	}
+	changes := []StorageChange{}
+	for _, slot := range to {
+		key := slot.Key.String()
+		if value, ok := values[key]; !ok || !bytes.Equal(value, slot.Value) {
+			changes = append(changes, StorageChange{Key: key, From: encodeHex(value), To: encodeHex(slot.Value)})
+		}
+		delete(values, key)
+	}
+	for _, slot := range from {
+		if value, ok := values[slot.Key.String()]; ok {
+			changes = append(changes, StorageChange{Key: slot.Key.String(), From: encodeHex(value)})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+// parseSalt parses a hex encoded salt of up to 32 bytes, left-padded to 32 bytes.
+func parseSalt(salt string) ([]byte, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(salt, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) > binary.Word256Bytes {
+		return nil, fmt.Errorf("salt %s is longer than %d bytes", salt, binary.Word256Bytes)
+	}
+	return binary.LeftPadBytes(bz, binary.Word256Bytes), nil
+}
+
+// parseLibraries parses the addresses of libraries by name from a comma separated list of
+// <name>:<address>, where the addresses are either bech32 or hex encoded.
+func parseLibraries(libraries string) (map[string]crypto.Address, error) {
+	addresses := make(map[string]crypto.Address)
+	if libraries == "" {
+		return addresses, nil
+	}
+	for _, library := range strings.Split(libraries, ",") {
+		parts := strings.SplitN(strings.TrimSpace(library), ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid library %s, expected <name>:<address>", library)
+		}
+		if addr, err := sdk.AccAddressFromBech32(parts[1]); err == nil {
+			addresses[parts[0]] = crypto.MustAddressFromBytes(addr)
+			continue
+		}
+		address, err := crypto.AddressFromHexString(strings.TrimPrefix(parts[1], "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid address of library %s: %w", parts[0], err)
+		}
+		addresses[parts[0]] = address
+	}
+	return addresses, nil
+}
+
+func encodeHex(bz []byte) string {
+	return strings.ToUpper(hex.EncodeToString(bz))
+}
+
+// ParseContractMigrationProposalJSON reads and parses a ContractMigrationProposalJSON from a file.
+func ParseContractMigrationProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (ContractMigrationProposalJSON, error) {
+	proposal := ContractMigrationProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseContractFreezeProposalJSON reads and parses a ContractFreezeProposalJSON from a file.
+func ParseContractFreezeProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (ContractFreezeProposalJSON, error) {
+	proposal := ContractFreezeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseContractUnfreezeProposalJSON reads and parses a ContractUnfreezeProposalJSON from a file.
+func ParseContractUnfreezeProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (ContractUnfreezeProposalJSON, error) {
+	proposal := ContractUnfreezeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseMultiCallJSON reads and parses the MultiCallJSON calls of a multi-call from a file.
+func ParseMultiCallJSON(callsFile string) ([]MultiCallJSON, error) {
+	var calls []MultiCallJSON
+
+	contents, err := ioutil.ReadFile(callsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(contents, &calls); err != nil {
+		return nil, err
+	}
+
+	return calls, nil
+}
@@ -0,0 +1,376 @@
+package ethrpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/burrow/binary"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+func (s *Server) clientVersion([]json.RawMessage) (interface{}, error) {
+	return fmt.Sprintf("%s/%s", version.AppName, version.Version), nil
+}
+
+func (s *Server) netVersion([]json.RawMessage) (interface{}, error) {
+	return strconv.FormatUint(s.chainID, 10), nil
+}
+
+func (s *Server) chainIDHex([]json.RawMessage) (interface{}, error) {
+	return encodeQuantity(s.chainID), nil
+}
+
+func (s *Server) blockNumber([]json.RawMessage) (interface{}, error) {
+	height, err := rpc.GetChainHeight(s.clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	return encodeQuantity(uint64(height)), nil
+}
+
+// getBalance returns the balance of an account in the bond denom.
+func (s *Server) getBalance(params []json.RawMessage) (interface{}, error) {
+	addr, err := addressParam(params, 0)
+	if err != nil {
+		return nil, err
+	}
+	height, err := blockParam(params, 1)
+	if err != nil {
+		return nil, err
+	}
+	clientCtx := s.clientCtx.WithHeight(height)
+
+	stakingParams, err := stakingtypes.NewQueryClient(clientCtx).Params(context.Background(), &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	res, err := banktypes.NewQueryClient(clientCtx).Balance(context.Background(), &banktypes.QueryBalanceRequest{
+		Address: addr.String(),
+		Denom:   stakingParams.Params.BondDenom,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return encodeBigQuantity(res.Balance.Amount.BigInt()), nil
+}
+
+func (s *Server) getCode(params []json.RawMessage) (interface{}, error) {
+	addr, err := addressParam(params, 0)
+	if err != nil {
+		return nil, err
+	}
+	height, err := blockParam(params, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := types.NewQueryClient(s.clientCtx.WithHeight(height)).Code(context.Background(), &types.QueryCodeRequest{
+		Address: addr.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return "0x" + res.Code, nil
+}
+
+func (s *Server) getStorageAt(params []json.RawMessage) (interface{}, error) {
+	addr, err := addressParam(params, 0)
+	if err != nil {
+		return nil, err
+	}
+	var position string
+	if err := param(params, 1, &position); err != nil {
+		return nil, err
+	}
+	key, ok := new(big.Int).SetString(position, 0)
+	if !ok || key.Sign() < 0 || key.BitLen() > 256 {
+		return nil, invalidParams("invalid storage position %s", position)
+	}
+	height, err := blockParam(params, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := types.NewQueryClient(s.clientCtx.WithHeight(height)).Storage(context.Background(), &types.QueryStorageRequest{
+		Address: addr.String(),
+		Key:     binary.LeftPadWord256(key.Bytes()).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return encodeBytes(binary.LeftPadWord256(res.Value).Bytes()), nil
+}
+
+// call runs a read-only call against a contract. A missing sender defaults to the zero address.
+func (s *Server) call(params []json.RawMessage) (interface{}, error) {
+	var args callArgs
+	if err := param(params, 0, &args); err != nil {
+		return nil, err
+	}
+	if args.To == "" {
+		return nil, invalidParams("contract creation is not supported by eth_call")
+	}
+	callee, err := parseAddress(args.To)
+	if err != nil {
+		return nil, err
+	}
+	caller := make(sdk.AccAddress, sdk.AddrLen)
+	if args.From != "" {
+		if caller, err = parseAddress(args.From); err != nil {
+			return nil, err
+		}
+	}
+	data, err := args.data()
+	if err != nil {
+		return nil, err
+	}
+	height, err := blockParam(params, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := types.NewQueryClient(s.clientCtx.WithHeight(height)).View(context.Background(), &types.QueryViewRequest{
+		Caller: caller.String(),
+		Callee: callee.String(),
+		Data:   data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return encodeBytes(res.ReturnData), nil
+}
+
+// estimateGas simulates the call, or the deployment if no recipient is given, as a
+// transaction and returns the SDK gas it consumes.
+func (s *Server) estimateGas(params []json.RawMessage) (interface{}, error) {
+	var args callArgs
+	if err := param(params, 0, &args); err != nil {
+		return nil, err
+	}
+	if args.From == "" {
+		return nil, invalidParams("the sender is required to estimate gas")
+	}
+	caller, err := parseAddress(args.From)
+	if err != nil {
+		return nil, err
+	}
+	var value uint64
+	if args.Value != "" {
+		if value, err = parseQuantity(args.Value); err != nil {
+			return nil, err
+		}
+	}
+	data, err := args.data()
+	if err != nil {
+		return nil, err
+	}
+
+	var msg sdk.Msg
+	if args.To == "" {
+		deploy := types.NewMsgDeploy(caller.String(), value, data, "", nil, false, false)
+		msg = &deploy
+	} else {
+		callee, err := parseAddress(args.To)
+		if err != nil {
+			return nil, err
+		}
+		call := types.NewMsgCall(caller.String(), callee.String(), value, data)
+		msg = &call
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, invalidParams(err.Error())
+	}
+
+	clientCtx := s.clientCtx.WithFromAddress(caller)
+	accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, caller)
+	if err != nil {
+		return nil, err
+	}
+	txf := tx.Factory{}.
+		WithTxConfig(clientCtx.TxConfig).
+		WithChainID(clientCtx.ChainID).
+		WithAccountNumber(accNum).
+		WithSequence(seq)
+	res, _, err := tx.CalculateGas(clientCtx.QueryWithData, txf, msg)
+	if err != nil {
+		return nil, err
+	}
+	return encodeQuantity(res.GasInfo.GasUsed), nil
+}
+
+// traceConfig is the tracer configuration of debug_traceCall.
+type traceConfig struct {
+	DisableStack   bool `json:"disableStack"`
+	DisableMemory  bool `json:"disableMemory"`
+	DisableStorage bool `json:"disableStorage"`
+}
+
+// traceCall replays the call, or the deployment if no recipient is given, on the state of
+// the given block and returns its trace in the struct log format.
+func (s *Server) traceCall(params []json.RawMessage) (interface{}, error) {
+	var args callArgs
+	if err := param(params, 0, &args); err != nil {
+		return nil, err
+	}
+	if args.From == "" {
+		return nil, invalidParams("the sender is required to trace a call")
+	}
+	caller, err := parseAddress(args.From)
+	if err != nil {
+		return nil, err
+	}
+	var callee string
+	if args.To != "" {
+		addr, err := parseAddress(args.To)
+		if err != nil {
+			return nil, err
+		}
+		callee = addr.String()
+	}
+	var value uint64
+	if args.Value != "" {
+		if value, err = parseQuantity(args.Value); err != nil {
+			return nil, err
+		}
+	}
+	data, err := args.data()
+	if err != nil {
+		return nil, err
+	}
+	height, err := blockParam(params, 1)
+	if err != nil {
+		return nil, err
+	}
+	var config traceConfig
+	if len(params) > 2 {
+		if err := param(params, 2, &config); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := types.NewQueryClient(s.clientCtx.WithHeight(height)).DebugTraceCall(context.Background(), &types.QueryDebugTraceCallRequest{
+		Caller:         caller.String(),
+		Callee:         callee,
+		Value:          value,
+		Data:           data,
+		DisableStack:   config.DisableStack,
+		DisableMemory:  config.DisableMemory,
+		DisableStorage: config.DisableStorage,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res.Trace), nil
+}
+
+// sendRawTransaction broadcasts a signed transaction. CVM accounts sign with their Cosmos
+// keys, so the raw transaction has to be an encoded Cosmos SDK transaction carrying CVM messages.
+// RLP-encoded Ethereum transactions, as signed by Ethereum wallets, are not supported and are
+// rejected with an error saying so.
+func (s *Server) sendRawTransaction(params []json.RawMessage) (interface{}, error) {
+	var raw string
+	if err := param(params, 0, &raw); err != nil {
+		return nil, err
+	}
+	txBytes, err := decodeBytes(raw)
+	if err != nil {
+		return nil, err
+	}
+	if isEthereumTx(txBytes) {
+		return nil, invalidParams("Ethereum transactions are not supported, " +
+			"sign the CVM messages as a Cosmos SDK transaction with the Cosmos key of the account")
+	}
+	if _, err := s.clientCtx.TxConfig.TxDecoder()(txBytes); err != nil {
+		return nil, invalidParams("raw transaction is not a signed Cosmos SDK transaction: %v", err)
+	}
+
+	res, err := s.clientCtx.WithBroadcastMode(flags.BroadcastSync).BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	hash, err := decodeBytes(res.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	return encodeBytes(hash), nil
+}
+
+// isEthereumTx returns whether raw transaction bytes are an RLP-encoded Ethereum transaction,
+// either a legacy transaction, which is an RLP list, or an EIP-2718 typed transaction of the
+// access list or dynamic fee type, which is an RLP list prefixed by its type. Encoded Cosmos SDK
+// transactions start with the protobuf key of their body instead.
+func isEthereumTx(bz []byte) bool {
+	const rlpList = 0xc0
+	switch {
+	case len(bz) == 0:
+		return false
+	case bz[0] >= rlpList:
+		return true
+	case bz[0] == 0x01 || bz[0] == 0x02:
+		return len(bz) > 1 && bz[1] >= rlpList
+	default:
+		return false
+	}
+}
+
+// translatedAddress is the result of cvm_addressTranslate.
+type translatedAddress struct {
+	Hex       string `json:"hex"`
+	Account   string `json:"account"`
+	Validator string `json:"validator"`
+	Consensus string `json:"consensus"`
+}
+
+// addressTranslate translates an address to hex and the Bech32 formats, like the
+// address-translate command does.
+func (s *Server) addressTranslate(params []json.RawMessage) (interface{}, error) {
+	var addr string
+	if err := param(params, 0, &addr); err != nil {
+		return nil, err
+	}
+
+	var bz []byte
+	config := sdk.GetConfig()
+	switch {
+	case strings.HasPrefix(addr, config.GetBech32ConsensusAddrPrefix()):
+		consAddr, err := sdk.ConsAddressFromBech32(addr)
+		if err != nil {
+			return nil, invalidParams("invalid address %s: %v", addr, err)
+		}
+		bz = consAddr
+	case strings.HasPrefix(addr, config.GetBech32ValidatorAddrPrefix()):
+		valAddr, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			return nil, invalidParams("invalid address %s: %v", addr, err)
+		}
+		bz = valAddr
+	default:
+		accAddr, err := parseAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		bz = accAddr
+	}
+	return translatedAddress{
+		Hex:       encodeBytes(bz),
+		Account:   sdk.AccAddress(bz).String(),
+		Validator: sdk.ValAddress(bz).String(),
+		Consensus: sdk.ConsAddress(bz).String(),
+	}, nil
+}
@@ -0,0 +1,269 @@
+package ethrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+const (
+	// logsPerPage is the number of transactions fetched per tx search request.
+	logsPerPage = 100
+
+	// maxLogsBlockRange is the largest block range eth_getLogs searches.
+	maxLogsBlockRange = 10000
+
+	// maxLogs is the largest number of transactions eth_getLogs searches, and of logs it returns.
+	maxLogs = 10000
+)
+
+// filterArgs is the filter object of eth_getLogs. Address is either a single address
+// or a list of addresses. Each topic is either null, a single topic or a list of topics.
+type filterArgs struct {
+	FromBlock string          `json:"fromBlock"`
+	ToBlock   string          `json:"toBlock"`
+	Address   json.RawMessage `json:"address"`
+	Topics    []interface{}   `json:"topics"`
+}
+
+// rpcLog is a CVM event log in the Ethereum log format.
+type rpcLog struct {
+	Address          string   `json:"address"`
+	Topics           []string `json:"topics"`
+	Data             string   `json:"data"`
+	BlockNumber      string   `json:"blockNumber"`
+	BlockHash        string   `json:"blockHash"`
+	TransactionHash  string   `json:"transactionHash"`
+	TransactionIndex string   `json:"transactionIndex"`
+	LogIndex         string   `json:"logIndex"`
+	Removed          bool     `json:"removed"`
+}
+
+// getLogs returns the logs emitted by CVM transactions in a block range. The range is limited
+// to maxLogsBlockRange blocks, and queries matching more than maxLogs transactions or logs fail
+// so that the filter has to be narrowed.
+func (s *Server) getLogs(params []json.RawMessage) (interface{}, error) {
+	var args filterArgs
+	if err := param(params, 0, &args); err != nil {
+		return nil, err
+	}
+	addresses, err := filterAddresses(args.Address)
+	if err != nil {
+		return nil, err
+	}
+	topics, err := filterTopics(args.Topics)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := rpc.GetChainHeight(s.clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	from, err := s.filterHeight(args.FromBlock, latest)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.filterHeight(args.ToBlock, latest)
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, invalidParams("fromBlock %d is after toBlock %d", from, to)
+	}
+	if to-from >= maxLogsBlockRange {
+		return nil, invalidParams("block range exceeds %d blocks", maxLogsBlockRange)
+	}
+
+	query := fmt.Sprintf("%s.%s='%s' AND tx.height>=%d AND tx.height<=%d",
+		sdk.EventTypeMessage, sdk.AttributeKeyModule, types.ModuleName, from, to)
+	if len(addresses) == 1 {
+		query += fmt.Sprintf(" AND %s.%s='%s'", types.EventTypeCVMEvent, types.AttributeKeyAddress, addresses[0])
+	}
+
+	logs := []rpcLog{}
+	blockHashes := make(map[int64]string)
+	for page := 1; ; page++ {
+		perPage := logsPerPage
+		res, err := s.clientCtx.Client.TxSearch(context.Background(), query, false, &page, &perPage, "asc")
+		if err != nil {
+			return nil, err
+		}
+		if res.TotalCount > maxLogs {
+			return nil, invalidParams("query matches more than %d transactions", maxLogs)
+		}
+		for _, tx := range res.Txs {
+			txLogs, err := parseLogs(tx.TxResult.Events)
+			if err != nil {
+				return nil, err
+			}
+			for _, log := range txLogs {
+				if !logMatches(log, addresses, topics) {
+					continue
+				}
+				if len(logs) == maxLogs {
+					return nil, invalidParams("query returns more than %d logs", maxLogs)
+				}
+				addr, err := sdk.AccAddressFromBech32(log.Address)
+				if err != nil {
+					return nil, err
+				}
+				blockHash, ok := blockHashes[tx.Height]
+				if !ok {
+					block, err := s.clientCtx.Client.Block(context.Background(), &tx.Height)
+					if err != nil {
+						return nil, err
+					}
+					blockHash = encodeBytes(block.BlockID.Hash)
+					blockHashes[tx.Height] = blockHash
+				}
+				topics := make([]string, len(log.Topics))
+				for j, topic := range log.Topics {
+					topics[j] = encodeBytes(topic)
+				}
+				logs = append(logs, rpcLog{
+					Address:          encodeBytes(addr),
+					Topics:           topics,
+					Data:             encodeBytes(log.Data),
+					BlockNumber:      encodeQuantity(uint64(tx.Height)),
+					BlockHash:        blockHash,
+					TransactionHash:  encodeBytes(tx.Hash),
+					TransactionIndex: encodeQuantity(uint64(tx.Index)),
+					LogIndex:         encodeQuantity(log.LogIndex),
+				})
+			}
+		}
+		if page*perPage >= res.TotalCount {
+			break
+		}
+	}
+	return logs, nil
+}
+
+// filterHeight resolves a block number of the filter, defaulting to the latest block.
+func (s *Server) filterHeight(block string, latest int64) (int64, error) {
+	height, err := parseBlockNumber(block)
+	if err != nil {
+		return 0, err
+	}
+	if height == 0 {
+		return latest, nil
+	}
+	return height, nil
+}
+
+func filterAddresses(raw json.RawMessage) ([]sdk.AccAddress, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err != nil {
+		var single string
+		if err := json.Unmarshal(raw, &single); err != nil {
+			return nil, invalidParams("invalid filter address: %v", err)
+		}
+		list = []string{single}
+	}
+	addresses := make([]sdk.AccAddress, len(list))
+	for i, s := range list {
+		addr, err := parseAddress(s)
+		if err != nil {
+			return nil, err
+		}
+		addresses[i] = addr
+	}
+	return addresses, nil
+}
+
+// filterTopics parses the topic filter. A nil entry matches any topic at its position.
+func filterTopics(raw []interface{}) ([][][]byte, error) {
+	topics := make([][][]byte, len(raw))
+	for i, t := range raw {
+		var options []string
+		switch t := t.(type) {
+		case nil:
+			continue
+		case string:
+			options = []string{t}
+		case []interface{}:
+			for _, o := range t {
+				s, ok := o.(string)
+				if !ok {
+					return nil, invalidParams("invalid topic %v", o)
+				}
+				options = append(options, s)
+			}
+		default:
+			return nil, invalidParams("invalid topic %v", t)
+		}
+		for _, o := range options {
+			topic, err := decodeBytes(o)
+			if err != nil {
+				return nil, err
+			}
+			topics[i] = append(topics[i], topic)
+		}
+	}
+	return topics, nil
+}
+
+// parseLogs parses the logs of a transaction from its CVM events.
+func parseLogs(events []abci.Event) ([]types.Log, error) {
+	var logs []types.Log
+	for _, event := range events {
+		if event.Type != types.EventTypeCVMEvent {
+			continue
+		}
+		attrs := make([]sdk.Attribute, len(event.Attributes))
+		for i, attr := range event.Attributes {
+			attrs[i] = sdk.NewAttribute(string(attr.Key), string(attr.Value))
+		}
+		eventLogs, err := types.ParseLogEvents(attrs)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, eventLogs...)
+	}
+	return logs, nil
+}
+
+func logMatches(log types.Log, addresses []sdk.AccAddress, topics [][][]byte) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, addr := range addresses {
+			if addr.String() == log.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, options := range topics {
+		if len(options) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range options {
+			if bytes.Equal(topic, log.Topics[i]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,133 @@
+// Package ethrpc implements an Ethereum compatible JSON-RPC gateway for the CVM.
+//
+// CVM accounts sign with their Cosmos keys, hence eth_sendRawTransaction only accepts signed
+// Cosmos SDK transactions and Ethereum tooling can read the chain state but not submit
+// Ethereum-signed transactions. eth_getLogs searches bounded block ranges.
+package ethrpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/hyperledger/burrow/rpc/web3"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// Route is the path the JSON-RPC gateway is served at on the API server.
+const Route = "/cvm/json-rpc"
+
+// Server serves the Ethereum JSON-RPC methods supported by the CVM.
+type Server struct {
+	clientCtx client.Context
+	chainID   uint64
+	methods   map[string]method
+}
+
+type method func(params []json.RawMessage) (interface{}, error)
+
+// NewServer returns a JSON-RPC gateway querying the chain through the given client context.
+// The chain ID is the EIP-155 chain ID reported to Ethereum tooling.
+func NewServer(clientCtx client.Context, chainID uint64) *Server {
+	s := &Server{
+		clientCtx: clientCtx,
+		chainID:   chainID,
+	}
+	s.methods = map[string]method{
+		"web3_clientVersion":     s.clientVersion,
+		"net_version":            s.netVersion,
+		"eth_chainId":            s.chainIDHex,
+		"eth_blockNumber":        s.blockNumber,
+		"eth_getBalance":         s.getBalance,
+		"eth_getCode":            s.getCode,
+		"eth_getStorageAt":       s.getStorageAt,
+		"eth_call":               s.call,
+		"eth_estimateGas":        s.estimateGas,
+		"eth_getLogs":            s.getLogs,
+		"eth_sendRawTransaction": s.sendRawTransaction,
+		"debug_traceCall":        s.traceCall,
+		"cvm_addressTranslate":   s.addressTranslate,
+	}
+	return s
+}
+
+// RegisterRoutes registers the JSON-RPC gateway on the API server router.
+func RegisterRoutes(clientCtx client.Context, rtr *mux.Router, chainID uint64) {
+	rtr.Handle(Route, NewServer(clientCtx, chainID)).Methods(http.MethodPost)
+}
+
+// ServeHTTP handles single and batched JSON-RPC requests.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeResponse(w, web3.ErrInvalidRequest.RPCError().AsRPCErrorResponse(nil))
+		return
+	}
+
+	var requests []web3.RPCRequest
+	if err := json.Unmarshal(data, &requests); err != nil {
+		var request web3.RPCRequest
+		if err := json.Unmarshal(data, &request); err != nil {
+			writeResponse(w, web3.ErrCouldNotParse.RPCError().AsRPCErrorResponse(nil))
+			return
+		}
+		writeResponse(w, s.do(request))
+		return
+	}
+
+	responses := make([]interface{}, len(requests))
+	for i, request := range requests {
+		responses[i] = s.do(request)
+	}
+	writeResponse(w, responses)
+}
+
+func (s *Server) do(request web3.RPCRequest) interface{} {
+	if request.JSONRPC != web3.JSONRPC || request.Method == "" {
+		return web3.ErrInvalidRequest.RPCError().AsRPCErrorResponse(request.ID)
+	}
+	m, ok := s.methods[request.Method]
+	if !ok {
+		msg := fmt.Sprintf("the method %s does not exist/is not available", request.Method)
+		return web3.ErrNotFound.RPCErrorWithMessage(msg).AsRPCErrorResponse(request.ID)
+	}
+
+	var params []json.RawMessage
+	if len(request.Params) > 0 {
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return web3.ErrInvalidParams.RPCErrorWithMessage(err.Error()).AsRPCErrorResponse(request.ID)
+		}
+	}
+	result, err := m(params)
+	if err != nil {
+		if _, ok := err.(paramsError); ok {
+			return web3.ErrInvalidParams.RPCErrorWithMessage(err.Error()).AsRPCErrorResponse(request.ID)
+		}
+		return web3.ErrServer.RPCErrorWithMessage(err.Error()).AsRPCErrorResponse(request.ID)
+	}
+	return web3.RPCResultResponse{
+		JSONRPC: web3.JSONRPC,
+		ID:      request.ID,
+		Result:  result,
+	}
+}
+
+func writeResponse(w http.ResponseWriter, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// paramsError is returned for malformed method parameters.
+type paramsError struct {
+	error
+}
+
+func invalidParams(format string, args ...interface{}) error {
+	return paramsError{fmt.Errorf(format, args...)}
+}
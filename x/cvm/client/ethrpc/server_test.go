@@ -0,0 +1,272 @@
+package ethrpc_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+
+	"github.com/certikfoundation/shentu/app"
+	"github.com/certikfoundation/shentu/common"
+	"github.com/certikfoundation/shentu/x/cvm"
+	"github.com/certikfoundation/shentu/x/cvm/client/ethrpc"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+type appOptions map[string]interface{}
+
+func (o appOptions) Get(key string) interface{} {
+	return o[key]
+}
+
+type response struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func newNetwork(t *testing.T) *network.Network {
+	encCfg := app.MakeEncodingConfig()
+	cfg := network.DefaultConfig()
+	cfg.Codec = encCfg.Marshaler
+	cfg.TxConfig = encCfg.TxConfig
+	cfg.LegacyAmino = encCfg.Amino
+	cfg.InterfaceRegistry = encCfg.InterfaceRegistry
+	cfg.GenesisState = app.ModuleBasics.DefaultGenesis(encCfg.Marshaler)
+	cfg.NumValidators = 1
+	cfg.BondDenom = common.MicroCTKDenom
+	cfg.MinGasPrices = "0" + common.MicroCTKDenom
+	cfg.AppConstructor = func(val network.Validator) servertypes.Application {
+		return app.NewCertiKApp(
+			val.Ctx.Logger, dbm.NewMemDB(), nil, true, make(map[int64]bool), val.Ctx.Config.RootDir, 0,
+			encCfg,
+			appOptions{cvm.FlagJSONRPC: true, cvm.FlagJSONRPCChainID: uint64(1234)},
+			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
+			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
+		)
+	}
+	return network.New(t, cfg)
+}
+
+func TestServer(t *testing.T) {
+	n := newNetwork(t)
+	defer n.Cleanup()
+	val := n.Validators[0]
+	_, err := n.WaitForHeight(1)
+	require.NoError(t, err)
+
+	call := func(method string, params ...interface{}) response {
+		body, err := json.Marshal(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"method":  method,
+			"params":  params,
+		})
+		require.NoError(t, err)
+		res, err := http.Post(val.APIAddress+ethrpc.Route, "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer res.Body.Close()
+		var out response
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+		return out
+	}
+	result := func(method string, params ...interface{}) string {
+		res := call(method, params...)
+		require.Nil(t, res.Error, method)
+		var s string
+		require.NoError(t, json.Unmarshal(res.Result, &s))
+		return s
+	}
+
+	// The contract stores 42 at slot 0 and logs it on deployment, then returns the stored value on calls.
+	topic := bytes.Repeat([]byte{0xab}, 32)
+	runtime := bc.MustSplice(PUSH1, 0, SLOAD, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN)
+	code := bc.MustSplice(PUSH1, 42, PUSH1, 0, SSTORE, PUSH1, 42, PUSH1, 0, MSTORE,
+		PUSH32, topic, PUSH1, 32, PUSH1, 0, LOG1,
+		PUSH1, len(runtime), PUSH1, 60, PUSH1, 0, CODECOPY, PUSH1, len(runtime), PUSH1, 0, RETURN, runtime)
+	word := "0x" + strings.Repeat("0", 62) + "2a"
+	valHex := "0x" + hex.EncodeToString(val.Address)
+
+	// sendDeploy sends a transaction deploying the code and waits for the next block.
+	sendDeploy := func(t *testing.T, code []byte) string {
+		clientCtx := val.ClientCtx
+		msg := types.NewMsgDeploy(val.Address.String(), 0, code, "", nil, false, false)
+		txf := tx.Factory{}.
+			WithTxConfig(clientCtx.TxConfig).
+			WithAccountRetriever(clientCtx.AccountRetriever).
+			WithKeybase(clientCtx.Keyring).
+			WithChainID(clientCtx.ChainID).
+			WithGas(1000000)
+		txf, err := tx.PrepareFactory(clientCtx.WithFromAddress(val.Address), txf)
+		require.NoError(t, err)
+		txBuilder, err := tx.BuildUnsignedTx(txf, &msg)
+		require.NoError(t, err)
+		require.NoError(t, tx.Sign(txf, val.Moniker, txBuilder, true))
+		txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+
+		hash := result("eth_sendRawTransaction", "0x"+hex.EncodeToString(txBytes))
+		require.NoError(t, n.WaitForNextBlock())
+		return hash
+	}
+
+	t.Run("chain info", func(t *testing.T) {
+		require.Equal(t, "0x4d2", result("eth_chainId"))
+		require.Equal(t, "1234", result("net_version"))
+		require.NotEqual(t, "0x0", result("eth_blockNumber"))
+	})
+
+	var contract string
+	t.Run("send raw transaction", func(t *testing.T) {
+		require.Len(t, sendDeploy(t, code), 66)
+
+		res := call("eth_sendRawTransaction", "0x1234")
+		require.NotNil(t, res.Error)
+		require.Equal(t, -32602, res.Error.Code)
+
+		// A legacy and a dynamic fee Ethereum transaction are rejected as such.
+		for _, raw := range []string{"0xf86c098504a817c800825208", "0x02f8720180"} {
+			res = call("eth_sendRawTransaction", raw)
+			require.NotNil(t, res.Error)
+			require.Equal(t, -32602, res.Error.Code)
+			require.Contains(t, res.Error.Message, "Ethereum transactions are not supported")
+		}
+	})
+
+	t.Run("get logs", func(t *testing.T) {
+		var logs []map[string]interface{}
+		res := call("eth_getLogs", map[string]interface{}{"fromBlock": "0x1"})
+		require.Nil(t, res.Error)
+		require.NoError(t, json.Unmarshal(res.Result, &logs))
+		require.Len(t, logs, 1)
+		require.Equal(t, []interface{}{"0x" + hex.EncodeToString(topic)}, logs[0]["topics"])
+		require.Equal(t, word, logs[0]["data"])
+		contract = logs[0]["address"].(string)
+
+		res = call("eth_getLogs", map[string]interface{}{
+			"fromBlock": "0x1",
+			"address":   contract,
+			"topics":    []interface{}{[]string{"0x" + strings.Repeat("00", 32), "0x" + hex.EncodeToString(topic)}},
+		})
+		require.Nil(t, res.Error)
+		require.NoError(t, json.Unmarshal(res.Result, &logs))
+		require.Len(t, logs, 1)
+
+		res = call("eth_getLogs", map[string]interface{}{
+			"fromBlock": "0x1",
+			"topics":    []interface{}{"0x" + strings.Repeat("00", 32)},
+		})
+		require.Nil(t, res.Error)
+		require.NoError(t, json.Unmarshal(res.Result, &logs))
+		require.Len(t, logs, 0)
+
+		for _, filter := range []map[string]interface{}{
+			{"fromBlock": "0x2", "toBlock": "0x1"},
+			{"fromBlock": "0x1", "toBlock": "0x2711"},
+		} {
+			res = call("eth_getLogs", filter)
+			require.NotNil(t, res.Error)
+			require.Equal(t, -32602, res.Error.Code)
+		}
+	})
+
+	t.Run("skip logs of reverted calls", func(t *testing.T) {
+		// The deployment creates a contract whose creation logs and reverts, and ignores the failure.
+		revertedTopic := bytes.Repeat([]byte{0xcd}, 32)
+		child := bc.MustSplice(PUSH32, revertedTopic, PUSH1, 0, DUP1, LOG1, PUSH1, 0, DUP1, REVERT)
+		parent := bc.MustSplice(PUSH1, len(child), PUSH1, 16, PUSH1, 0, CODECOPY,
+			PUSH1, len(child), PUSH1, 0, PUSH1, 0, CREATE, POP, STOP, child)
+		sendDeploy(t, parent)
+
+		var logs []map[string]interface{}
+		res := call("eth_getLogs", map[string]interface{}{
+			"fromBlock": "0x1",
+			"topics":    []interface{}{"0x" + hex.EncodeToString(revertedTopic)},
+		})
+		require.Nil(t, res.Error)
+		require.NoError(t, json.Unmarshal(res.Result, &logs))
+		require.Len(t, logs, 0)
+
+		res = call("eth_getLogs", map[string]interface{}{"fromBlock": "0x1"})
+		require.Nil(t, res.Error)
+		require.NoError(t, json.Unmarshal(res.Result, &logs))
+		require.Len(t, logs, 1)
+	})
+
+	t.Run("query state", func(t *testing.T) {
+		require.Equal(t, "0x"+hex.EncodeToString(runtime), result("eth_getCode", contract, "latest"))
+		require.Equal(t, word, result("eth_getStorageAt", contract, "0x0", "latest"))
+		require.Equal(t, "0x"+strings.Repeat("0", 64), result("eth_getStorageAt", contract, "0x1", "latest"))
+		require.NotEqual(t, "0x0", result("eth_getBalance", valHex, "latest"))
+		require.Equal(t, "0x0", result("eth_getBalance", contract, "latest"))
+	})
+
+	t.Run("call", func(t *testing.T) {
+		require.Equal(t, word, result("eth_call", map[string]string{"to": contract}, "latest"))
+		require.Equal(t, word, result("eth_call", map[string]string{"from": valHex, "to": contract, "data": "0x"}))
+	})
+
+	t.Run("estimate gas", func(t *testing.T) {
+		require.NotEqual(t, "0x0", result("eth_estimateGas", map[string]string{"from": valHex, "to": contract}))
+		require.NotEqual(t, "0x0", result("eth_estimateGas", map[string]string{
+			"from": valHex,
+			"data": "0x" + hex.EncodeToString(code),
+		}))
+	})
+
+	t.Run("trace call", func(t *testing.T) {
+		var trace struct {
+			Failed      bool   `json:"failed"`
+			ReturnValue string `json:"returnValue"`
+			StructLogs  []struct {
+				Op    string   `json:"op"`
+				Stack []string `json:"stack"`
+			} `json:"structLogs"`
+		}
+		res := call("debug_traceCall", map[string]string{"from": valHex, "to": contract}, "latest", map[string]bool{"disableStack": true})
+		require.Nil(t, res.Error)
+		require.NoError(t, json.Unmarshal(res.Result, &trace))
+		require.False(t, trace.Failed)
+		require.Equal(t, word[2:], trace.ReturnValue)
+		require.Len(t, trace.StructLogs, 7)
+		require.Equal(t, "SLOAD", trace.StructLogs[1].Op)
+		require.Empty(t, trace.StructLogs[1].Stack)
+	})
+
+	t.Run("translate address", func(t *testing.T) {
+		var out map[string]string
+		res := call("cvm_addressTranslate", val.Address.String())
+		require.Nil(t, res.Error)
+		require.NoError(t, json.Unmarshal(res.Result, &out))
+		require.Equal(t, valHex, out["hex"])
+		require.Equal(t, val.ValAddress.String(), out["validator"])
+
+		res = call("cvm_addressTranslate", valHex)
+		require.Nil(t, res.Error)
+		require.NoError(t, json.Unmarshal(res.Result, &out))
+		require.Equal(t, val.Address.String(), out["account"])
+	})
+
+	t.Run("unsupported method", func(t *testing.T) {
+		res := call("eth_mining")
+		require.NotNil(t, res.Error)
+		require.Equal(t, -32601, res.Error.Code)
+	})
+}
@@ -0,0 +1,123 @@
+package ethrpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// callArgs is the transaction call object of eth_call and eth_estimateGas.
+type callArgs struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Value string `json:"value"`
+	Data  string `json:"data"`
+	Input string `json:"input"`
+}
+
+// data returns the call data, which newer clients send as input.
+func (args callArgs) data() ([]byte, error) {
+	if args.Input != "" {
+		return decodeBytes(args.Input)
+	}
+	return decodeBytes(args.Data)
+}
+
+// param unmarshals the i-th positional parameter into v.
+func param(params []json.RawMessage, i int, v interface{}) error {
+	if i >= len(params) {
+		return invalidParams("missing value for required argument %d", i)
+	}
+	if err := json.Unmarshal(params[i], v); err != nil {
+		return invalidParams("invalid argument %d: %v", i, err)
+	}
+	return nil
+}
+
+// addressParam parses the i-th positional parameter as an address.
+func addressParam(params []json.RawMessage, i int) (sdk.AccAddress, error) {
+	var s string
+	if err := param(params, i, &s); err != nil {
+		return nil, err
+	}
+	return parseAddress(s)
+}
+
+// parseAddress parses a 20-byte hex address, with or without the 0x prefix, or a
+// Bech32 account address.
+func parseAddress(s string) (sdk.AccAddress, error) {
+	if strings.HasPrefix(s, sdk.GetConfig().GetBech32AccountAddrPrefix()) {
+		addr, err := sdk.AccAddressFromBech32(s)
+		if err != nil {
+			return nil, invalidParams("invalid address %s: %v", s, err)
+		}
+		return addr, nil
+	}
+	bz, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil || len(bz) != sdk.AddrLen {
+		return nil, invalidParams("invalid address %s", s)
+	}
+	return bz, nil
+}
+
+// blockParam parses the optional i-th positional parameter as a block number.
+// Zero stands for the latest block.
+func blockParam(params []json.RawMessage, i int) (int64, error) {
+	if i >= len(params) {
+		return 0, nil
+	}
+	var s string
+	if err := param(params, i, &s); err != nil {
+		return 0, err
+	}
+	return parseBlockNumber(s)
+}
+
+func parseBlockNumber(s string) (int64, error) {
+	switch s {
+	case "", "latest", "pending":
+		return 0, nil
+	case "earliest":
+		return 1, nil
+	}
+	height, err := parseQuantity(s)
+	if err != nil {
+		return 0, err
+	}
+	return int64(height), nil
+}
+
+func parseQuantity(s string) (uint64, error) {
+	if !strings.HasPrefix(s, "0x") {
+		return 0, invalidParams("quantity %s is missing the 0x prefix", s)
+	}
+	x, err := strconv.ParseUint(s[2:], 16, 64)
+	if err != nil {
+		return 0, invalidParams("invalid quantity %s: %v", s, err)
+	}
+	return x, nil
+}
+
+func encodeQuantity(x uint64) string {
+	return "0x" + strconv.FormatUint(x, 16)
+}
+
+func encodeBigQuantity(x *big.Int) string {
+	return "0x" + x.Text(16)
+}
+
+func decodeBytes(s string) ([]byte, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, invalidParams("invalid hex data %s: %v", s, err)
+	}
+	return bz, nil
+}
+
+func encodeBytes(bz []byte) string {
+	return "0x" + hex.EncodeToString(bz)
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/certikfoundation/shentu/x/cvm/client/cli"
+	"github.com/certikfoundation/shentu/x/cvm/client/rest"
+)
+
+// contract migration, freeze and unfreeze proposal handlers
+var (
+	ProposalHandler         = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	FreezeProposalHandler   = govclient.NewProposalHandler(cli.GetCmdSubmitFreezeProposal, rest.FreezeProposalRESTHandler)
+	UnfreezeProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitUnfreezeProposal, rest.UnfreezeProposalRESTHandler)
+)
@@ -5,7 +5,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 )
 
 func RegisterHandlers(clientCtx client.Context, rtr *mux.Router) {
@@ -19,6 +21,8 @@ type callReq struct {
 	Callee  string       `json:"callee"`
 	Value   string       `json:"value"`
 	Data    string       `json:"data"`
+	// AcceptRisk accepts calling a contract scored below the threshold score by the security oracle.
+	AcceptRisk bool `json:"accept_risk"`
 }
 
 type deployReq struct {
@@ -31,6 +35,74 @@ type deployReq struct {
 	Meta         []string     `json:"meta"`
 	IsEWASM      bool         `json:"is_ewasm"`
 	IsRuntime    bool         `json:"is_runtime"`
+	Admin        string       `json:"admin"`
+	Salt         string       `json:"salt"`
+}
+
+type migrateReq struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	Contract string       `json:"contract"`
+	Code     string       `json:"code"`
+	Abi      string       `json:"abi"`
+}
+
+// ContractMigrationProposalReq defines a contract migration proposal request body.
+type ContractMigrationProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Contract    string    `json:"contract" yaml:"contract"`
+	Code        string    `json:"code" yaml:"code"`
+	Abi         string    `json:"abi" yaml:"abi"`
+	Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+}
+
+// ContractFreezeProposalReq defines a contract freeze proposal request body. The duration is
+// parsed by time.ParseDuration, and an empty duration freezes the contract until it is unfrozen.
+type ContractFreezeProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Contract    string    `json:"contract" yaml:"contract"`
+	Reason      string    `json:"reason" yaml:"reason"`
+	Duration    string    `json:"duration" yaml:"duration"`
+	Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+}
+
+// ContractUnfreezeProposalReq defines a contract unfreeze proposal request body.
+type ContractUnfreezeProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Contract    string    `json:"contract" yaml:"contract"`
+	Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the contract migration REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "contract_migration",
+		Handler:  postProposalHandlerFn(cliCtx),
+	}
+}
+
+// FreezeProposalRESTHandler returns a ProposalRESTHandler that exposes the contract freeze REST handler with a given sub-route.
+func FreezeProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "contract_freeze",
+		Handler:  postFreezeProposalHandlerFn(cliCtx),
+	}
+}
+
+// UnfreezeProposalRESTHandler returns a ProposalRESTHandler that exposes the contract unfreeze REST handler with a given sub-route.
+func UnfreezeProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "contract_unfreeze",
+		Handler:  postUnfreezeProposalHandlerFn(cliCtx),
+	}
 }
 
 type viewReq struct {
@@ -0,0 +1,213 @@
+package compile
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// DefaultSolc is the solc binary used when no compiler path is configured.
+const DefaultSolc = "solc"
+
+// solcOutputSelection are the outputs burrow requests from solc for each contract.
+var solcOutputSelection = []string{
+	"abi",
+	"evm.bytecode.object",
+	"evm.deployedBytecode.object",
+	"evm.bytecode.linkReferences",
+	"metadata",
+	"bin",
+	"devdoc",
+}
+
+// SolidityEVM compiles a Solidity file with the standard JSON interface of the solc binary at the
+// compiler path, run from the directory of the file. The input is the one burrow sends to solc, with
+// the libraries linked by solc, but solc is only allowed to read the files of the directory. The
+// response holds every contract of the output in the order of their files and names, each with the
+// metadata of all the contracts. Errors reported by solc fail the compilation, while its warnings are
+// kept in the response.
+func SolidityEVM(solc, basename, workDir string, optimize bool, libraries map[string]crypto.Address,
+	logger *logging.Logger) (*compile.Response, error) {
+	if solc == "" {
+		solc = DefaultSolc
+	}
+	solc, err := exec.LookPath(solc)
+	if err != nil {
+		return nil, err
+	}
+	allowedDir, err := filepath.Abs(workDir)
+	if err != nil {
+		return nil, err
+	}
+
+	input := compile.SolidityInput{Language: "Solidity", Sources: make(map[string]compile.SolidityInputSource)}
+	input.Sources[basename] = compile.SolidityInputSource{Urls: []string{basename}}
+	input.Settings.Optimizer.Enabled = optimize
+	input.Settings.OutputSelection.File.OutputType = solcOutputSelection
+	input.Settings.Libraries = map[string]map[string]string{"": {}}
+	for name, address := range libraries {
+		input.Settings.Libraries[""][name] = "0x" + hex.EncodeToString(address.Bytes())
+	}
+	command, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	logger.TraceMsg("Command Input", "command", string(command))
+
+	result, err := runSolc(solc, command, allowedDir)
+	if err != nil {
+		return nil, err
+	}
+	logger.TraceMsg("Command Output", "result", string(result))
+
+	var output compile.SolidityOutput
+	if err := json.Unmarshal(result, &output); err != nil {
+		return nil, fmt.Errorf("invalid solc output: %w", err)
+	}
+	var warnings, errs []string
+	for _, msg := range output.Errors {
+		if msg.Type == "Warning" {
+			warnings = append(warnings, strings.TrimSpace(msg.FormattedMessage))
+		} else {
+			errs = append(errs, strings.TrimSpace(msg.FormattedMessage))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, "\n"))
+	}
+
+	var objects []compile.ResponseItem
+	for filename, contracts := range output.Contracts {
+		for name, contract := range contracts {
+			objects = append(objects, compile.ResponseItem{
+				Filename:   filename,
+				Objectname: name,
+				Contract:   contract,
+			})
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		if objects[i].Filename != objects[j].Filename {
+			return objects[i].Filename < objects[j].Filename
+		}
+		return objects[i].Objectname < objects[j].Objectname
+	})
+
+	// Contracts can create any contract of the output, so each of them carries the metadata of all.
+	var metamap []compile.MetadataMap
+	for _, object := range objects {
+		if object.Contract.Evm.DeployedBytecode.Object == "" {
+			continue
+		}
+		var meta compile.SolidityMetadata
+		_ = json.Unmarshal([]byte(object.Contract.Metadata), &meta)
+		metamap = append(metamap, compile.MetadataMap{
+			DeployedBytecode: object.Contract.Evm.DeployedBytecode,
+			Metadata: compile.Metadata{
+				ContractName:    object.Objectname,
+				SourceFile:      object.Filename,
+				CompilerVersion: meta.Compiler.Version,
+				Abi:             object.Contract.Abi,
+			},
+		})
+	}
+	for i := range objects {
+		objects[i].Contract.MetadataMap = append([]compile.MetadataMap(nil), metamap...)
+	}
+
+	return &compile.Response{
+		Objects: objects,
+		Warning: strings.Join(warnings, "\n"),
+	}, nil
+}
+
+// runSolc runs solc with the standard JSON input from a directory, and only allows it to read the
+// files of the directory.
+func runSolc(solc string, input []byte, dir string) ([]byte, error) {
+	shellCmd := exec.Command(solc, "--standard-json", "--allow-paths", dir)
+	shellCmd.Dir = dir
+	shellCmd.Stdin = bytes.NewReader(input)
+	var stderr bytes.Buffer
+	shellCmd.Stderr = &stderr
+	output, err := shellCmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("running %s: %w: %s", solc, err, strings.TrimSpace(stderr.String()))
+	}
+	return output, nil
+}
+
+// SelectContract returns the contract of a compilation response with a name, which may be qualified
+// by its file as <file>:<name>. Without a name, the response must have a single contract with
+// bytecode, which excludes interfaces and abstract contracts.
+func SelectContract(resp *compile.Response, name string) (*compile.ResponseItem, error) {
+	var matches []*compile.ResponseItem
+	var names []string
+	for i, object := range resp.Objects {
+		qualified := object.Filename + ":" + object.Objectname
+		switch {
+		case name == "" && object.Contract.Evm.Bytecode.Object == "":
+			continue
+		case name == "", name == object.Objectname, name == qualified:
+			matches = append(matches, &resp.Objects[i])
+		}
+		names = append(names, qualified)
+	}
+	switch {
+	case len(matches) == 1:
+		return matches[0], nil
+	case len(matches) == 0 && name != "":
+		return nil, fmt.Errorf("contract %s not found in %s", name, strings.Join(names, ", "))
+	case len(matches) == 0:
+		return nil, errors.New("no contract with bytecode found")
+	default:
+		return nil, fmt.Errorf("several contracts found, select one of %s", strings.Join(names, ", "))
+	}
+}
+
+// LinkContract replaces the library placeholders in the bytecode of a contract, and of the contracts
+// it can create, with the addresses of the libraries by name. Placeholders left unlinked in the
+// bytecode of the contract are an error.
+func LinkContract(contract *compile.SolidityContract, libraries map[string]crypto.Address) error {
+	addresses := make(map[string]string, len(libraries))
+	for name, address := range libraries {
+		addresses[name] = hex.EncodeToString(address.Bytes())
+	}
+	if err := contract.Link(addresses); err != nil {
+		return err
+	}
+	if strings.Contains(contract.Evm.Bytecode.Object, "_") {
+		return errors.New("bytecode has unlinked library placeholders")
+	}
+	return nil
+}
+
+// ContractMetas returns the metadata of the contracts a compiled contract can create by the hashes
+// of their runtime code, including the contract itself, to be deployed along with the contract.
+func ContractMetas(contract *compile.SolidityContract, logger *logging.Logger) ([]*payload.ContractMeta, error) {
+	metadata, err := contract.GetMetadata(logger)
+	if err != nil {
+		return nil, err
+	}
+	metas := make([]*payload.ContractMeta, 0, len(metadata))
+	for codeHash, meta := range metadata {
+		metas = append(metas, &payload.ContractMeta{
+			CodeHash: codeHash.Bytes(),
+			Meta:     meta,
+		})
+	}
+	sort.Slice(metas, func(i, j int) bool {
+		return bytes.Compare(metas[i].CodeHash, metas[j].CodeHash) < 0
+	})
+	return metas, nil
+}
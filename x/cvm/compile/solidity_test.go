@@ -0,0 +1,155 @@
+package compile
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/logging"
+)
+
+const tokenOutput = `{
+  "contracts": {
+    "Token.sol": {
+      "IToken": {
+        "abi": [],
+        "metadata": "{\"compiler\":{\"version\":\"0.8.4+commit.c7e474f2\"},\"language\":\"Solidity\"}",
+        "evm": {"bytecode": {"object": "", "linkReferences": {}}, "deployedBytecode": {"object": "", "linkReferences": {}}}
+      },
+      "SafeMath": {
+        "abi": [],
+        "metadata": "{\"compiler\":{\"version\":\"0.8.4+commit.c7e474f2\"},\"language\":\"Solidity\"}",
+        "evm": {"bytecode": {"object": "6000", "linkReferences": {}}, "deployedBytecode": {"object": "6001", "linkReferences": {}}}
+      },
+      "Token": {
+        "abi": [{"inputs": [], "stateMutability": "nonpayable", "type": "constructor"}],
+        "metadata": "{\"compiler\":{\"version\":\"0.8.4+commit.c7e474f2\"},\"language\":\"Solidity\"}",
+        "evm": {
+          "bytecode": {
+            "object": "73__$0123456789abcdef0123456789abcdef01$__6000",
+            "linkReferences": {"Token.sol": {"SafeMath": [{"start": 1, "length": 20}]}}
+          },
+          "deployedBytecode": {"object": "6002", "linkReferences": {}}
+        }
+      }
+    }
+  },
+  "errors": [
+    {"severity": "warning", "type": "Warning", "formattedMessage": "Warning: unused variable"}
+  ]
+}`
+
+const errorOutput = `{
+  "errors": [
+    {"severity": "error", "type": "ParserError", "formattedMessage": "ParserError: expected ';'"}
+  ]
+}`
+
+// stubSolc writes a stub compiler to a new directory, which records its arguments and standard input
+// there and prints the output, and returns the directory.
+func stubSolc(t *testing.T, output string) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("the stub compiler is a shell script")
+	}
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "output.json"), []byte(output), 0600))
+	script := "#!/bin/sh\n" +
+		"echo \"$@\" > \"" + dir + "/args\"\n" +
+		"cat > \"" + dir + "/input.json\"\n" +
+		"cat \"" + dir + "/output.json\"\n"
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "solc"), []byte(script), 0700))
+	return dir
+}
+
+func TestSolidityEVM(t *testing.T) {
+	dir := stubSolc(t, tokenOutput)
+	logger := logging.NewNoopLogger()
+	library := crypto.Address{0xab, 0x01}
+	resp, err := SolidityEVM(filepath.Join(dir, "solc"), "Token.sol", dir, true, map[string]crypto.Address{"SafeMath": library}, logger)
+	require.NoError(t, err)
+	require.Equal(t, "Warning: unused variable", resp.Warning)
+
+	// The compiler only reads the files of the directory of the compiled file.
+	args, err := ioutil.ReadFile(filepath.Join(dir, "args"))
+	require.NoError(t, err)
+	require.Equal(t, "--standard-json --allow-paths "+dir, strings.TrimSpace(string(args)))
+	bz, err := ioutil.ReadFile(filepath.Join(dir, "input.json"))
+	require.NoError(t, err)
+	var input compile.SolidityInput
+	require.NoError(t, json.Unmarshal(bz, &input))
+	require.Equal(t, "Solidity", input.Language)
+	require.Equal(t, []string{"Token.sol"}, input.Sources["Token.sol"].Urls)
+	require.True(t, input.Settings.Optimizer.Enabled)
+	require.Contains(t, input.Settings.OutputSelection.File.OutputType, "evm.bytecode.linkReferences")
+	require.Equal(t, map[string]string{"SafeMath": "0xab01000000000000000000000000000000000000"}, input.Settings.Libraries[""])
+
+	t.Run("contracts are selected by name", func(t *testing.T) {
+		var names []string
+		for _, object := range resp.Objects {
+			names = append(names, object.Objectname)
+		}
+		require.Equal(t, []string{"IToken", "SafeMath", "Token"}, names)
+
+		for _, name := range []string{"Token", "Token.sol:Token"} {
+			object, err := SelectContract(resp, name)
+			require.NoError(t, err)
+			require.Equal(t, "Token", object.Objectname)
+		}
+		_, err := SelectContract(resp, "")
+		require.Error(t, err)
+		_, err = SelectContract(resp, "Missing")
+		require.Error(t, err)
+		_, err = SelectContract(&compile.Response{Objects: resp.Objects[:2]}, "")
+		require.NoError(t, err)
+	})
+
+	t.Run("libraries are linked into the placeholders", func(t *testing.T) {
+		object, err := SelectContract(resp, "Token")
+		require.NoError(t, err)
+		contract := object.Contract
+		require.Error(t, LinkContract(&contract, nil))
+
+		contract = object.Contract
+		require.NoError(t, LinkContract(&contract, map[string]crypto.Address{"SafeMath": library}))
+		require.Equal(t, "73ab01000000000000000000000000000000000000"+"6000", contract.Evm.Bytecode.Object)
+	})
+
+	t.Run("metadata are keyed by the hashes of the runtime code", func(t *testing.T) {
+		object, err := SelectContract(resp, "Token")
+		require.NoError(t, err)
+		metas, err := ContractMetas(&object.Contract, logger)
+		require.NoError(t, err)
+		require.Len(t, metas, 2)
+
+		found := false
+		for _, meta := range metas {
+			if string(meta.CodeHash) != string(crypto.Keccak256([]byte{0x60, 0x02})) {
+				continue
+			}
+			var metadata compile.Metadata
+			require.NoError(t, json.Unmarshal([]byte(meta.Meta), &metadata))
+			require.Equal(t, "Token", metadata.ContractName)
+			require.Equal(t, "Token.sol", metadata.SourceFile)
+			require.Equal(t, "0.8.4+commit.c7e474f2", metadata.CompilerVersion)
+			found = true
+		}
+		require.True(t, found)
+	})
+}
+
+func TestSolidityEVMErrors(t *testing.T) {
+	dir := stubSolc(t, errorOutput)
+	logger := logging.NewNoopLogger()
+	_, err := SolidityEVM(filepath.Join(dir, "solc"), "Token.sol", dir, false, nil, logger)
+	require.EqualError(t, err, "ParserError: expected ';'")
+
+	_, err = SolidityEVM(filepath.Join(dir, "missing"), "Token.sol", dir, false, nil, logger)
+	require.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/permission"
 
@@ -16,6 +17,53 @@ import (
 
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) []abci.ValidatorUpdate {
 	k.SetGasRate(ctx, data.GasRate)
+	k.SetReceiptParams(ctx, data.ReceiptParams)
+	k.SetTransactionGasLimit(ctx, data.TransactionGasLimit)
+	k.SetGasSchedule(ctx, data.GasSchedule)
+	k.SetForks(ctx, data.Forks)
+	k.SetCertifierFreezeDuration(ctx, data.CertifierFreezeDuration)
+	k.SetOracleCheckParams(ctx, data.OracleCheckParams)
+	k.SetDispatchAllowlist(ctx, data.DispatchAllowlist)
+	k.SetScheduleBlockGasLimit(ctx, data.ScheduleBlockGasLimit)
+	k.SetSweptFunds(ctx, data.SweptFunds)
+	for _, verified := range data.VerifiedContracts {
+		address, err := sdk.AccAddressFromBech32(verified.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetVerifiedContract(ctx, crypto.MustAddressFromBytes(address), verified)
+	}
+	for _, frozen := range data.FrozenContracts {
+		address, err := sdk.AccAddressFromBech32(frozen.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetFrozenContract(ctx, crypto.MustAddressFromBytes(address), frozen)
+	}
+	for _, sponsorship := range data.Sponsorships {
+		address, err := sdk.AccAddressFromBech32(sponsorship.Contract)
+		if err != nil {
+			panic(err)
+		}
+		k.SetSponsorship(ctx, crypto.MustAddressFromBytes(address), sponsorship)
+	}
+	for _, usage := range data.SponsorUsages {
+		address, err := sdk.AccAddressFromBech32(usage.Contract)
+		if err != nil {
+			panic(err)
+		}
+		user, err := sdk.AccAddressFromBech32(usage.User)
+		if err != nil {
+			panic(err)
+		}
+		k.SetSponsorUsage(ctx, crypto.MustAddressFromBytes(address), user, usage)
+	}
+	if data.NextScheduledCallId != 0 {
+		k.SetNextScheduledCallID(ctx, data.NextScheduledCallId)
+	}
+	for _, call := range data.ScheduledCalls {
+		k.SetScheduledCall(ctx, call)
+	}
 	state := k.NewState(ctx)
 
 	callframe := engine.NewCallFrame(state, acmstate.Named("TxCache"))
@@ -25,6 +73,20 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) []ab
 		if contract.Abi != nil {
 			k.SetAbi(ctx, contract.Address, contract.Abi)
 		}
+		if contract.Admin != "" {
+			admin, err := sdk.AccAddressFromBech32(contract.Admin)
+			if err != nil {
+				panic(err)
+			}
+			k.SetAdmin(ctx, contract.Address, admin)
+		}
+		if contract.Deployer != "" {
+			deployer, err := sdk.AccAddressFromBech32(contract.Deployer)
+			if err != nil {
+				panic(err)
+			}
+			k.SetDeployer(ctx, contract.Address, deployer)
+		}
 
 		for _, kv := range contract.Storage {
 			if err := state.SetStorage(contract.Address, kv.Key, kv.Value); err != nil {
@@ -104,12 +166,42 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) []ab
 
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	gasRate := k.GetGasRate(ctx)
+	receiptParams := k.GetReceiptParams(ctx)
+	transactionGasLimit := k.GetTransactionGasLimit(ctx)
+	gasSchedule := k.GetGasSchedule(ctx)
+	forks := k.GetForks(ctx)
+	certifierFreezeDuration := k.GetCertifierFreezeDuration(ctx)
+	oracleCheckParams := k.GetOracleCheckParams(ctx)
+	dispatchAllowlist := k.GetDispatchAllowlist(ctx)
+	scheduleBlockGasLimit := k.GetScheduleBlockGasLimit(ctx)
+	sweptFunds := k.GetSweptFunds(ctx)
 	contracts := k.GetAllContracts(ctx)
 	metadatas := k.GetAllMetas(ctx)
+	verifiedContracts := k.GetAllVerifiedContracts(ctx)
+	frozenContracts := k.GetAllFrozenContracts(ctx)
+	sponsorships := k.GetAllSponsorships(ctx)
+	sponsorUsages := k.GetAllSponsorUsages(ctx)
+	scheduledCalls := k.GetAllScheduledCalls(ctx)
+	nextScheduledCallID := k.GetNextScheduledCallID(ctx)
 
 	return &types.GenesisState{
-		GasRate:   gasRate,
-		Contracts: contracts,
-		Metadatas: metadatas,
+		GasRate:                 gasRate,
+		Contracts:               contracts,
+		Metadatas:               metadatas,
+		ReceiptParams:           receiptParams,
+		TransactionGasLimit:     transactionGasLimit,
+		GasSchedule:             gasSchedule,
+		Forks:                   forks,
+		SweptFunds:              sweptFunds,
+		VerifiedContracts:       verifiedContracts,
+		FrozenContracts:         frozenContracts,
+		CertifierFreezeDuration: certifierFreezeDuration,
+		OracleCheckParams:       oracleCheckParams,
+		DispatchAllowlist:       dispatchAllowlist,
+		Sponsorships:            sponsorships,
+		SponsorUsages:           sponsorUsages,
+		ScheduleBlockGasLimit:   scheduleBlockGasLimit,
+		ScheduledCalls:          scheduledCalls,
+		NextScheduledCallId:     nextScheduledCallID,
 	}
 }
@@ -4,9 +4,11 @@ import (
 	"encoding/hex"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/txs/payload"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -15,6 +17,7 @@ import (
 
 	"github.com/certikfoundation/shentu/simapp"
 	"github.com/certikfoundation/shentu/x/cvm"
+	"github.com/certikfoundation/shentu/x/cvm/types"
 )
 
 var basicTestsBytecodeString = "6080604052602260005534801561001557600080fd5b50610184806100256000396000f3fe608060405234801561001057600080fd5b50600436106100415760003560e01c80630b30d76414610046578063a0eb379f14610074578063e2276f1c1461007e575b600080fd5b6100726004803603602081101561005c57600080fd5b81019080803590602001909291905050506100ca565b005b61007c6100d4565b005b6100b46004803603604081101561009457600080fd5b810190808035906020019092919080359060200190929190505050610142565b6040518082815260200191505060405180910390f35b8060008190555050565b6040517f08c379a00000000000000000000000000000000000000000000000000000000081526004018080602001828103825260098152602001807f476f20617761792121000000000000000000000000000000000000000000000081525060200191505060405180910390fd5b600081830190509291505056fea265627a7a7231582029e87152c00d34140b78a06d51e5b41bdd4eab369148d1b9540394dcc93f1d5e64736f6c634300050b0032"
@@ -32,8 +35,62 @@ func TestExportGenesis(t *testing.T) {
 	code, err := hex.DecodeString(basicTestsBytecodeString)
 	require.Nil(t, err)
 
-	_, _ = k.Tx(ctx, addrs[0], nil, 0, code, []*payload.ContractMeta{}, false, false, false)
+	contract, _ := k.Tx(ctx, addrs[0], nil, 0, code, []*payload.ContractMeta{}, false, false, false)
+	k.SetAdmin(ctx, crypto.MustAddressFromBytes(contract), addrs[1])
+	k.SetDeployer(ctx, crypto.MustAddressFromBytes(contract), addrs[0])
+	sweptFunds := sdk.NewCoins(sdk.NewInt64Coin("uctk", 100))
+	k.SetSweptFunds(ctx, sweptFunds)
+	verified := types.VerifiedContract{
+		Address:         sdk.AccAddress(contract).String(),
+		SourceFiles:     []types.SourceFile{{Name: "A.sol", Content: "contract A {}"}},
+		CompilerVersion: "0.8.4",
+		CodeHash:        types.CodeHash(code),
+		CertificateId:   1,
+		Submitter:       addrs[1].String(),
+	}
+	k.SetVerifiedContract(ctx, crypto.MustAddressFromBytes(contract), verified)
+	frozen := types.FrozenContract{
+		Address: sdk.AccAddress(contract).String(),
+		Reason:  "exploited",
+	}
+	k.SetFrozenContract(ctx, crypto.MustAddressFromBytes(contract), frozen)
+	k.SetCertifierFreezeDuration(ctx, time.Hour)
+	oracleCheckParams := types.OracleCheckParams{Enabled: true, ThresholdScore: sdk.NewInt(60)}
+	k.SetOracleCheckParams(ctx, oracleCheckParams)
+	k.SetDispatchAllowlist(ctx, []string{"/cosmos.bank.v1beta1.Msg/Send"})
+	sponsorship := types.NewSponsorship(sdk.AccAddress(contract).String(), addrs[1].String(),
+		sdk.NewCoins(sdk.NewInt64Coin("uctk", 1000)), []string{"0xa9059cbb"}, time.Time{})
+	k.SetSponsorship(ctx, crypto.MustAddressFromBytes(contract), sponsorship)
+	usage := types.SponsorUsage{
+		Contract: sponsorship.Contract,
+		User:     addrs[0].String(),
+		Day:      1,
+		Spent:    sdk.NewCoins(sdk.NewInt64Coin("uctk", 10)),
+	}
+	k.SetSponsorUsage(ctx, crypto.MustAddressFromBytes(contract), addrs[0], usage)
+	k.SetScheduleBlockGasLimit(ctx, 1000000)
+	scheduledCall := types.ScheduledCall{
+		Id:         1,
+		Caller:     addrs[0].String(),
+		Callee:     sdk.AccAddress(contract).String(),
+		Interval:   10,
+		NextHeight: 10,
+		GasLimit:   100000,
+		PrepaidGas: 1000000,
+		PrepaidFee: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+	}
+	k.SetScheduledCall(ctx, scheduledCall)
+	k.SetNextScheduledCallID(ctx, 2)
 	exported := cvm.ExportGenesis(ctx, k)
+	for _, c := range exported.Contracts {
+		if c.Address == crypto.MustAddressFromBytes(contract) {
+			require.Equal(t, addrs[1].String(), c.Admin)
+			require.Equal(t, addrs[0].String(), c.Deployer)
+		} else {
+			require.Empty(t, c.Admin)
+			require.Empty(t, c.Deployer)
+		}
+	}
 
 	app2 := simapp.Setup(false)
 	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{})
@@ -42,4 +99,17 @@ func TestExportGenesis(t *testing.T) {
 	cvm.InitGenesis(ctx2, k2, *exported)
 	exported2 := cvm.ExportGenesis(ctx, k)
 	require.True(t, reflect.DeepEqual(exported, exported2))
+	require.Equal(t, addrs[1], k2.GetAdmin(ctx2, crypto.MustAddressFromBytes(contract)))
+	require.Equal(t, addrs[0], k2.GetDeployer(ctx2, crypto.MustAddressFromBytes(contract)))
+	require.Equal(t, sweptFunds, k2.GetSweptFunds(ctx2))
+	require.Equal(t, []types.VerifiedContract{verified}, k2.GetAllVerifiedContracts(ctx2))
+	require.Equal(t, []types.FrozenContract{frozen}, k2.GetAllFrozenContracts(ctx2))
+	require.Equal(t, time.Hour, k2.GetCertifierFreezeDuration(ctx2))
+	require.Equal(t, oracleCheckParams, k2.GetOracleCheckParams(ctx2))
+	require.Equal(t, []string{"/cosmos.bank.v1beta1.Msg/Send"}, k2.GetDispatchAllowlist(ctx2))
+	require.Equal(t, []types.Sponsorship{sponsorship}, k2.GetAllSponsorships(ctx2))
+	require.Equal(t, []types.SponsorUsage{usage}, k2.GetAllSponsorUsages(ctx2))
+	require.Equal(t, uint64(1000000), k2.GetScheduleBlockGasLimit(ctx2))
+	require.Equal(t, []types.ScheduledCall{scheduledCall}, k2.GetAllScheduledCalls(ctx2))
+	require.Equal(t, uint64(2), k2.GetNextScheduledCallID(ctx2))
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/certikfoundation/shentu/x/cvm/types"
 )
@@ -23,8 +24,60 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.Deploy(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgMigrate:
+			res, err := msgServer.Migrate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgVerifyContract:
+			res, err := msgServer.VerifyContract(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFreezeContract:
+			res, err := msgServer.FreezeContract(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUnfreezeContract:
+			res, err := msgServer.UnfreezeContract(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetSponsorship:
+			res, err := msgServer.SetSponsorship(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRevokeSponsorship:
+			res, err := msgServer.RevokeSponsorship(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgScheduleCall:
+			res, err := msgServer.ScheduleCall(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCancelScheduledCall:
+			res, err := msgServer.CancelScheduledCall(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgMultiCall:
+			res, err := msgServer.MultiCall(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Unrecognized CVM Msg type: %v", msg)
 		}
 	}
 }
+
+// NewProposalHandler returns a handler for contract migration, freeze and unfreeze proposals.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ContractMigrationProposal:
+			return keeper.HandleContractMigrationProposal(ctx, k, c)
+		case *types.ContractFreezeProposal:
+			return keeper.HandleContractFreezeProposal(ctx, k, c)
+		case *types.ContractUnfreezeProposal:
+			return keeper.HandleContractUnfreezeProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized cvm proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package cvm
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/05-port/types"
+
+	"github.com/certikfoundation/shentu/x/cvm/keeper"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// IBCMiddleware wraps the ICS-20 transfer application to call contracts on the receipt of the
+// transfers whose receivers request a call, see types.ParseIBCHook. The other callbacks are left
+// to the transfer application.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware returns the CVM middleware of the transfer application.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. The transfer of a packet requesting a call is
+// received by the caller of the contract, see types.IBCHookCaller, and the contract is credited and
+// called once the transfer succeeds. If the call fails, the transfer is discarded as well and the
+// packet is acknowledged with an error, so that the sender is refunded.
+//
+// The call depends on the outcome of the transfer, hence it is only made for transfers acknowledged
+// synchronously. The packets whose acknowledgements the transfer application defers are
+// acknowledged with an error instead, rather than calling contracts with coins not yet received.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) (*sdk.Result, []byte, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet)
+	}
+	hook, ok, err := types.ParseIBCHook(data.Receiver)
+	if !ok {
+		return im.IBCModule.OnRecvPacket(ctx, packet)
+	}
+	if err != nil {
+		return im.fail(ctx, hook, nil, err)
+	}
+
+	caller := types.IBCHookCaller(packet.GetDestChannel(), data.Sender)
+	data.Receiver = caller.String()
+	packet.Data = data.GetBytes()
+
+	cacheCtx, write := ctx.CacheContext()
+	res, ack, err := im.IBCModule.OnRecvPacket(cacheCtx, packet)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ack == nil {
+		return im.fail(ctx, hook, caller, sdkerrors.Wrap(channeltypes.ErrInvalidAcknowledgement, "asynchronous acknowledgement"))
+	}
+	var acknowledgement channeltypes.Acknowledgement
+	err = transfertypes.ModuleCdc.UnmarshalJSON(ack, &acknowledgement)
+	if _, ok := acknowledgement.Response.(*channeltypes.Acknowledgement_Result); err != nil || !ok {
+		// The transfer failed, leave it to the transfer application.
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		return res, ack, nil
+	}
+
+	coin := sdk.NewCoin(receivedDenom(packet, data), sdk.NewIntFromUint64(data.Amount))
+	if _, err := im.keeper.ExecuteIBCHook(cacheCtx, caller, hook, sdk.NewCoins(coin)); err != nil {
+		return im.fail(ctx, hook, caller, err)
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(newIBCHookEvent(hook, caller, nil))
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, ack, nil
+}
+
+// fail acknowledges a packet whose contract call failed with an error.
+func (im IBCMiddleware) fail(ctx sdk.Context, hook types.IBCHook, caller sdk.AccAddress, err error) (*sdk.Result, []byte, error) {
+	ctx.EventManager().EmitEvent(newIBCHookEvent(hook, caller, err))
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, channeltypes.NewErrorAcknowledgement(err.Error()).GetBytes(), nil
+}
+
+// newIBCHookEvent returns the event of the contract call of a transfer.
+func newIBCHookEvent(hook types.IBCHook, caller sdk.AccAddress, err error) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyContract, hook.Contract.String()),
+		sdk.NewAttribute(types.AttributeKeyCaller, caller.String()),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+	if err != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyReason, err.Error()))
+	}
+	return sdk.NewEvent(types.EventTypeIBCHook, attrs...)
+}
+
+// receivedDenom returns the denom of the coins the transfer application credits for a packet.
+func receivedDenom(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// The coins return to this chain and are unescrowed, without the prefix added by the sender.
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := data.Denom[len(voucherPrefix):]
+		denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom)
+		if denomTrace.Path == "" {
+			return unprefixedDenom
+		}
+		return denomTrace.IBCDenom()
+	}
+	prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
@@ -0,0 +1,404 @@
+package cvm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmprotoversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/version"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer"
+	transfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	ibc "github.com/cosmos/cosmos-sdk/x/ibc/core"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/core/03-connection/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+	ibcmock "github.com/cosmos/cosmos-sdk/x/ibc/testing/mock"
+
+	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// testChain runs the simulation app as the counterparty of a chain of the IBC testing coordinator.
+// Its blocks follow the clock of the coordinator and their headers are signed by a mock validator,
+// so that the clients of the two chains verify each other's state.
+type testChain struct {
+	t           *testing.T
+	coordinator *ibctesting.Coordinator
+	clock       *ibctesting.TestChain
+	app         *simapp.SimApp
+	chainID     string
+	vals        *tmtypes.ValidatorSet
+	signers     []tmtypes.PrivValidator
+	lastHeader  *ibctmtypes.Header
+}
+
+func newTestChain(t *testing.T, coordinator *ibctesting.Coordinator, clock *ibctesting.TestChain, chainID string) *testChain {
+	privVal := ibcmock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	chain := &testChain{
+		t:           t,
+		coordinator: coordinator,
+		clock:       clock,
+		app:         simapp.Setup(false),
+		chainID:     chainID,
+		vals:        tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)}),
+		signers:     []tmtypes.PrivValidator{privVal},
+	}
+	chain.app.Commit()
+	chain.nextBlock(nil)
+	return chain
+}
+
+// ctx returns a context on the committed state of the chain.
+func (chain *testChain) ctx() sdk.Context {
+	return chain.app.BaseApp.NewContext(true, tmproto.Header{ChainID: chain.chainID, Height: chain.app.LastBlockHeight()})
+}
+
+// nextBlock runs and commits a block, after the time of the coordinator is incremented.
+func (chain *testChain) nextBlock(run func(ctx sdk.Context)) {
+	chain.coordinator.IncrementTime()
+	header := tmproto.Header{
+		ChainID:            chain.chainID,
+		Height:             chain.app.LastBlockHeight() + 1,
+		AppHash:            chain.app.LastCommitID().Hash,
+		Time:               chain.clock.CurrentHeader.Time,
+		ValidatorsHash:     chain.vals.Hash(),
+		NextValidatorsHash: chain.vals.Hash(),
+	}
+	chain.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	if run != nil {
+		run(chain.app.BaseApp.NewContext(false, header))
+	}
+	chain.app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	chain.app.Commit()
+	chain.lastHeader = chain.signHeader(header)
+}
+
+// signHeader returns the light client header of a block signed by the validators of the chain.
+func (chain *testChain) signHeader(header tmproto.Header) *ibctmtypes.Header {
+	tmHeader := tmtypes.Header{
+		Version:            tmprotoversion.Consensus{Block: tmversion.BlockProtocol, App: 2},
+		ChainID:            header.ChainID,
+		Height:             header.Height,
+		Time:               header.Time,
+		LastBlockID:        ibctesting.MakeBlockID(make([]byte, tmhash.Size), 10_000, make([]byte, tmhash.Size)),
+		DataHash:           tmhash.Sum([]byte("data_hash")),
+		ValidatorsHash:     header.ValidatorsHash,
+		NextValidatorsHash: header.NextValidatorsHash,
+		ConsensusHash:      tmhash.Sum([]byte("consensus_hash")),
+		AppHash:            header.AppHash,
+		LastResultsHash:    tmhash.Sum([]byte("last_results_hash")),
+		EvidenceHash:       tmhash.Sum([]byte("evidence_hash")),
+		ProposerAddress:    chain.vals.Proposer.Address,
+	}
+	blockID := ibctesting.MakeBlockID(tmHeader.Hash(), 3, tmhash.Sum([]byte("part_set")))
+	voteSet := tmtypes.NewVoteSet(header.ChainID, header.Height, 1, tmproto.PrecommitType, chain.vals)
+	commit, err := tmtypes.MakeCommit(blockID, header.Height, 1, voteSet, chain.signers, header.Time)
+	require.NoError(chain.t, err)
+	valSet, err := chain.vals.ToProto()
+	require.NoError(chain.t, err)
+	return &ibctmtypes.Header{
+		SignedHeader: &tmproto.SignedHeader{Header: tmHeader.ToProto(), Commit: commit.ToProto()},
+		ValidatorSet: valSet,
+	}
+}
+
+// sendMsgs delivers messages in a block and returns their events. An empty block follows, so that
+// the state they write can be proven to the counterparty.
+func (chain *testChain) sendMsgs(msgs ...sdk.Msg) []abci.Event {
+	var events []abci.Event
+	chain.nextBlock(func(ctx sdk.Context) {
+		for _, msg := range msgs {
+			handler := ibc.NewHandler(*chain.app.IBCKeeper)
+			if msg.Route() == transfertypes.RouterKey {
+				handler = transfer.NewHandler(chain.app.TransferKeeper)
+			}
+			res, err := handler(ctx, msg)
+			require.NoError(chain.t, err)
+			events = append(events, res.Events...)
+		}
+	})
+	chain.nextBlock(nil)
+	return events
+}
+
+// queryProof returns the proof of an IBC store key and the height at which it is verified.
+func (chain *testChain) queryProof(key []byte) ([]byte, clienttypes.Height) {
+	res := chain.app.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
+		Height: chain.app.LastBlockHeight() - 1,
+		Data:   key,
+		Prove:  true,
+	})
+	merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	require.NoError(chain.t, err)
+	proof, err := chain.app.AppCodec().MarshalBinaryBare(&merkleProof)
+	require.NoError(chain.t, err)
+	return proof, clienttypes.NewHeight(0, uint64(res.Height)+1)
+}
+
+// packetAck returns the acknowledgement written among events.
+func packetAck(t *testing.T, events []abci.Event) []byte {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeWriteAck {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == channeltypes.AttributeKeyAck {
+				return attribute.Value
+			}
+		}
+	}
+	require.FailNow(t, "no acknowledgement written")
+	return nil
+}
+
+func TestIBCMiddleware(t *testing.T) {
+	// The transfers are relayed between a chain of the coordinator and the CVM chain.
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(0))
+	chainB := newTestChain(t, coordinator, chainA, ibctesting.GetChainID(1))
+	app := chainB.app
+	sender := chainA.SenderAccount.GetAddress()
+	relayer := chainA.SenderAccount.GetAddress()
+	port := transfertypes.PortID
+	timeoutHeight := clienttypes.NewHeight(0, 1000)
+
+	// commitA commits a block on the chain of the coordinator, so that the state written by its last
+	// messages can be proven to the CVM chain.
+	commitA := func() {
+		coordinator.CommitBlock(chainA)
+	}
+
+	// Create the clients of both chains.
+	commitA()
+	clientA := chainA.NewClientID(exported.Tendermint)
+	unbondingTime := app.StakingKeeper.UnbondingTime(chainB.ctx())
+	clientState := ibctmtypes.NewClientState(chainB.chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod,
+		unbondingTime, ibctesting.MaxClockDrift, chainB.lastHeader.GetHeight().(clienttypes.Height),
+		commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false)
+	createClient, err := clienttypes.NewMsgCreateClient(clientState, chainB.lastHeader.ConsensusState(), relayer)
+	require.NoError(t, err)
+	_, err = chainA.SendMsgs(createClient)
+	require.NoError(t, err)
+	commitA()
+
+	clientB := clienttypes.FormatClientIdentifier(exported.Tendermint, app.IBCKeeper.ClientKeeper.GetNextClientSequence(chainB.ctx()))
+	clientState = ibctmtypes.NewClientState(chainA.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod,
+		ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, chainA.LastHeader.GetHeight().(clienttypes.Height),
+		commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false)
+	createClient, err = clienttypes.NewMsgCreateClient(clientState, chainA.LastHeader.ConsensusState(), relayer)
+	require.NoError(t, err)
+	chainB.sendMsgs(createClient)
+
+	// updateA and updateB return the messages updating the clients to the last headers of the
+	// counterparties.
+	updateA := func() sdk.Msg {
+		header := *chainB.lastHeader
+		header.TrustedHeight = chainA.GetClientState(clientA).GetLatestHeight().(clienttypes.Height)
+		trustedVals, err := chainB.vals.ToProto()
+		require.NoError(t, err)
+		header.TrustedValidators = trustedVals
+		msg, err := clienttypes.NewMsgUpdateClient(clientA, &header, relayer)
+		require.NoError(t, err)
+		return msg
+	}
+	updateB := func() sdk.Msg {
+		clientState, found := app.IBCKeeper.ClientKeeper.GetClientState(chainB.ctx(), clientB)
+		require.True(t, found)
+		header := *chainA.LastHeader
+		header.TrustedHeight = clientState.GetLatestHeight().(clienttypes.Height)
+		trustedVals, err := chainA.Vals.ToProto()
+		require.NoError(t, err)
+		header.TrustedValidators = trustedVals
+		msg, err := clienttypes.NewMsgUpdateClient(clientB, &header, relayer)
+		require.NoError(t, err)
+		return msg
+	}
+	// sendA first catches the chain of the coordinator up with the clock, so that it accepts the last
+	// header of the CVM chain, and updates its client if the CVM chain has moved on.
+	sendA := func(msgs ...sdk.Msg) *sdk.Result {
+		commitA()
+		if chainA.GetClientState(clientA).GetLatestHeight().LT(chainB.lastHeader.GetHeight()) {
+			msgs = append([]sdk.Msg{updateA()}, msgs...)
+		}
+		res, err := chainA.SendMsgs(msgs...)
+		require.NoError(t, err)
+		commitA()
+		return res
+	}
+
+	// Open a connection and a transfer channel between the chains.
+	connA := connectiontypes.FormatConnectionIdentifier(chainA.App.IBCKeeper.ConnectionKeeper.GetNextConnectionSequence(chainA.GetContext()))
+	sendA(connectiontypes.NewMsgConnectionOpenInit(clientA, clientB, commitmenttypes.NewMerklePrefix(app.IBCKeeper.ConnectionKeeper.GetCommitmentPrefix().Bytes()),
+		ibctesting.DefaultOpenInitVersion, ibctesting.DefaultDelayPeriod, relayer))
+
+	connB := connectiontypes.FormatConnectionIdentifier(app.IBCKeeper.ConnectionKeeper.GetNextConnectionSequence(chainB.ctx()))
+	counterpartyClient, proofClient := chainA.QueryClientStateProof(clientA)
+	proofInit, proofHeight := chainA.QueryProof(host.ConnectionKey(connA))
+	proofConsensus, consensusHeight := chainA.QueryConsensusStateProof(clientA)
+	chainB.sendMsgs(updateB(), connectiontypes.NewMsgConnectionOpenTry("", clientB, connA, clientA, counterpartyClient,
+		chainA.GetPrefix(), []*connectiontypes.Version{ibctesting.ConnectionVersion}, ibctesting.DefaultDelayPeriod,
+		proofInit, proofClient, proofConsensus, proofHeight, consensusHeight, relayer))
+
+	counterpartyClient, found := app.IBCKeeper.ClientKeeper.GetClientState(chainB.ctx(), clientB)
+	require.True(t, found)
+	proofClient, _ = chainB.queryProof(host.FullClientStateKey(clientB))
+	consensusHeight = counterpartyClient.GetLatestHeight().(clienttypes.Height)
+	proofConsensus, _ = chainB.queryProof(host.FullConsensusStateKey(clientB, consensusHeight))
+	proofTry, proofHeight := chainB.queryProof(host.ConnectionKey(connB))
+	sendA(connectiontypes.NewMsgConnectionOpenAck(connA, connB, counterpartyClient, proofTry, proofClient, proofConsensus,
+		proofHeight, consensusHeight, ibctesting.ConnectionVersion, relayer))
+
+	proofAck, proofHeight := chainA.QueryProof(host.ConnectionKey(connA))
+	chainB.sendMsgs(updateB(), connectiontypes.NewMsgConnectionOpenConfirm(connB, proofAck, proofHeight, relayer))
+
+	channelA := channeltypes.FormatChannelIdentifier(chainA.App.IBCKeeper.ChannelKeeper.GetNextChannelSequence(chainA.GetContext()))
+	sendA(channeltypes.NewMsgChannelOpenInit(port, transfertypes.Version, channeltypes.UNORDERED, []string{connA}, port, relayer))
+
+	channelB := channeltypes.FormatChannelIdentifier(app.IBCKeeper.ChannelKeeper.GetNextChannelSequence(chainB.ctx()))
+	proofInit, proofHeight = chainA.QueryProof(host.ChannelKey(port, channelA))
+	chainB.sendMsgs(updateB(), channeltypes.NewMsgChannelOpenTry(port, "", transfertypes.Version, channeltypes.UNORDERED,
+		[]string{connB}, port, channelA, transfertypes.Version, proofInit, proofHeight, relayer))
+
+	proofTry, proofHeight = chainB.queryProof(host.ChannelKey(port, channelB))
+	sendA(channeltypes.NewMsgChannelOpenAck(port, channelA, channelB, transfertypes.Version, proofTry, proofHeight, relayer))
+
+	proofAck, proofHeight = chainA.QueryProof(host.ChannelKey(port, channelA))
+	chainB.sendMsgs(updateB(), channeltypes.NewMsgChannelOpenConfirm(port, channelB, proofAck, proofHeight, relayer))
+
+	// relayToB relays a packet sent by the chain of the coordinator and its acknowledgement back.
+	relayToB := func(packet channeltypes.Packet) channeltypes.Acknowledgement {
+		proof, proofHeight := chainA.QueryProof(host.PacketCommitmentKey(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+		ack := packetAck(t, chainB.sendMsgs(updateB(), channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, relayer)))
+		proof, proofHeight = chainB.queryProof(host.PacketAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence))
+		sendA(channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, relayer))
+
+		var acknowledgement channeltypes.Acknowledgement
+		require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+		return acknowledgement
+	}
+	// relayToA relays a packet sent by the CVM chain and its acknowledgement back.
+	relayToA := func(packet channeltypes.Packet) {
+		proof, proofHeight := chainB.queryProof(host.PacketCommitmentKey(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+		ack := packetAck(t, sendA(channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, relayer)).Events)
+		proof, proofHeight = chainA.QueryProof(host.PacketAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence))
+		chainB.sendMsgs(updateB(), channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, relayer))
+	}
+	// transfer sends a transfer from the chain of the coordinator and relays it.
+	transfer := func(coin sdk.Coin, denom, receiver string) channeltypes.Acknowledgement {
+		sequence, ok := chainA.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(chainA.GetContext(), port, channelA)
+		require.True(t, ok)
+		sendA(transfertypes.NewMsgTransfer(port, channelA, coin, sender, receiver, timeoutHeight, 0))
+		data := transfertypes.NewFungibleTokenPacketData(denom, coin.Amount.Uint64(), sender.String(), receiver)
+		packet := channeltypes.NewPacket(data.GetBytes(), sequence, port, channelA, port, channelB, timeoutHeight, 0)
+		return relayToB(packet)
+	}
+	balanceA := func() sdk.Coin {
+		return chainA.App.BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	}
+
+	var addrs []sdk.AccAddress
+	var vault, reverter sdk.AccAddress
+	chainB.nextBlock(func(ctx sdk.Context) {
+		addrs = simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(80000*1e6))
+		deploy := func(deployer sdk.AccAddress, runtime []byte) sdk.AccAddress {
+			code := bc.MustSplice(PUSH1, len(runtime), PUSH1, 12, PUSH1, 0, CODECOPY, PUSH1, len(runtime), PUSH1, 0, RETURN, runtime)
+			result, err := app.CVMKeeper.Tx(ctx, deployer, nil, 0, code, nil, false, false, false)
+			require.NoError(t, err)
+			return sdk.AccAddress(result)
+		}
+		// The vault stores its caller, the value and the first word of the data of its calls.
+		vault = deploy(addrs[0], bc.MustSplice(CALLER, PUSH1, 0, SSTORE, CALLVALUE, PUSH1, 1, SSTORE,
+			PUSH1, 0, CALLDATALOAD, PUSH1, 2, SSTORE, STOP))
+		reverter = deploy(addrs[1], bc.MustSplice(PUSH1, 0, DUP1, REVERT))
+	})
+	bondDenom := app.StakingKeeper.BondDenom(chainB.ctx())
+	storage := func(contract sdk.AccAddress, key int64) binary.Word256 {
+		value, err := app.CVMKeeper.GetStorage(chainB.ctx(), crypto.MustAddressFromBytes(contract), binary.Int64ToWord256(key))
+		require.NoError(t, err)
+		return binary.LeftPadWord256(value)
+	}
+	hook := func(contract string) string {
+		return fmt.Sprintf(`{"cvm":{"contract":"%s","data":"%064x"}}`, contract, 7)
+	}
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(port, channelB, sdk.DefaultBondDenom)).IBCDenom()
+	caller := types.IBCHookCaller(channelB, sender.String())
+
+	t.Run("credit and call the contract", func(t *testing.T) {
+		acknowledgement := transfer(coin, coin.Denom, hook(vault.String()))
+		require.Empty(t, acknowledgement.GetError())
+
+		require.Equal(t, int64(100), app.BankKeeper.GetBalance(chainB.ctx(), vault, voucherDenom).Amount.Int64())
+		require.True(t, app.BankKeeper.GetAllBalances(chainB.ctx(), caller).Empty())
+		require.Equal(t, binary.LeftPadWord256(caller), storage(vault, 0))
+		require.Equal(t, binary.Int64ToWord256(0), storage(vault, 1))
+		require.Equal(t, binary.Int64ToWord256(7), storage(vault, 2))
+	})
+
+	t.Run("refund the transfer when the call reverts", func(t *testing.T) {
+		balance := balanceA()
+		acknowledgement := transfer(coin, coin.Denom, hook(reverter.String()))
+		require.NotEmpty(t, acknowledgement.GetError())
+		require.True(t, app.BankKeeper.GetAllBalances(chainB.ctx(), reverter).Empty())
+		require.True(t, app.BankKeeper.GetAllBalances(chainB.ctx(), caller).Empty())
+		require.Equal(t, balance, balanceA())
+	})
+
+	t.Run("invalid calls are acknowledged with errors", func(t *testing.T) {
+		balance := balanceA()
+		for _, receiver := range []string{
+			hook("contract"),
+			fmt.Sprintf(`{"cvm":{"contract":"%s","data":"data"}}`, vault),
+			hook(addrs[2].String()),
+		} {
+			acknowledgement := transfer(coin, coin.Denom, receiver)
+			require.NotEmpty(t, acknowledgement.GetError())
+		}
+		require.True(t, app.BankKeeper.GetAllBalances(chainB.ctx(), caller).Empty())
+		require.Equal(t, balance, balanceA())
+	})
+
+	t.Run("transfers returning the bond denom are the value of the call", func(t *testing.T) {
+		// Send the bond denom of the CVM chain to the chain of the coordinator first.
+		sequence, ok := app.IBCKeeper.ChannelKeeper.GetNextSequenceSend(chainB.ctx(), port, channelB)
+		require.True(t, ok)
+		chainB.sendMsgs(updateB(), transfertypes.NewMsgTransfer(port, channelB, sdk.NewInt64Coin(bondDenom, 300),
+			addrs[2], sender.String(), timeoutHeight, 0))
+		data := transfertypes.NewFungibleTokenPacketData(bondDenom, 300, addrs[2].String(), sender.String())
+		relayToA(channeltypes.NewPacket(data.GetBytes(), sequence, port, channelB, port, channelA, timeoutHeight, 0))
+
+		denom := transfertypes.GetPrefixedDenom(port, channelA, bondDenom)
+		voucher := sdk.NewInt64Coin(transfertypes.ParseDenomTrace(denom).IBCDenom(), 300)
+		acknowledgement := transfer(voucher, denom, hook(vault.String()))
+		require.Empty(t, acknowledgement.GetError())
+		require.Equal(t, int64(300), app.BankKeeper.GetBalance(chainB.ctx(), vault, bondDenom).Amount.Int64())
+		require.Equal(t, binary.Int64ToWord256(300), storage(vault, 1))
+	})
+
+	t.Run("other transfers are left to the transfer application", func(t *testing.T) {
+		acknowledgement := transfer(coin, coin.Denom, addrs[2].String())
+		require.Empty(t, acknowledgement.GetError())
+		require.Equal(t, int64(100), app.BankKeeper.GetBalance(chainB.ctx(), addrs[2], voucherDenom).Amount.Int64())
+	})
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/native"
+
+	"github.com/certikfoundation/shentu/vm"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// dispatchKey is the context key marking the contexts of dispatched messages.
+type dispatchKey struct{}
+
+// DispatchCallable executes the messages dispatched by contracts.
+type DispatchCallable struct {
+	ctx           sdk.Context
+	cdc           codec.BinaryMarshaler
+	router        types.MsgServiceRouter
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+	state         *State
+	allowlist     []string
+	effects       *vm.NativeEffects
+}
+
+// dispatch executes a message on behalf of the calling contract. The input is a protobuf Any
+// holding the message, whose type URL is its Msg service method as in the service messages of
+// transactions, and the output is the protobuf encoded response of the message. The contract must
+// be the only signer of the message, and the method must be in the dispatch allowlist.
+//
+// The message runs on a cached context, which is written only if the message succeeds, so that a
+// failed message leaves no state behind. A dispatched message is not discarded together with the
+// call frame of a failed call, hence the failure of a calling frame fails the whole execution.
+// Dispatched messages cannot execute contracts, such as sending coins to a contract, since the
+// execution would miss the state cached by the caller.
+func (dc DispatchCallable) dispatch(ctx native.Context) ([]byte, error) {
+	gasRequired := big.NewInt(GasBase)
+	if ctx.Gas.Cmp(gasRequired) == -1 {
+		return nil, errors.Codes.InsufficientGas
+	} else {
+		*ctx.Gas = *ctx.Gas.Sub(ctx.Gas, gasRequired)
+	}
+	// Only a direct call can act on behalf of the caller, otherwise a contract could
+	// dispatch messages signed by its own caller through DELEGATECALL.
+	if ctx.CallType != exec.CallTypeCall {
+		return nil, errors.Codes.PermissionDenied
+	}
+
+	var any codectypes.Any
+	if err := dc.cdc.UnmarshalBinaryBare(ctx.Input, &any); err != nil {
+		return nil, errors.Wrap(errors.Codes.NativeFunction, err.Error())
+	}
+	if !dc.isAllowed(any.TypeUrl) {
+		return nil, errors.Errorf(errors.Codes.PermissionDenied, "message %s is not allowed", any.TypeUrl)
+	}
+	handler := dc.router.Handler(any.TypeUrl)
+	if handler == nil {
+		return nil, errors.Errorf(errors.Codes.NativeFunction, "unknown message %s", any.TypeUrl)
+	}
+	var msg sdk.MsgRequest
+	if err := dc.cdc.UnpackAny(&any, &msg); err != nil {
+		return nil, errors.Wrap(errors.Codes.NativeFunction, err.Error())
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(errors.Codes.NativeFunction, err.Error())
+	}
+	signers := msg.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(sdk.AccAddress(ctx.Caller.Bytes())) {
+		return nil, errors.Errorf(errors.Codes.PermissionDenied, "message %s is not signed by the contract only", any.TypeUrl)
+	}
+
+	// Touch the caller account so that messages are rejected in a read-only call frame.
+	err := engine.UpdateAccount(ctx.State.CallFrame, ctx.Caller, func(*acm.Account) error { return nil })
+	if err != nil {
+		return nil, err
+	}
+
+	// The bank module is behind the CVM state for the bond denom balances the execution has changed,
+	// bring it up to date before the message reads them.
+	cacheCtx, write := dc.ctx.CacheContext()
+	cacheCtx = cacheCtx.WithValue(dispatchKey{}, true)
+	bondDenom := dc.stakingKeeper.BondDenom(dc.ctx)
+	accounts := dc.state.LoadedAccounts()
+	for _, address := range accounts {
+		acc, err := ctx.State.CallFrame.GetAccount(address)
+		if err != nil {
+			return nil, err
+		}
+		if acc == nil {
+			continue
+		}
+		balance := sdk.NewCoin(bondDenom, sdk.NewIntFromUint64(acc.Balance))
+		if err := dc.bankKeeper.SetBalance(cacheCtx, address.Bytes(), balance); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := handler(cacheCtx, msg)
+	if err != nil {
+		return nil, errors.Wrap(errors.Codes.NativeFunction, err.Error())
+	}
+	dc.effects.Record()
+	write()
+	dc.ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDispatch,
+			sdk.NewAttribute(types.AttributeKeyContract, sdk.AccAddress(ctx.Caller.Bytes()).String()),
+			sdk.NewAttribute(types.AttributeKeyMessage, any.TypeUrl),
+		),
+	})
+	for _, event := range res.GetEvents() {
+		dc.ctx.EventManager().EmitEvent(sdk.Event(event))
+	}
+
+	// Apply the bond denom balance changes of the message back to the CVM state, so that they are
+	// not overwritten when the CVM state is synced.
+	if err := dc.syncBalances(ctx, accounts, bondDenom); err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
+
+// syncBalances sets the bond denom balances of the accounts in the CVM state to their balances in
+// the bank module.
+func (dc DispatchCallable) syncBalances(ctx native.Context, accounts []crypto.Address, bondDenom string) error {
+	for _, address := range accounts {
+		acc, err := ctx.State.CallFrame.GetAccount(address)
+		if err != nil {
+			return err
+		}
+		if acc == nil {
+			continue
+		}
+		balance := dc.bankKeeper.GetBalance(dc.ctx, address.Bytes(), bondDenom).Amount
+		if !balance.IsUint64() {
+			return errors.Codes.IntegerOverflow
+		}
+		if balance.Uint64() == acc.Balance {
+			continue
+		}
+		err = engine.UpdateAccount(ctx.State.CallFrame, address, func(acc *acm.Account) error {
+			acc.Balance = balance.Uint64()
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isAllowed returns whether contracts can dispatch the messages of a Msg service method.
+func (dc DispatchCallable) isAllowed(method string) bool {
+	for _, allowed := range dc.allowlist {
+		if method == allowed {
+			return true
+		}
+	}
+	return false
+}
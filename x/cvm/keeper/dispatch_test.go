@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/hyperledger/burrow/execution/errors"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+func TestDispatch(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 5, sdk.NewInt(80000*1e6))
+	user, recipient := addrs[0], addrs[1]
+	denom := app.StakingKeeper.BondDenom(ctx)
+	// The simulation app does not register the Msg services of its modules.
+	banktypes.RegisterMsgServer(app.MsgServiceRouter(), bankkeeper.NewMsgServerImpl(app.BankKeeper))
+
+	// The dispatcher passes its input to the dispatch native and returns its output, or reverts if
+	// the dispatch fails.
+	dispatcher := deployRuntime(t, ctx, app.CVMKeeper, addrs[2], bc.MustSplice(CALLDATASIZE, PUSH1, 0, PUSH1, 0, CALLDATACOPY,
+		PUSH1, 0, PUSH1, 0, CALLDATASIZE, PUSH1, 0, PUSH1, 0, PUSH1, 113, GAS, CALL, PUSH1, 26, JUMPI,
+		PUSH1, 0, DUP1, REVERT, JUMPDEST, RETURNDATASIZE, PUSH1, 0, PUSH1, 0, RETURNDATACOPY, RETURNDATASIZE, PUSH1, 0, RETURN))
+	// The reverter dispatches its input and reverts, and the proxy calls the reverter ignoring its
+	// failure.
+	reverter := deployRuntime(t, ctx, app.CVMKeeper, addrs[3], bc.MustSplice(CALLDATASIZE, PUSH1, 0, PUSH1, 0, CALLDATACOPY,
+		PUSH1, 0, PUSH1, 0, CALLDATASIZE, PUSH1, 0, PUSH1, 0, PUSH1, 113, GAS, CALL, POP, PUSH1, 0, DUP1, REVERT))
+	proxy := deployRuntime(t, ctx, app.CVMKeeper, addrs[4], bc.MustSplice(CALLDATASIZE, PUSH1, 0, PUSH1, 0, CALLDATACOPY,
+		PUSH1, 0, PUSH1, 0, CALLDATASIZE, PUSH1, 0, PUSH1, 0, PUSH20, reverter.Bytes(), GAS, CALL, POP, STOP))
+	for _, contract := range []sdk.AccAddress{dispatcher, reverter} {
+		require.NoError(t, app.BankKeeper.BaseKeeper.SendCoins(ctx, user, contract, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+	}
+
+	encode := func(method string, msg sdk.MsgRequest) []byte {
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		any.TypeUrl = method
+		bz, err := app.AppCodec().MarshalBinaryBare(any)
+		require.NoError(t, err)
+		return bz
+	}
+	send := func(from sdk.AccAddress, amount int64) []byte {
+		return encode("/cosmos.bank.v1beta1.Msg/Send", banktypes.NewMsgSend(from, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, amount))))
+	}
+	balance := func(ctx sdk.Context, addr sdk.AccAddress) int64 {
+		return app.BankKeeper.GetBalance(ctx, addr, denom).Amount.Int64()
+	}
+	recipientBalance := balance(ctx, recipient)
+
+	t.Run("dispatch a message signed by the contract", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		// The value of the call is dispatched along with the balance of the contract.
+		result, err := app.CVMKeeper.Tx(ctx, user, dispatcher, 500, send(dispatcher, 1200), nil, false, false, false)
+		require.NoError(t, err)
+		require.Empty(t, result)
+		require.Equal(t, int64(300), balance(ctx, dispatcher))
+		require.Equal(t, recipientBalance+1200, balance(ctx, recipient))
+
+		var dispatched bool
+		for _, event := range ctx.EventManager().Events() {
+			dispatched = dispatched || event.Type == types.EventTypeDispatch
+		}
+		require.True(t, dispatched)
+	})
+
+	t.Run("rejected messages", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			input []byte
+		}{
+			{"signed by another account", send(user, 100)},
+			{"not allowed", encode("/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress",
+				distrtypes.NewMsgSetWithdrawAddress(dispatcher, recipient))},
+			{"invalid message", send(dispatcher, 0)},
+			{"failed message", send(dispatcher, 2000)},
+			{"executing a contract", encode("/cosmos.bank.v1beta1.Msg/Send",
+				banktypes.NewMsgSend(dispatcher, proxy, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))},
+			{"not a message", []byte("message")},
+		}
+		for _, tc := range tests {
+			ctx, _ := ctx.CacheContext()
+			_, err := app.CVMKeeper.Tx(ctx, user, dispatcher, 0, tc.input, nil, false, false, false)
+			require.Error(t, err, tc.name)
+			require.Equal(t, int64(1000), balance(ctx, dispatcher), tc.name)
+			require.Equal(t, recipientBalance, balance(ctx, recipient), tc.name)
+		}
+	})
+
+	t.Run("a failed frame with a dispatched message fails the execution", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		_, err := app.CVMKeeper.Tx(ctx, user, proxy, 0, send(reverter, 100), nil, false, false, false)
+		require.Equal(t, types.ErrCodedError(errors.Codes.ExecutionReverted), err)
+	})
+
+	t.Run("no dispatcher without an allowlist", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		app.CVMKeeper.SetDispatchAllowlist(ctx, nil)
+		_, err := app.CVMKeeper.Tx(ctx, user, dispatcher, 0, send(dispatcher, 100), nil, false, false, false)
+		require.Equal(t, types.ErrCodedError(errors.Codes.Generic), err)
+		require.Equal(t, int64(1000), balance(ctx, dispatcher))
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/hex"
 	"strconv"
 
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/hyperledger/burrow/execution/errors"
@@ -13,11 +15,15 @@ import (
 )
 
 type EventSink struct {
-	ctx sdk.Context
+	ctx  sdk.Context
+	tkey sdk.StoreKey
+	logs []types.Log
 }
 
-func NewEventSink(ctx sdk.Context) *EventSink {
-	return &EventSink{ctx}
+// NewEventSink returns an event sink emitting CVM events. Log positions are counted in the
+// transient store of the given key.
+func NewEventSink(ctx sdk.Context, tkey sdk.StoreKey) *EventSink {
+	return &EventSink{ctx: ctx, tkey: tkey}
 }
 
 func (es *EventSink) Call(call *exec.CallEvent, exception *errors.Exception) error {
@@ -64,37 +70,49 @@ func (es *EventSink) Call(call *exec.CallEvent, exception *errors.Exception) err
 	return nil
 }
 
+// Log emits the log and assigns its positions in the block and in the transaction. The CVM passes
+// the logs of a call frame on only when the frame succeeds, so the logs of reverted calls are
+// neither emitted nor counted.
 func (es *EventSink) Log(log *exec.LogEvent) error {
-	topicsString := ""
-	for _, topic := range log.Topics {
-		topicsString += topic.String()
-	}
-
 	b32addr, err := sdk.AccAddressFromHex(log.Address.String())
 	if err != nil {
 		panic("address data in CVM is corrupted")
 	}
 
-	es.ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeCVMEvent,
-			sdk.Attribute{
-				Key:   "address",
-				Value: b32addr.String(),
-			},
-			sdk.Attribute{
-				Key:   "topics",
-				Value: topicsString,
-			},
-			sdk.Attribute{
-				Key:   "data",
-				Value: log.Data.String(),
-			},
-		),
-	)
+	topics := make([][]byte, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = topic.Bytes()
+	}
+	logIndex, txLogIndex := es.nextLogIndexes()
+	cvmLog := types.Log{
+		Address:    b32addr.String(),
+		Topics:     topics,
+		Data:       log.Data,
+		LogIndex:   logIndex,
+		TxLogIndex: txLogIndex,
+	}
+	es.logs = append(es.logs, cvmLog)
+	es.ctx.EventManager().EmitEvent(types.NewLogEvent(cvmLog))
 	return nil
 }
 
+// nextLogIndexes returns the positions of a new log in the block and in the transaction.
+func (es *EventSink) nextLogIndexes() (uint64, uint64) {
+	store := es.ctx.TransientStore(es.tkey)
+	txKey := types.TxLogCountKey(tmhash.Sum(es.ctx.TxBytes()))
+	return incrementCount(store, types.BlockLogCountKey), incrementCount(store, txKey)
+}
+
+// incrementCount increments the counter at the key and returns its previous value.
+func incrementCount(store sdk.KVStore, key []byte) uint64 {
+	var count uint64
+	if bz := store.Get(key); bz != nil {
+		count = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(count+1))
+	return count
+}
+
 func (es *EventSink) Print(print *exec.PrintEvent) error {
 	return nil
 }
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/txs/payload"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// transferAbiJsonString declares the event emitted by the transfer contract.
+const transferAbiJsonString = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`
+
+func TestLogEvents(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(80000*1e6))
+
+	// The contract logs Transfer(caller, <first word of the call data>) on every call.
+	topic := abi.GetEventID("Transfer(address,uint256)")
+	runtime := bc.MustSplice(PUSH1, 0, CALLDATALOAD, PUSH1, 0, MSTORE,
+		CALLER, PUSH32, topic.Bytes(), PUSH1, 32, PUSH1, 0, LOG2, STOP)
+	code := bc.MustSplice(PUSH1, len(runtime), PUSH1, 12, PUSH1, 0, CODECOPY, PUSH1, len(runtime), PUSH1, 0, RETURN, runtime)
+	result, err := app.CVMKeeper.Tx(ctx, addrs[0], nil, 0, code, []*payload.ContractMeta{}, false, false, false)
+	require.Nil(t, err)
+	contract := sdk.AccAddress(result)
+	app.CVMKeeper.SetAbi(ctx, crypto.MustAddressFromBytes(contract), []byte(transferAbiJsonString))
+
+	call := func(ctx sdk.Context, caller sdk.AccAddress, value byte) []types.Log {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		data := make([]byte, 32)
+		data[31] = value
+		_, err := app.CVMKeeper.Tx(ctx, caller, contract, 0, data, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+
+		// Events of the same type are merged in transaction logs.
+		events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+		require.Len(t, events, 1)
+		require.Equal(t, types.EventTypeCVMEvent, events[0].Type)
+		logs, err := types.ParseLogEvents(events[0].Attributes)
+		require.Nil(t, err)
+		return logs
+	}
+
+	var logs []types.Log
+	t.Run("emit logs in a transaction", func(t *testing.T) {
+		ctx := ctx.WithTxBytes([]byte("tx1"))
+		logs = append(call(ctx, addrs[0], 7), call(ctx, addrs[1], 8)...)
+		require.Len(t, logs, 2)
+		for i, log := range logs {
+			require.Equal(t, contract.String(), log.Address)
+			require.Len(t, log.Topics, 2)
+			require.Equal(t, topic.Bytes(), log.Topics[0])
+			require.Equal(t, addrs[i].Bytes(), log.Topics[1][12:])
+			require.Len(t, log.Data, 32)
+			require.Equal(t, uint64(i), log.LogIndex)
+			require.Equal(t, uint64(i), log.TxLogIndex)
+		}
+	})
+
+	t.Run("emit a log in the next transaction of the block", func(t *testing.T) {
+		next := call(ctx.WithTxBytes([]byte("tx2")), addrs[0], 9)
+		require.Len(t, next, 1)
+		require.Equal(t, uint64(2), next[0].LogIndex)
+		require.Equal(t, uint64(0), next[0].TxLogIndex)
+	})
+
+	t.Run("decode logs with the contract ABI", func(t *testing.T) {
+		decoded, err := app.CVMKeeper.DecodeLog(ctx, logs[1])
+		require.Nil(t, err)
+		require.Equal(t, "Transfer", decoded.Event)
+		require.Equal(t, logs[1], decoded.Log)
+		require.Equal(t, []types.EventParam{
+			{Name: "from", Value: crypto.MustAddressFromBytes(addrs[1]).String(), Indexed: true},
+			{Name: "value", Value: "8"},
+		}, decoded.Params)
+	})
+
+	t.Run("keep logs of unknown events undecoded", func(t *testing.T) {
+		log := logs[0]
+		log.Topics = [][]byte{make([]byte, 32)}
+		decoded, err := app.CVMKeeper.DecodeLog(ctx, log)
+		require.Nil(t, err)
+		require.Empty(t, decoded.Event)
+		require.Empty(t, decoded.Params)
+
+		log = logs[0]
+		log.Topics = log.Topics[:1]
+		_, err = app.CVMKeeper.DecodeLog(ctx, log)
+		require.NotNil(t, err)
+	})
+	t.Run("skip logs of reverted calls", func(t *testing.T) {
+		// The callee logs and reverts; the caller ignores the failure and logs itself.
+		callee := deployRuntime(t, ctx, app.CVMKeeper, addrs[1], bc.MustSplice(
+			PUSH32, topic.Bytes(), PUSH1, 0, DUP1, LOG1, PUSH1, 0, DUP1, REVERT))
+		caller := deployRuntime(t, ctx, app.CVMKeeper, addrs[2], bc.MustSplice(
+			PUSH1, 0, DUP1, DUP1, DUP1, DUP1, PUSH20, callee.Bytes(), GAS, CALL, POP,
+			PUSH32, topic.Bytes(), PUSH1, 0, DUP1, LOG1, STOP))
+
+		ctx := ctx.WithTxBytes([]byte("tx3")).WithEventManager(sdk.NewEventManager())
+		_, err := app.CVMKeeper.Tx(ctx, addrs[0], caller, 0, nil, nil, false, false, false)
+		require.Nil(t, err)
+
+		var logs []types.Log
+		for _, event := range sdk.StringifyEvents(ctx.EventManager().ABCIEvents()) {
+			if event.Type == types.EventTypeCVMEvent {
+				logs, err = types.ParseLogEvents(event.Attributes)
+				require.Nil(t, err)
+			}
+		}
+		require.Len(t, logs, 1)
+		require.Equal(t, caller.String(), logs[0].Address)
+		require.Equal(t, uint64(3), logs[0].LogIndex)
+		require.Equal(t, uint64(0), logs[0].TxLogIndex)
+	})
+}
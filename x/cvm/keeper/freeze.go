@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/hyperledger/burrow/crypto"
+
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// SetFrozenContract sets the freeze of a contract.
+func (k Keeper) SetFrozenContract(ctx sdk.Context, address crypto.Address, frozen types.FrozenContract) {
+	ctx.KVStore(k.key).Set(types.FrozenContractStoreKey(address), k.cdc.MustMarshalBinaryBare(&frozen))
+}
+
+// GetFrozenContract returns the freeze of a contract, which may have expired.
+func (k Keeper) GetFrozenContract(ctx sdk.Context, address crypto.Address) (types.FrozenContract, bool) {
+	bz := ctx.KVStore(k.key).Get(types.FrozenContractStoreKey(address))
+	if bz == nil {
+		return types.FrozenContract{}, false
+	}
+	var frozen types.FrozenContract
+	k.cdc.MustUnmarshalBinaryBare(bz, &frozen)
+	return frozen, true
+}
+
+// DeleteFrozenContract deletes the freeze of a contract.
+func (k Keeper) DeleteFrozenContract(ctx sdk.Context, address crypto.Address) {
+	ctx.KVStore(k.key).Delete(types.FrozenContractStoreKey(address))
+}
+
+// IterateFrozenContracts iterates over the freezes of contracts.
+func (k Keeper) IterateFrozenContracts(ctx sdk.Context, callback func(frozen types.FrozenContract) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.FrozenContractStoreKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var frozen types.FrozenContract
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &frozen)
+		if callback(frozen) {
+			break
+		}
+	}
+}
+
+// GetAllFrozenContracts returns the freezes of all contracts.
+func (k Keeper) GetAllFrozenContracts(ctx sdk.Context) []types.FrozenContract {
+	frozenContracts := []types.FrozenContract{}
+	k.IterateFrozenContracts(ctx, func(frozen types.FrozenContract) bool {
+		frozenContracts = append(frozenContracts, frozen)
+		return false
+	})
+	return frozenContracts
+}
+
+// IsFrozen returns whether the calls into an address are rejected. The read is not charged, so that
+// the executions of chains without frozen contracts replay identically.
+func (k Keeper) IsFrozen(ctx sdk.Context, address crypto.Address) bool {
+	frozen, ok := k.GetFrozenContract(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), address)
+	return ok && !isExpired(ctx, frozen)
+}
+
+// isExpired returns whether a freeze has expired at the block time.
+func isExpired(ctx sdk.Context, frozen types.FrozenContract) bool {
+	return !frozen.Expiry.IsZero() && !ctx.BlockTime().Before(frozen.Expiry)
+}
+
+// FreezeContract freezes a contract, so that its calls are rejected. The freeze of a certifier
+// expires after the certifier freeze duration, while the governance sets the duration of its
+// freezes, where zero freezes the contract until it is unfrozen. Certifiers cannot replace the
+// freezes of the governance, and authorization is left to the callers.
+func (k Keeper) FreezeContract(ctx sdk.Context, contract sdk.AccAddress, reason string, certifier sdk.AccAddress,
+	duration time.Duration) error {
+	address := crypto.MustAddressFromBytes(contract)
+	account, err := k.NewState(ctx).GetAccount(address)
+	if err != nil {
+		return err
+	}
+	if account == nil || (len(account.EVMCode) == 0 && len(account.WASMCode) == 0) {
+		return sdkerrors.Wrapf(types.ErrNotContract, "%s", contract)
+	}
+
+	frozen := types.FrozenContract{
+		Address: contract.String(),
+		Reason:  reason,
+	}
+	if certifier != nil {
+		if previous, ok := k.GetFrozenContract(ctx, address); ok && previous.Certifier == "" && !isExpired(ctx, previous) {
+			return sdkerrors.Wrapf(types.ErrFrozenByGovernance, "%s", contract)
+		}
+		frozen.Certifier = certifier.String()
+		duration = k.GetCertifierFreezeDuration(ctx)
+	}
+	if duration > 0 {
+		frozen.Expiry = ctx.BlockTime().Add(duration)
+	}
+	k.SetFrozenContract(ctx, address, frozen)
+
+	expiry := ""
+	if !frozen.Expiry.IsZero() {
+		expiry = frozen.Expiry.Format(time.RFC3339)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFreeze,
+			sdk.NewAttribute(types.AttributeKeyContract, frozen.Address),
+			sdk.NewAttribute(types.AttributeKeyReason, frozen.Reason),
+			sdk.NewAttribute(types.AttributeKeyCertifier, frozen.Certifier),
+			sdk.NewAttribute(types.AttributeKeyExpiry, expiry),
+		),
+	)
+	return nil
+}
+
+// UnfreezeContract unfreezes a contract. Certifiers cannot unfreeze the freezes of the governance,
+// and authorization is left to the callers.
+func (k Keeper) UnfreezeContract(ctx sdk.Context, contract sdk.AccAddress, certifier sdk.AccAddress) error {
+	address := crypto.MustAddressFromBytes(contract)
+	frozen, ok := k.GetFrozenContract(ctx, address)
+	if !ok || isExpired(ctx, frozen) {
+		return sdkerrors.Wrapf(types.ErrNotFrozen, "%s", contract)
+	}
+	if certifier != nil && frozen.Certifier == "" {
+		return sdkerrors.Wrapf(types.ErrFrozenByGovernance, "%s", contract)
+	}
+	k.unfreeze(ctx, address, frozen)
+	return nil
+}
+
+// PruneFrozenContracts unfreezes the contracts whose freezes have expired.
+func (k Keeper) PruneFrozenContracts(ctx sdk.Context) {
+	var expired []types.FrozenContract
+	k.IterateFrozenContracts(ctx, func(frozen types.FrozenContract) bool {
+		if isExpired(ctx, frozen) {
+			expired = append(expired, frozen)
+		}
+		return false
+	})
+	for _, frozen := range expired {
+		contract, err := sdk.AccAddressFromBech32(frozen.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.unfreeze(ctx, crypto.MustAddressFromBytes(contract), frozen)
+	}
+}
+
+func (k Keeper) unfreeze(ctx sdk.Context, address crypto.Address, frozen types.FrozenContract) {
+	k.DeleteFrozenContract(ctx, address)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnfreeze,
+			sdk.NewAttribute(types.AttributeKeyContract, frozen.Address),
+		),
+	)
+}
+
+// HandleContractFreezeProposal freezes a contract on behalf of the governance.
+func HandleContractFreezeProposal(ctx sdk.Context, k Keeper, p *types.ContractFreezeProposal) error {
+	contract, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return err
+	}
+	return k.FreezeContract(ctx, contract, p.Reason, nil, p.Duration)
+}
+
+// HandleContractUnfreezeProposal unfreezes a contract on behalf of the governance.
+func HandleContractUnfreezeProposal(ctx sdk.Context, k Keeper, p *types.ContractUnfreezeProposal) error {
+	contract, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return err
+	}
+	return k.UnfreezeContract(ctx, contract, nil)
+}
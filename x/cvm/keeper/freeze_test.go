@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/simapp"
+	certtypes "github.com/certikfoundation/shentu/x/cert/types"
+	"github.com/certikfoundation/shentu/x/cvm/keeper"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// deployRuntime deploys runtime code with a constructor returning it.
+func deployRuntime(t *testing.T, ctx sdk.Context, k keeper.Keeper, deployer sdk.AccAddress, runtime []byte) sdk.AccAddress {
+	code := bc.MustSplice(PUSH1, len(runtime), PUSH1, 12, PUSH1, 0, CODECOPY, PUSH1, len(runtime), PUSH1, 0, RETURN, runtime)
+	result, err := k.Tx(ctx, deployer, nil, 0, code, nil, false, false, false)
+	require.NoError(t, err)
+	return sdk.AccAddress(result)
+}
+
+func TestFreezeContract(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(80000*1e6))
+	certifier, user := addrs[0], addrs[1]
+	msgServer := keeper.NewMsgServerImpl(app.CVMKeeper)
+	querier := keeper.Querier{Keeper: app.CVMKeeper}
+	app.CertKeeper.SetCertifier(ctx, certtypes.Certifier{Address: certifier.String()})
+
+	target := deployRuntime(t, ctx, app.CVMKeeper, addrs[0], bc.MustSplice(PUSH1, 7, PUSH1, 0, SSTORE, STOP))
+	// The proxy calls the target with all its gas, and fails if the call fails.
+	proxy := deployRuntime(t, ctx, app.CVMKeeper, addrs[1], bc.MustSplice(PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0,
+		PUSH1, 0, PUSH20, target.Bytes(), GAS, CALL, PUSH1, 40, JUMPI, PUSH1, 0, DUP1, REVERT, JUMPDEST, STOP))
+	call := func(ctx sdk.Context, callee sdk.AccAddress) error {
+		_, err := app.CVMKeeper.Tx(ctx, user, callee, 0, nil, nil, false, false, false)
+		return err
+	}
+	require.NoError(t, call(ctx, target))
+	require.NoError(t, call(ctx, proxy))
+
+	t.Run("only certifiers can freeze", func(t *testing.T) {
+		msg := types.NewMsgFreezeContract(user.String(), target.String(), "exploited")
+		_, err := msgServer.FreezeContract(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, types.ErrNotCertifier)
+
+		msg = types.NewMsgFreezeContract(certifier.String(), user.String(), "exploited")
+		_, err = msgServer.FreezeContract(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, types.ErrNotContract)
+	})
+
+	t.Run("certifier freeze rejects direct and nested calls", func(t *testing.T) {
+		msg := types.NewMsgFreezeContract(certifier.String(), target.String(), "exploited")
+		_, err := msgServer.FreezeContract(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, call(ctx, target), types.ErrContractFrozen)
+		require.Equal(t, types.ErrCodedError(errors.Codes.PermissionDenied), call(ctx, proxy))
+
+		res, err := querier.FrozenContract(sdk.WrapSDKContext(ctx), &types.QueryFrozenContractRequest{Address: target.String()})
+		require.NoError(t, err)
+		require.Equal(t, types.FrozenContract{
+			Address:   target.String(),
+			Reason:    "exploited",
+			Certifier: certifier.String(),
+			Expiry:    ctx.BlockTime().Add(types.DefaultCertifierFreezeDuration),
+		}, res.FrozenContract)
+	})
+
+	t.Run("certifier freeze expires", func(t *testing.T) {
+		ctx := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultCertifierFreezeDuration))
+		require.NoError(t, call(ctx, proxy))
+		_, err := querier.FrozenContract(sdk.WrapSDKContext(ctx), &types.QueryFrozenContractRequest{Address: target.String()})
+		require.Error(t, err)
+
+		ctx, _ = ctx.CacheContext()
+		app.CVMKeeper.PruneFrozenContracts(ctx)
+		require.Empty(t, app.CVMKeeper.GetAllFrozenContracts(ctx))
+	})
+
+	t.Run("certifier unfreeze", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		msg := types.NewMsgUnfreezeContract(certifier.String(), target.String())
+		_, err := msgServer.UnfreezeContract(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+		require.NoError(t, call(ctx, proxy))
+
+		_, err = msgServer.UnfreezeContract(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, types.ErrNotFrozen)
+	})
+
+	t.Run("governance freeze takes over", func(t *testing.T) {
+		proposal := types.NewContractFreezeProposal("title", "description", target.String(), "exploited", 0)
+		require.NoError(t, proposal.ValidateBasic())
+		require.NoError(t, keeper.HandleContractFreezeProposal(ctx, app.CVMKeeper, proposal))
+
+		res, err := querier.FrozenContracts(sdk.WrapSDKContext(ctx), &types.QueryFrozenContractsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []types.FrozenContract{{Address: target.String(), Reason: "exploited"}}, res.FrozenContracts)
+
+		// The governance freeze does not expire, and certifiers can neither replace nor lift it.
+		later := ctx.WithBlockTime(ctx.BlockTime().Add(365 * 24 * time.Hour))
+		require.ErrorIs(t, call(later, target), types.ErrContractFrozen)
+		freeze := types.NewMsgFreezeContract(certifier.String(), target.String(), "exploited")
+		_, err = msgServer.FreezeContract(sdk.WrapSDKContext(ctx), &freeze)
+		require.ErrorIs(t, err, types.ErrFrozenByGovernance)
+		unfreeze := types.NewMsgUnfreezeContract(certifier.String(), target.String())
+		_, err = msgServer.UnfreezeContract(sdk.WrapSDKContext(ctx), &unfreeze)
+		require.ErrorIs(t, err, types.ErrFrozenByGovernance)
+	})
+
+	t.Run("governance unfreeze", func(t *testing.T) {
+		proposal := types.NewContractUnfreezeProposal("title", "description", target.String())
+		require.NoError(t, proposal.ValidateBasic())
+		require.NoError(t, keeper.HandleContractUnfreezeProposal(ctx, app.CVMKeeper, proposal))
+		require.NoError(t, call(ctx, proxy))
+		_, ok := app.CVMKeeper.GetFrozenContract(ctx, crypto.MustAddressFromBytes(target))
+		require.False(t, ok)
+	})
+}
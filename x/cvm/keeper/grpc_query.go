@@ -1,8 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hyperledger/burrow/execution/evm/abi"
 
@@ -11,8 +17,11 @@ import (
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/certikfoundation/shentu/vm"
 	"github.com/certikfoundation/shentu/x/cvm/types"
 )
 
@@ -77,6 +86,29 @@ func (q Querier) Storage(c context.Context, request *types.QueryStorageRequest)
 	}, nil
 }
 
+// ContractStorage returns the storage of a contract in key order.
+func (q Querier) ContractStorage(c context.Context, request *types.QueryContractStorageRequest) (*types.QueryContractStorageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	addr, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s", request.Address)
+	}
+
+	var storage []types.Storage
+	store := prefix.NewStore(ctx.KVStore(q.key), types.ContractStorageStoreKeyPrefix(crypto.MustAddressFromBytes(addr)))
+	pageRes, err := query.Paginate(store, request.Pagination, func(key []byte, value []byte) error {
+		storage = append(storage, types.Storage{Key: binary.LeftPadWord256(key), Value: value})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryContractStorageResponse{
+		Storage:    storage,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (q Querier) AddressMeta(c context.Context, request *types.QueryAddressMetaRequest) (*types.QueryAddressMetaResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	addr, err := sdk.AccAddressFromBech32(request.Address)
@@ -145,7 +177,16 @@ func (q Querier) View(c context.Context, request *types.QueryViewRequest) (*type
 		return nil, err
 	}
 	ret, err := q.Tx(ctx, caller, callee, 0, request.Data, nil, true, false, false)
+	if err != nil {
+		return nil, err
+	}
 
+	// Without an ABI, only the raw return data is returned.
+	if len(request.AbiSpec) == 0 {
+		return &types.QueryViewResponse{
+			ReturnData: ret,
+		}, nil
+	}
 	out, err := abi.DecodeFunctionReturn(string(request.AbiSpec), request.FunctionName, ret)
 	if err != nil {
 		return nil, err
@@ -159,7 +200,379 @@ func (q Querier) View(c context.Context, request *types.QueryViewRequest) (*type
 	}
 	return &types.QueryViewResponse{
 		ReturnVars: result,
+		ReturnData: ret,
+	}, nil
+}
+
+// Simulate runs a call, or a deployment if no callee is given, and reports its gas usage
+// without committing any state changes.
+func (q Querier) Simulate(c context.Context, request *types.QuerySimulateRequest) (*types.QuerySimulateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	caller, err := sdk.AccAddressFromBech32(request.Caller)
+	if err != nil {
+		return nil, err
+	}
+	var callee sdk.AccAddress
+	if request.Callee != "" {
+		if callee, err = sdk.AccAddressFromBech32(request.Callee); err != nil {
+			return nil, err
+		}
+	}
+	return q.Keeper.Simulate(ctx, caller, callee, request.Value, request.Data, request.IsEwasm, request.IsRuntime)
+}
+
+// Admin returns the admin of a contract.
+func (q Querier) Admin(c context.Context, request *types.QueryAdminRequest) (*types.QueryAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	address, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, err
+	}
+	var admin string
+	if bz := q.GetAdmin(ctx, crypto.MustAddressFromBytes(address)); bz != nil {
+		admin = bz.String()
+	}
+	return &types.QueryAdminResponse{
+		Admin: admin,
+	}, nil
+}
+
+// VerifiedContract returns the verified source code of a contract.
+func (q Querier) VerifiedContract(c context.Context, request *types.QueryVerifiedContractRequest) (*types.QueryVerifiedContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	address, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, err
+	}
+	verified, ok := q.GetVerifiedContract(ctx, crypto.MustAddressFromBytes(address))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "contract %s is not verified", request.Address)
+	}
+	return &types.QueryVerifiedContractResponse{
+		VerifiedContract: verified,
+	}, nil
+}
+
+// FrozenContract returns the freeze of a frozen contract.
+func (q Querier) FrozenContract(c context.Context, request *types.QueryFrozenContractRequest) (*types.QueryFrozenContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	address, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, err
+	}
+	addr := crypto.MustAddressFromBytes(address)
+	frozen, ok := q.GetFrozenContract(ctx, addr)
+	if !ok || !q.IsFrozen(ctx, addr) {
+		return nil, status.Errorf(codes.NotFound, "contract %s is not frozen", request.Address)
+	}
+	return &types.QueryFrozenContractResponse{
+		FrozenContract: frozen,
+	}, nil
+}
+
+// FrozenContracts returns the freezes of the frozen contracts, including the expired freezes that
+// have not been pruned yet.
+func (q Querier) FrozenContracts(c context.Context, request *types.QueryFrozenContractsRequest) (*types.QueryFrozenContractsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var frozenContracts []types.FrozenContract
+	store := prefix.NewStore(ctx.KVStore(q.key), types.FrozenContractStoreKeyPrefix)
+	pageRes, err := query.Paginate(store, request.Pagination, func(_ []byte, value []byte) error {
+		var frozen types.FrozenContract
+		if err := q.cdc.UnmarshalBinaryBare(value, &frozen); err != nil {
+			return err
+		}
+		frozenContracts = append(frozenContracts, frozen)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryFrozenContractsResponse{
+		FrozenContracts: frozenContracts,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// Sponsorship returns the sponsorship of the calls into a contract and the spendable balance of its sponsor.
+func (q Querier) Sponsorship(c context.Context, request *types.QuerySponsorshipRequest) (*types.QuerySponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	contract, err := sdk.AccAddressFromBech32(request.Contract)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract %s", request.Contract)
+	}
+	sponsorship, ok := q.GetSponsorship(ctx, crypto.MustAddressFromBytes(contract))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "contract %s is not sponsored", request.Contract)
+	}
+	sponsor, err := sdk.AccAddressFromBech32(sponsorship.Sponsor)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySponsorshipResponse{
+		Sponsorship: sponsorship,
+		Balance:     q.bk.SpendableCoins(ctx, sponsor),
+	}, nil
+}
+
+// SponsorUsage returns the fees the sponsor of a contract paid for the calls of a user today, along
+// with the fees it pays for the rest of the day.
+func (q Querier) SponsorUsage(c context.Context, request *types.QuerySponsorUsageRequest) (*types.QuerySponsorUsageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	contract, err := sdk.AccAddressFromBech32(request.Contract)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract %s", request.Contract)
+	}
+	user, err := sdk.AccAddressFromBech32(request.User)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user %s", request.User)
+	}
+	address := crypto.MustAddressFromBytes(contract)
+	sponsorship, ok := q.GetSponsorship(ctx, address)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "contract %s is not sponsored", request.Contract)
+	}
+	remaining := sdk.NewCoins()
+	if !sponsorship.IsExpired(ctx.BlockTime()) {
+		remaining = q.SponsorRemaining(ctx, sponsorship, user)
+	}
+	return &types.QuerySponsorUsageResponse{
+		Usage:     q.GetSponsorUsage(ctx, address, user),
+		Remaining: remaining,
 	}, nil
 }
 
+// SponsorUsages returns the latest fees the sponsor of a contract paid for the calls of each user,
+// including the fees of the past days.
+func (q Querier) SponsorUsages(c context.Context, request *types.QuerySponsorUsagesRequest) (*types.QuerySponsorUsagesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	contract, err := sdk.AccAddressFromBech32(request.Contract)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract %s", request.Contract)
+	}
+	var usages []types.SponsorUsage
+	store := prefix.NewStore(ctx.KVStore(q.key), types.SponsorUsagesStoreKeyPrefix(crypto.MustAddressFromBytes(contract)))
+	pageRes, err := query.Paginate(store, request.Pagination, func(_ []byte, value []byte) error {
+		var usage types.SponsorUsage
+		if err := q.cdc.UnmarshalBinaryBare(value, &usage); err != nil {
+			return err
+		}
+		usages = append(usages, usage)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySponsorUsagesResponse{
+		Usages:     usages,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ScheduledCall returns a scheduled call.
+func (q Querier) ScheduledCall(c context.Context, request *types.QueryScheduledCallRequest) (*types.QueryScheduledCallResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	call, ok := q.GetScheduledCall(ctx, request.Id)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "scheduled call %d not found", request.Id)
+	}
+	return &types.QueryScheduledCallResponse{ScheduledCall: call}, nil
+}
+
+// ScheduledCalls returns the scheduled calls, optionally of a caller.
+func (q Querier) ScheduledCalls(c context.Context, request *types.QueryScheduledCallsRequest) (*types.QueryScheduledCallsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if request.Caller != "" {
+		if _, err := sdk.AccAddressFromBech32(request.Caller); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid caller %s", request.Caller)
+		}
+	}
+	var calls []types.ScheduledCall
+	store := prefix.NewStore(ctx.KVStore(q.key), types.ScheduledCallStoreKeyPrefix)
+	pageRes, err := query.FilteredPaginate(store, request.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var call types.ScheduledCall
+		if err := q.cdc.UnmarshalBinaryBare(value, &call); err != nil {
+			return false, err
+		}
+		if request.Caller != "" && call.Caller != request.Caller {
+			return false, nil
+		}
+		if accumulate {
+			calls = append(calls, call)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryScheduledCallsResponse{
+		ScheduledCalls: calls,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// SweptFunds returns the total coins swept from the zero address to the community pool.
+func (q Querier) SweptFunds(c context.Context, request *types.QuerySweptFundsRequest) (*types.QuerySweptFundsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QuerySweptFundsResponse{
+		Amount: q.GetSweptFunds(ctx),
+	}, nil
+}
+
+// DeployAddress returns the address of a contract deployed with a salt, and whether the address is
+// already taken by an account with code or sent transactions.
+func (q Querier) DeployAddress(c context.Context, request *types.QueryDeployAddressRequest) (*types.QueryDeployAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	deployer, err := sdk.AccAddressFromBech32(request.Deployer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid deployer %s", request.Deployer)
+	}
+	salt, err := hex.DecodeString(strings.TrimPrefix(request.Salt, "0x"))
+	if err != nil || len(salt) != binary.Word256Bytes {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid salt %s", request.Salt)
+	}
+	codeHash, err := hex.DecodeString(strings.TrimPrefix(request.CodeHash, "0x"))
+	if err != nil || len(codeHash) != binary.Word256Bytes {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid code hash %s", request.CodeHash)
+	}
+
+	address := types.NewSaltedContractAddress(crypto.MustAddressFromBytes(deployer), binary.LeftPadWord256(salt), codeHash)
+	return &types.QueryDeployAddressResponse{
+		Address: sdk.AccAddress(address.Bytes()).String(),
+		Exists:  q.IsDeployAddressTaken(ctx, address),
+	}, nil
+}
+
+// DebugTraceCall returns the opcode-level trace of a call replayed on the queried state.
+func (q Querier) DebugTraceCall(c context.Context, request *types.QueryDebugTraceCallRequest) (*types.QueryDebugTraceCallResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	caller, err := sdk.AccAddressFromBech32(request.Caller)
+	if err != nil {
+		return nil, err
+	}
+	var callee sdk.AccAddress
+	if request.Callee != "" {
+		if callee, err = sdk.AccAddressFromBech32(request.Callee); err != nil {
+			return nil, err
+		}
+	}
+	config := vm.StructLoggerConfig{
+		DisableStack:   request.DisableStack,
+		DisableMemory:  request.DisableMemory,
+		DisableStorage: request.DisableStorage,
+	}
+	res, err := q.Keeper.TraceCall(ctx, caller, callee, request.Value, request.Data, config)
+	if err != nil {
+		return nil, err
+	}
+	trace, err := json.Marshal(res)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryDebugTraceCallResponse{
+		Trace: string(trace),
+	}, nil
+}
+
+// DecodeLogs decodes logs with the ABIs of the contracts that emitted them.
+func (q Querier) DecodeLogs(c context.Context, request *types.QueryDecodeLogsRequest) (*types.QueryDecodeLogsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	logs := make([]types.DecodedLog, len(request.Logs))
+	for i, log := range request.Logs {
+		decoded, err := q.DecodeLog(ctx, log)
+		if err != nil {
+			return nil, err
+		}
+		logs[i] = decoded
+	}
+	return &types.QueryDecodeLogsResponse{
+		Logs: logs,
+	}, nil
+}
+
+// Receipt returns the receipt of a CVM message.
+func (q Querier) Receipt(c context.Context, request *types.QueryReceiptRequest) (*types.QueryReceiptResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	txHash, err := hex.DecodeString(request.TxHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction hash %s", request.TxHash)
+	}
+	receipt, found := q.GetReceipt(ctx, txHash, request.MsgIndex)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "receipt of message %d in transaction %s not found", request.MsgIndex, request.TxHash)
+	}
+	return &types.QueryReceiptResponse{
+		Receipt: receipt,
+	}, nil
+}
+
+// Receipts lists the receipts in a height range. Filtering by address or topic goes through the
+// receipt index, so receipts written while the index was disabled are not found by the filters.
+func (q Querier) Receipts(c context.Context, request *types.QueryReceiptsRequest) (*types.QueryReceiptsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var topic []byte
+	if request.Topic != "" {
+		var err error
+		if topic, err = hex.DecodeString(request.Topic); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid topic %s", request.Topic)
+		}
+	}
+	var indexPrefix []byte
+	switch {
+	case request.Address != "":
+		addr, err := sdk.AccAddressFromBech32(request.Address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s", request.Address)
+		}
+		indexPrefix = types.ReceiptAddressIndexPrefix(addr)
+	case topic != nil:
+		indexPrefix = types.ReceiptTopicIndexPrefix(topic)
+	default:
+		indexPrefix = types.ReceiptHeightIndexKeyPrefix
+	}
+	toHeight := request.ToHeight
+	if toHeight == 0 {
+		toHeight = ctx.BlockHeight()
+	}
+
+	var receipts []types.Receipt
+	receiptStore := prefix.NewStore(ctx.KVStore(q.key), types.ReceiptStoreKeyPrefix)
+	indexStore := prefix.NewStore(ctx.KVStore(q.key), indexPrefix)
+	pageRes, err := query.FilteredPaginate(indexStore, request.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		height, receiptKey := types.SplitReceiptIndexKey(key)
+		if height < request.FromHeight || height > toHeight {
+			return false, nil
+		}
+		var receipt types.Receipt
+		if err := q.cdc.UnmarshalBinaryBare(receiptStore.Get(receiptKey), &receipt); err != nil {
+			return false, err
+		}
+		if topic != nil && !hasTopic(receipt, topic) {
+			return false, nil
+		}
+		if accumulate {
+			receipts = append(receipts, receipt)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryReceiptsResponse{
+		Receipts:   receipts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// hasTopic returns true if a log of the receipt has the topic.
+func hasTopic(receipt types.Receipt, topic []byte) bool {
+	for _, log := range receipt.Logs {
+		for _, t := range log.Topics {
+			if bytes.Equal(t, topic) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 var _ types.QueryServer = Querier{}
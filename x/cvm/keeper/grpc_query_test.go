@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/hyperledger/burrow/binary"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/cvm/keeper"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+func TestContractStorage(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+	querier := keeper.Querier{Keeper: app.CVMKeeper}
+
+	// The contract stores 10 + i at slot i for the slots 3, 1, 2 and 4, in that order.
+	contract := deployRuntime(t, ctx, app.CVMKeeper, addrs[0], bc.MustSplice(PUSH1, 13, PUSH1, 3, SSTORE,
+		PUSH1, 11, PUSH1, 1, SSTORE, PUSH1, 12, PUSH1, 2, SSTORE, PUSH1, 14, PUSH1, 4, SSTORE, STOP))
+	_, err := app.CVMKeeper.Tx(ctx, addrs[1], contract, 0, nil, nil, false, false, false)
+	require.NoError(t, err)
+	// Another contract storing a slot, which the pages of the first contract must not include.
+	deployRuntime(t, ctx, app.CVMKeeper, addrs[1], bc.MustSplice(PUSH1, 1, PUSH1, 1, SSTORE, STOP))
+
+	var storage []types.Storage
+	var key []byte
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 2)
+		res, err := querier.ContractStorage(sdk.WrapSDKContext(ctx), &types.QueryContractStorageRequest{
+			Address:    contract.String(),
+			Pagination: &query.PageRequest{Key: key, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Storage, 2)
+		storage = append(storage, res.Storage...)
+		if key = res.Pagination.NextKey; key == nil {
+			break
+		}
+	}
+	require.Len(t, storage, 4)
+	for i, slot := range storage {
+		require.Equal(t, binary.Int64ToWord256(int64(i+1)), slot.Key)
+		require.Equal(t, binary.Int64ToWord256(int64(i+11)), binary.LeftPadWord256(slot.Value))
+	}
+
+	_, err = querier.ContractStorage(sdk.WrapSDKContext(ctx), &types.QueryContractStorageRequest{Address: "contract"})
+	require.Error(t, err)
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// ExecuteIBCHook credits a contract with the coins a transfer received on behalf of the caller and
+// calls it. The bond denom coins are the value of the call, while the other coins are sent to the
+// contract before the call. The call is a MsgCall of the caller, subject to the oracle score check
+// as the calls of transactions are.
+func (k Keeper) ExecuteIBCHook(ctx sdk.Context, caller sdk.AccAddress, hook types.IBCHook, coins sdk.Coins) (*types.MsgCallResponse, error) {
+	if k.bk.BlockedAddr(hook.Contract) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transfers", hook.Contract)
+	}
+	bondDenom := k.sk.BondDenom(ctx)
+	value := coins.AmountOf(bondDenom)
+	if !value.IsUint64() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s%s exceeds the call value", value, bondDenom)
+	}
+
+	others := coins.Sub(sdk.NewCoins(sdk.NewCoin(bondDenom, value)))
+	if !others.Empty() {
+		if k.ak.GetAccount(ctx, hook.Contract) == nil {
+			return nil, sdkerrors.Wrapf(types.ErrNotContract, "%s", hook.Contract)
+		}
+		if err := k.bk.SubtractCoins(ctx, caller, others); err != nil {
+			return nil, err
+		}
+		if err := k.bk.AddCoins(ctx, hook.Contract, others); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.CheckOracleScore(ctx, hook.Contract, hook.Data); err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgCall(caller.String(), hook.Contract.String(), value.Uint64(), hook.Data)
+	return NewMsgServerImpl(k).Call(sdk.WrapSDKContext(ctx), &msg)
+}
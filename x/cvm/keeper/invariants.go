@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// RegisterInvariants registers all cvm invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "swept-funds", SweptFundsInvariant(k))
+}
+
+// SweptFundsInvariant checks that the swept funds are valid coins. The balance of the zero address is
+// not checked, as coins sent to it within a block are only swept at the end of the block.
+func SweptFundsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		sweptFunds := k.GetSweptFunds(ctx)
+		broken := !sweptFunds.IsValid()
+
+		return sdk.FormatInvariant(types.ModuleName, "swept-funds",
+			fmt.Sprintf("\n\tswept funds: %s\n", sweptFunds)), broken
+	}
+}
@@ -4,7 +4,10 @@ package keeper
 import (
 	"bytes"
 	gobin "encoding/binary"
+	"encoding/hex"
+	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -19,6 +22,7 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/wasm"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
@@ -28,34 +32,40 @@ import (
 	"github.com/certikfoundation/shentu/x/cvm/types"
 )
 
-// TransactionGasLimit is the gas limit of the block.
-const TransactionGasLimit = uint64(5000000)
-
 // Keeper implements SDK Keeper.
 type Keeper struct {
 	cdc        codec.BinaryMarshaler
 	key        sdk.StoreKey
+	tkey       sdk.StoreKey
 	ak         types.AccountKeeper
 	bk         types.BankKeeper
 	dk         types.DistributionKeeper
 	ck         types.CertKeeper
+	ok         types.OracleKeeper
+	shk        types.ShieldKeeper
 	sk         types.StakingKeeper
 	paramSpace types.ParamSubspace
+	router     types.MsgServiceRouter
 }
 
 // NewKeeper creates a new instance of the CVM keeper.
 func NewKeeper(
-	cdc codec.BinaryMarshaler, key sdk.StoreKey, ak types.AccountKeeper, bk types.BankKeeper,
-	dk types.DistributionKeeper, ck types.CertKeeper, sk types.StakingKeeper, paramSpace types.ParamSubspace) Keeper {
+	cdc codec.BinaryMarshaler, key, tkey sdk.StoreKey, ak types.AccountKeeper, bk types.BankKeeper,
+	dk types.DistributionKeeper, ck types.CertKeeper, ok types.OracleKeeper, shk types.ShieldKeeper,
+	sk types.StakingKeeper, paramSpace types.ParamSubspace, router types.MsgServiceRouter) Keeper {
 	return Keeper{
 		cdc:        cdc,
 		key:        key,
+		tkey:       tkey,
 		ak:         ak,
 		bk:         bk,
 		dk:         dk,
 		ck:         ck,
+		ok:         ok,
+		shk:        shk,
 		sk:         sk,
 		paramSpace: paramSpace,
+		router:     router,
 	}
 }
 
@@ -64,11 +74,21 @@ func (k Keeper) Deploy(ctx sdk.Context, msg *types.MsgDeploy) ([]byte, error) {
 	if err != nil {
 		return []byte{}, err
 	}
-	res, err := k.Tx(ctx, callerAddr, nil, msg.Value, msg.Code, msg.Meta, false, msg.IsEWASM, msg.IsRuntime)
+	res, err := k.execute(ctx, callerAddr, nil, msg.Value, msg.Code, msg.Meta, msg.Salt, false, msg.IsEWASM, msg.IsRuntime, nil)
 	if err != nil {
 		return []byte{}, err
 	}
-	return res, nil
+	if msg.Admin != "" {
+		admin, err := sdk.AccAddressFromBech32(msg.Admin)
+		if err != nil {
+			return []byte{}, err
+		}
+		k.SetAdmin(ctx, crypto.MustAddressFromBytes(res.ReturnData), admin)
+	}
+	// The deployer is recorded without charging gas, so that deployments cost as much as before.
+	k.SetDeployer(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), crypto.MustAddressFromBytes(res.ReturnData), callerAddr)
+	k.recordReceipt(ctx, msg.Caller, "", res)
+	return res.ReturnData, nil
 }
 
 func (k Keeper) Call(ctx sdk.Context, msg *types.MsgCall, view bool) ([]byte, error) {
@@ -80,13 +100,103 @@ func (k Keeper) Call(ctx sdk.Context, msg *types.MsgCall, view bool) ([]byte, er
 	if err != nil {
 		return []byte{}, err
 	}
-	res, err := k.Tx(ctx, callerAddr, calleeAddr, msg.Value, msg.Data, []*payload.ContractMeta{}, view, false, false)
-	return res, nil
+	res, err := k.execute(ctx, callerAddr, calleeAddr, msg.Value, msg.Data, []*payload.ContractMeta{}, nil, view, false, false, nil)
+	if err != nil {
+		return nil, callFailure(err, res.ReturnData)
+	}
+	if !view {
+		k.recordReceipt(ctx, msg.Caller, msg.Callee, res)
+	}
+	return res.ReturnData, nil
+}
+
+// TxResult is the result of a CVM execution.
+type TxResult struct {
+	// ReturnData is the output of the execution, or the address of the contract for deployments.
+	ReturnData []byte
+	// GasUsed is the CVM gas charged for the execution after the refund.
+	GasUsed uint64
+	// Refund is the CVM gas refunded.
+	Refund uint64
+	// Logs are the logs emitted by the execution.
+	Logs []types.Log
 }
 
 // Call executes the CVM call from caller to callee with the given data and gas limit.
 func (k Keeper) Tx(ctx sdk.Context, caller, callee sdk.AccAddress, value uint64, data []byte, payloadMeta []*payload.ContractMeta,
 	view, isEWASM, isRuntime bool) ([]byte, error) {
+	res, err := k.execute(ctx, caller, callee, value, data, payloadMeta, nil, view, isEWASM, isRuntime, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.ReturnData, nil
+}
+
+// Simulate runs a CVM transaction on a cached context without committing its state changes.
+// The SDK gas used covers both the CVM gas at the current gas rate and the store accesses.
+// A reverted execution is not an error, its return data and revert reason are reported instead.
+func (k Keeper) Simulate(ctx sdk.Context, caller, callee sdk.AccAddress, value uint64, data []byte,
+	isEWASM, isRuntime bool) (*types.QuerySimulateResponse, error) {
+	if k.ak.GetAccount(ctx, caller) == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", caller)
+	}
+	gasMeter := sdk.NewInfiniteGasMeter()
+	cacheCtx, _ := ctx.WithGasMeter(gasMeter).CacheContext()
+	res, err := k.execute(cacheCtx, caller, callee, value, data, nil, nil, false, isEWASM, isRuntime, nil)
+	reverted := isReverted(err)
+	if err != nil && !reverted {
+		return nil, err
+	}
+
+	var reason string
+	if reverted {
+		reason = types.DecodeRevertReason(res.ReturnData)
+	}
+	return &types.QuerySimulateResponse{
+		GasUsed:      res.GasUsed,
+		SdkGasUsed:   gasMeter.GasConsumed(),
+		Refund:       res.Refund,
+		ReturnData:   res.ReturnData,
+		Reverted:     reverted,
+		RevertReason: reason,
+	}, nil
+}
+
+// TraceCall runs a CVM transaction on a cached context like Simulate and returns the opcode-level
+// trace of the execution in the struct log format of Ethereum debug traces.
+func (k Keeper) TraceCall(ctx sdk.Context, caller, callee sdk.AccAddress, value uint64, data []byte,
+	config vm.StructLoggerConfig) (*vm.ExecutionResult, error) {
+	if k.ak.GetAccount(ctx, caller) == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", caller)
+	}
+	logger := vm.NewStructLogger(config)
+	cacheCtx, _ := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+	res, err := k.execute(cacheCtx, caller, callee, value, data, nil, nil, false, false, false, logger)
+	structLogs := logger.StructLogs()
+	if err != nil && len(structLogs) == 0 {
+		// The execution failed before running any opcode.
+		return nil, err
+	}
+	if structLogs == nil {
+		structLogs = []vm.StructLog{}
+	}
+	return &vm.ExecutionResult{
+		Gas:         res.GasUsed,
+		Failed:      err != nil,
+		ReturnValue: hex.EncodeToString(res.ReturnData),
+		StructLogs:  structLogs,
+	}, nil
+}
+
+// execute runs a CVM transaction. On execution errors, the result still holds the gas charged
+// and the output, which carries the revert reason of reverted executions. Deployments with a salt
+// derive the contract address from it instead of from the caller sequence. The steps of the
+// execution are reported to the tracer if it is not nil.
+func (k Keeper) execute(ctx sdk.Context, caller, callee sdk.AccAddress, value uint64, data []byte, payloadMeta []*payload.ContractMeta,
+	salt []byte, view, isEWASM, isRuntime bool, tracer vm.Tracer) (TxResult, error) {
+	if ctx.Value(dispatchKey{}) != nil {
+		return TxResult{}, types.ErrCodedError(errors.Codes.PermissionDenied)
+	}
 	state := k.NewState(ctx)
 
 	callframe := engine.NewCallFrame(state, acmstate.Named("TxCache"))
@@ -106,9 +216,19 @@ func (k Keeper) Tx(ctx sdk.Context, caller, callee sdk.AccAddress, value uint64,
 	var err error
 	var input []byte
 	if callee == nil {
-		calleeAddr = crypto.NewContractAddress(callerAddr, sequenceBytes)
-		if err = engine.CreateAccount(cache, calleeAddr); err != nil {
-			return nil, types.ErrCodedError(errors.GetCode(err))
+		if len(salt) == 0 {
+			calleeAddr = crypto.NewContractAddress(callerAddr, sequenceBytes)
+		} else {
+			calleeAddr = types.NewSaltedContractAddress(callerAddr, binary.LeftPadWord256(salt), crypto.Keccak256(data))
+			if k.IsDeployAddressTaken(ctx, calleeAddr) {
+				return TxResult{}, sdkerrors.Wrapf(types.ErrAddressCollision, "%s", sdk.AccAddress(calleeAddr.Bytes()))
+			}
+		}
+		// A salted contract may be deployed at an account that was only sent coins.
+		if len(salt) == 0 || k.ak.GetAccount(ctx, calleeAddr.Bytes()) == nil {
+			if err = engine.CreateAccount(cache, calleeAddr); err != nil {
+				return TxResult{}, types.ErrCodedError(errors.GetCode(err))
+			}
 		}
 		code = data
 	} else {
@@ -116,17 +236,20 @@ func (k Keeper) Tx(ctx sdk.Context, caller, callee sdk.AccAddress, value uint64,
 		calleeAddr = crypto.MustAddressFromBytes(callee)
 		calleeAddr, code, isEWASM, err = getCallee(callee, cache)
 		if len(code) == 0 && !bytes.Equal(data, []byte{}) {
-			return nil, types.ErrCodedError(errors.Codes.CodeOutOfBounds)
+			return TxResult{}, types.ErrCodedError(errors.Codes.CodeOutOfBounds)
+		}
+		if k.IsFrozen(ctx, calleeAddr) {
+			return TxResult{}, sdkerrors.Wrapf(types.ErrContractFrozen, "%s", callee)
 		}
 	}
 	if err != nil {
-		return nil, types.ErrCodedError(errors.GetCode(err))
+		return TxResult{}, types.ErrCodedError(errors.GetCode(err))
 	}
 
 	gasRate := k.GetGasRate(ctx)
 	originalGas, err := k.getOriginalGas(ctx, gasRate)
 	if err != nil {
-		return nil, types.ErrCodedError(errors.GetCode(err))
+		return TxResult{}, types.ErrCodedError(errors.GetCode(err))
 	}
 	gasTracker := originalGas
 
@@ -139,14 +262,58 @@ func (k Keeper) Tx(ctx sdk.Context, caller, callee sdk.AccAddress, value uint64,
 		Gas:    big.NewInt(int64(gasTracker)),
 	}
 
+	effects := &vm.NativeEffects{}
 	cc := CertificateCallable{
 		ctx:        ctx,
 		certKeeper: k.ck,
 	}
-	options := registerCVMNative(cc, sequenceBytes)
+	oc := OracleCallable{
+		ctx:          ctx,
+		oracleKeeper: k.ok,
+	}
+	sc := ShieldCallable{
+		ctx:          ctx,
+		shieldKeeper: k.shk,
+	}
+	bkc := BankCallable{
+		ctx:           ctx,
+		accountKeeper: k.ak,
+		bankKeeper:    k.bk,
+		stakingKeeper: k.sk,
+		effects:       effects,
+	}
+	stc := StakingCallable{
+		ctx:                ctx,
+		bankKeeper:         k.bk,
+		distributionKeeper: k.dk,
+		stakingKeeper:      k.sk,
+		effects:            effects,
+	}
+	dc := DispatchCallable{
+		ctx:           ctx,
+		cdc:           k.cdc,
+		router:        k.router,
+		bankKeeper:    k.bk,
+		stakingKeeper: k.sk,
+		state:         state,
+		allowlist:     k.GetDispatchAllowlist(ctx),
+		effects:       effects,
+	}
+	options := registerCVMNative(cc, oc, sc, bkc, stc, dc, sequenceBytes)
 
 	newCVM := vm.NewCVM(options)
+	newCVM.SetGasSchedule(vm.GasSchedule(k.GetGasSchedule(ctx)))
+	forks := k.GetForks(ctx)
+	newCVM.SetRules(forks.Rules(ctx.BlockHeight()))
+	newCVM.SetNativeEffects(effects)
+	newCVM.SetFrozenCheck(func(address crypto.Address) bool {
+		return k.IsFrozen(ctx, address)
+	})
+	if tracer != nil {
+		newCVM.SetTracer(tracer)
+	}
 	bc := NewBlockChain(ctx, k)
+	eventSink := NewEventSink(ctx, k.tkey)
 
 	var ret []byte
 	if isEWASM {
@@ -154,22 +321,35 @@ func (k Keeper) Tx(ctx sdk.Context, caller, callee sdk.AccAddress, value uint64,
 			ret = code
 		} else {
 			wvm := wasm.New(options)
-			ret, err = wvm.Execute(cache, bc, NewEventSink(ctx), callParams, code)
+			ret, err = wvm.Execute(cache, bc, eventSink, callParams, code)
 		}
 	} else {
-		ret, err = newCVM.Execute(cache, bc, NewEventSink(ctx), callParams, code)
+		ret, err = newCVM.Execute(cache, bc, eventSink, callParams, code)
 	}
-	// Refund cannot exceed half of the total gas cost.
-	// Only refund when there is no error.
-	if err != nil {
-		gasTracker = gasTracker + vm.Min((originalGas-gasTracker)/2, newCVM.GetRefund())
+	// Before the gas charge fork, executions are not charged for the CVM gas they use.
+	var refund uint64
+	if forks.IsGasCharged(ctx.BlockHeight()) {
+		// The VM deducts the gas it uses from the call parameters.
+		gasTracker = callParams.Gas.Uint64()
+		// Refund cannot exceed half of the total gas cost.
+		// Only refund when there is no error.
+		if err == nil {
+			refund = vm.Min((originalGas-gasTracker)/2, newCVM.GetRefund())
+			gasTracker = gasTracker + refund
+		}
 	}
 
 	// GasTracker is guaranteed to not underflow during CVM execution.
 	fee := originalGas - gasTracker
 	ctx.GasMeter().ConsumeGas((fee+gasRate-1)/gasRate, "CVM execution fee")
+	res := TxResult{
+		ReturnData: ret,
+		GasUsed:    fee,
+		Refund:     refund,
+		Logs:       eventSink.logs,
+	}
 	if err != nil {
-		return nil, types.ErrCodedError(errors.GetCode(err))
+		return res, types.ErrCodedError(errors.GetCode(err))
 	}
 
 	if callee == nil {
@@ -179,19 +359,19 @@ func (k Keeper) Tx(ctx sdk.Context, caller, callee sdk.AccAddress, value uint64,
 			err = engine.InitEVMCode(cache, calleeAddr, ret)
 		}
 		if err != nil {
-			return nil, types.ErrCodedError(errors.GetCode(err))
+			return res, types.ErrCodedError(errors.GetCode(err))
 		}
 		err = engine.UpdateContractMeta(cache, state, calleeAddr, payloadMeta)
 		if err != nil {
-			return nil, types.ErrCodedError(errors.GetCode(err))
+			return res, types.ErrCodedError(errors.GetCode(err))
 		}
-		ret = calleeAddr.Bytes()
+		res.ReturnData = calleeAddr.Bytes()
 	}
 	if err = cache.Sync(state); err != nil {
-		return nil, types.ErrCodedError(errors.GetCode(err))
+		return res, types.ErrCodedError(errors.GetCode(err))
 	}
 
-	return ret, nil
+	return res, nil
 }
 
 // Send executes the send transaction from caller to callee with the given amount of tokens.
@@ -204,6 +384,17 @@ func (k Keeper) Send(ctx sdk.Context, caller, callee sdk.AccAddress, coins sdk.C
 	return err
 }
 
+// IsDeployAddressTaken returns whether a contract cannot be deployed at an address, because the
+// account at the address has code or has sent transactions. As in EIP-684, an account that was only
+// sent coins does not block a deployment.
+func (k Keeper) IsDeployAddressTaken(ctx sdk.Context, addr crypto.Address) bool {
+	account := k.ak.GetAccount(ctx, addr.Bytes())
+	if account == nil {
+		return false
+	}
+	return account.GetSequence() != 0 || ctx.KVStore(k.key).Has(types.CodeStoreKey(addr))
+}
+
 // GetCode returns the code at the given account address.
 func (k Keeper) GetCode(ctx sdk.Context, addr crypto.Address) ([]byte, error) {
 	state := k.NewState(ctx)
@@ -220,6 +411,27 @@ func (k Keeper) GetCode(ctx sdk.Context, addr crypto.Address) ([]byte, error) {
 	return acc.EVMCode, nil
 }
 
+// isReverted returns true if the error is a wrapped execution revert.
+func isReverted(err error) bool {
+	coded, ok := err.(*sdkerrors.Error)
+	return ok && coded.Codespace() == types.ModuleName &&
+		coded.ABCICode() == types.BurrowErrorCodeStart+errors.Codes.ExecutionReverted.ErrorCode().Number
+}
+
+// callFailure returns the failure of a call for CVM execution errors, which carries the revert data
+// and the revert reason of the output. Other errors are returned as is.
+func callFailure(err error, output []byte) error {
+	coded, ok := err.(*sdkerrors.Error)
+	if !ok || coded.Codespace() != types.ModuleName || coded.ABCICode() < types.BurrowErrorCodeStart {
+		return err
+	}
+	code := errors.Codes.Get(coded.ABCICode() - types.BurrowErrorCodeStart)
+	if code == nil {
+		return err
+	}
+	return types.NewCallFailure(code, output)
+}
+
 // getCallee returns the callee address and bytecode of a given account address.
 func getCallee(callee sdk.AccAddress, cache *acmstate.Cache) (crypto.Address, acm.Bytecode, bool, error) {
 	calleeAddr := crypto.MustAddressFromBytes(callee)
@@ -235,12 +447,17 @@ func getCallee(callee sdk.AccAddress, cache *acmstate.Cache) (crypto.Address, ac
 
 // getOriginalGas returns the original gas cost.
 func (k Keeper) getOriginalGas(ctx sdk.Context, gasRate uint64) (uint64, error) {
+	transactionGasLimit := k.GetTransactionGasLimit(ctx)
+	// Simulations and queries run with an infinite gas meter, which has no limit.
+	if ctx.GasMeter().Limit() == 0 {
+		return transactionGasLimit, nil
+	}
 	gasCurrent := ctx.GasMeter().Limit() - ctx.GasMeter().GasConsumed()
 	originalGas := gasCurrent * gasRate
 	if originalGas < gasCurrent {
 		return 0, types.ErrCodedError(errors.Codes.IntegerOverflow)
 	}
-	originalGas = vm.Min(originalGas, TransactionGasLimit)
+	originalGas = vm.Min(originalGas, transactionGasLimit)
 	return originalGas, nil
 }
 
@@ -265,6 +482,58 @@ func (k Keeper) GetAbi(ctx sdk.Context, address crypto.Address) []byte {
 	return ctx.KVStore(k.key).Get(types.AbiStoreKey(address))
 }
 
+// DecodeLog decodes a log with the ABI stored for the contract that emitted it. Logs of contracts
+// without a valid ABI, or of events the ABI does not declare, are returned undecoded.
+func (k Keeper) DecodeLog(ctx sdk.Context, log types.Log) (types.DecodedLog, error) {
+	decoded := types.DecodedLog{Log: log}
+	addr, err := sdk.AccAddressFromBech32(log.Address)
+	if err != nil {
+		return decoded, err
+	}
+	if len(log.Topics) == 0 {
+		return decoded, nil
+	}
+	spec, err := abi.ReadSpec(k.GetAbi(ctx, crypto.MustAddressFromBytes(addr)))
+	if err != nil {
+		return decoded, nil
+	}
+	var id abi.EventID
+	copy(id[:], log.Topics[0])
+	eventSpec, ok := spec.EventsByID[id]
+	if !ok {
+		return decoded, nil
+	}
+
+	topics := make([]binary.Word256, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = binary.LeftPadWord256(topic)
+	}
+	indexed := 0
+	values := make([]interface{}, len(eventSpec.Inputs))
+	for i, input := range eventSpec.Inputs {
+		if input.Indexed {
+			indexed++
+		}
+		values[i] = new(string)
+	}
+	if len(topics) != indexed+1 {
+		return decoded, fmt.Errorf("log has %d topics, event %s expects %d", len(topics), eventSpec.Name, indexed+1)
+	}
+	if err := abi.UnpackEvent(eventSpec, topics, log.Data, values...); err != nil {
+		return decoded, err
+	}
+
+	decoded.Event = eventSpec.Name
+	for i, input := range eventSpec.Inputs {
+		decoded.Params = append(decoded.Params, types.EventParam{
+			Name:    input.Name,
+			Value:   *values[i].(*string),
+			Indexed: input.Indexed,
+		})
+	}
+	return decoded, nil
+}
+
 // getAddrMeta returns the meta hash of an address.
 func (k Keeper) getAddrMeta(ctx sdk.Context, address crypto.Address) ([]*acm.ContractMeta, error) {
 	state := k.NewState(ctx)
@@ -350,12 +619,21 @@ func (k Keeper) GetAllContracts(ctx sdk.Context) []types.Contract {
 			storage = append(storage, types.Storage{Key: key, Value: storeIterator.Value()})
 		}
 		storeIterator.Close()
+		var admin, deployer string
+		if bz := k.GetAdmin(ctx, address); bz != nil {
+			admin = bz.String()
+		}
+		if bz := k.GetDeployer(ctx, address); bz != nil {
+			deployer = bz.String()
+		}
 		contracts = append(contracts, types.Contract{
-			Address: address,
-			Code:    code,
-			Storage: storage,
-			Abi:     abi,
-			Meta:    meta,
+			Address:  address,
+			Code:     code,
+			Storage:  storage,
+			Abi:      abi,
+			Meta:     meta,
+			Admin:    admin,
+			Deployer: deployer,
 		})
 	}
 	return contracts
@@ -414,3 +692,105 @@ func (k *Keeper) GetGasRate(ctx sdk.Context) uint64 {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyGasRate, &gasRate)
 	return gasRate
 }
+
+// SetReceiptParams sets the receipt parameters in the parameters subspace.
+func (k Keeper) SetReceiptParams(ctx sdk.Context, receiptParams types.ReceiptParams) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyReceiptParams, &receiptParams)
+}
+
+// GetReceiptParams returns the receipt parameters in the parameters subspace. Receipts are disabled
+// on chains that have not set them.
+func (k Keeper) GetReceiptParams(ctx sdk.Context) types.ReceiptParams {
+	var receiptParams types.ReceiptParams
+	k.paramSpace.GetIfExists(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), types.ParamStoreKeyReceiptParams, &receiptParams)
+	return receiptParams
+}
+
+// SetTransactionGasLimit sets the CVM gas limit of a transaction in the parameters subspace.
+func (k Keeper) SetTransactionGasLimit(ctx sdk.Context, limit uint64) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyTransactionGasLimit, &limit)
+}
+
+// GetTransactionGasLimit returns the CVM gas limit of a transaction in the parameters subspace.
+// Chains that have not set it use the default limit. The read is not charged, like the gas schedule.
+func (k Keeper) GetTransactionGasLimit(ctx sdk.Context) uint64 {
+	limit := types.DefaultTransactionGasLimit
+	k.paramSpace.GetIfExists(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), types.ParamStoreKeyTransactionGasLimit, &limit)
+	return limit
+}
+
+// SetGasSchedule sets the gas schedule of the CVM opcodes in the parameters subspace.
+func (k Keeper) SetGasSchedule(ctx sdk.Context, schedule types.GasSchedule) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyGasSchedule, &schedule)
+}
+
+// GetGasSchedule returns the gas schedule of the CVM opcodes in the parameters subspace.
+// Chains that have not set it use the default schedule. The read is not charged, so that CVM
+// executions do not pay for the size of the schedule on top of the gas it meters.
+func (k Keeper) GetGasSchedule(ctx sdk.Context) types.GasSchedule {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if !k.paramSpace.Has(ctx, types.ParamStoreKeyGasSchedule) {
+		return types.DefaultGasSchedule()
+	}
+	// The zero refunds are left out of the stored schedule, so it is not read over the default one.
+	var schedule types.GasSchedule
+	k.paramSpace.Get(ctx, types.ParamStoreKeyGasSchedule, &schedule)
+	return schedule
+}
+
+// SetForks sets the block heights of the CVM upgrades in the parameters subspace.
+func (k Keeper) SetForks(ctx sdk.Context, forks types.Forks) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyForks, &forks)
+}
+
+// GetForks returns the block heights of the CVM upgrades in the parameters subspace. Chains that
+// have not set them have the upgrades disabled, so that their blocks replay identically. The read
+// is not charged, like the gas schedule.
+func (k Keeper) GetForks(ctx sdk.Context) types.Forks {
+	var forks types.Forks
+	k.paramSpace.GetIfExists(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), types.ParamStoreKeyForks, &forks)
+	return forks
+}
+
+// SetCertifierFreezeDuration sets the duration of the freezes of contracts by certifiers in the
+// parameters subspace.
+func (k Keeper) SetCertifierFreezeDuration(ctx sdk.Context, duration time.Duration) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyCertifierFreezeDuration, &duration)
+}
+
+// GetCertifierFreezeDuration returns the duration of the freezes of contracts by certifiers in the
+// parameters subspace. Chains that have not set it use the default duration.
+func (k Keeper) GetCertifierFreezeDuration(ctx sdk.Context) time.Duration {
+	duration := types.DefaultCertifierFreezeDuration
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyCertifierFreezeDuration, &duration)
+	return duration
+}
+
+// SetOracleCheckParams sets the oracle check parameters in the parameters subspace.
+func (k Keeper) SetOracleCheckParams(ctx sdk.Context, oracleCheckParams types.OracleCheckParams) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyOracleCheckParams, &oracleCheckParams)
+}
+
+// GetOracleCheckParams returns the oracle check parameters in the parameters subspace. The check is
+// disabled on chains that have not set them, and the read is not charged, so that their
+// transactions use the same gas.
+func (k Keeper) GetOracleCheckParams(ctx sdk.Context) types.OracleCheckParams {
+	oracleCheckParams := types.OracleCheckParams{ThresholdScore: sdk.ZeroInt()}
+	k.paramSpace.GetIfExists(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), types.ParamStoreKeyOracleCheckParams, &oracleCheckParams)
+	return oracleCheckParams
+}
+
+// SetDispatchAllowlist sets the Msg service methods of the messages contracts can dispatch in the
+// parameters subspace.
+func (k Keeper) SetDispatchAllowlist(ctx sdk.Context, allowlist []string) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyDispatchAllowlist, &allowlist)
+}
+
+// GetDispatchAllowlist returns the Msg service methods of the messages contracts can dispatch in
+// the parameters subspace. Contracts cannot dispatch messages on chains that have not set it, and
+// the read is not charged, like the gas schedule.
+func (k Keeper) GetDispatchAllowlist(ctx sdk.Context) []string {
+	var allowlist []string
+	k.paramSpace.GetIfExists(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), types.ParamStoreKeyDispatchAllowlist, &allowlist)
+	return allowlist
+}
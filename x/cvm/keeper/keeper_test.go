@@ -16,16 +16,26 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm/abi"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
 	"github.com/hyperledger/burrow/txs/payload"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authKeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	"github.com/certikfoundation/shentu/common"
 	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/vm"
 	certtypes "github.com/certikfoundation/shentu/x/cert/types"
 	. "github.com/certikfoundation/shentu/x/cvm/keeper"
 	"github.com/certikfoundation/shentu/x/cvm/types"
+	oracletypes "github.com/certikfoundation/shentu/x/oracle/types"
+	shieldtypes "github.com/certikfoundation/shentu/x/shield/types"
+	"github.com/certikfoundation/shentu/x/staking/teststaking"
 )
 
 var (
@@ -114,6 +124,87 @@ func TestContractCreation(t *testing.T) {
 
 }
 
+func TestSaltedDeploy(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+	msgServer := NewMsgServerImpl(app.CVMKeeper)
+	querier := Querier{Keeper: app.CVMKeeper}
+
+	code, err := hex.DecodeString(Hello55BytecodeString)
+	require.NoError(t, err)
+	salt := binary.Int64ToWord256(1)
+	deployer := crypto.MustAddressFromBytes(addrs[0])
+	// The address is the CREATE2 address of the deployer, the salt and the code.
+	expected := sdk.AccAddress(crypto.NewContractAddress2(deployer, salt, code).Bytes())
+	require.Equal(t, expected, sdk.AccAddress(types.NewSaltedContractAddress(deployer, salt, crypto.Keccak256(code)).Bytes()))
+
+	request := &types.QueryDeployAddressRequest{
+		Deployer: addrs[0].String(),
+		Salt:     hex.EncodeToString(salt.Bytes()),
+		CodeHash: "0x" + types.CodeHash(code),
+	}
+	res, err := querier.DeployAddress(sdk.WrapSDKContext(ctx), request)
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryDeployAddressResponse{Address: expected.String()}, res)
+
+	// Sending coins to the address does not block the deployment, and the contract keeps them.
+	funds := sdk.NewCoins(sdk.NewInt64Coin(app.StakingKeeper.BondDenom(ctx), 1000))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addrs[1], expected, funds))
+	res, err = querier.DeployAddress(sdk.WrapSDKContext(ctx), request)
+	require.NoError(t, err)
+	require.False(t, res.Exists)
+
+	// The address does not depend on the deployer sequence.
+	deploy := types.NewMsgDeploy(addrs[0].String(), 0, code, Hello55AbiJsonString, nil, false, false)
+	deploy.Salt = salt.Bytes()
+	account := app.AccountKeeper.GetAccount(ctx, addrs[0])
+	require.NoError(t, account.SetSequence(account.GetSequence()+5))
+	app.AccountKeeper.SetAccount(ctx, account)
+	deployRes, err := msgServer.Deploy(sdk.WrapSDKContext(ctx), &deploy)
+	require.NoError(t, err)
+	require.Equal(t, expected, sdk.AccAddress(deployRes.Result))
+	deployed, err := app.CVMKeeper.GetCode(ctx, crypto.MustAddressFromBytes(expected))
+	require.NoError(t, err)
+	require.NotEmpty(t, deployed)
+	require.Equal(t, funds, app.BankKeeper.GetAllBalances(ctx, expected))
+
+	res, err = querier.DeployAddress(sdk.WrapSDKContext(ctx), request)
+	require.NoError(t, err)
+	require.True(t, res.Exists)
+	_, err = msgServer.Deploy(sdk.WrapSDKContext(ctx), &deploy)
+	require.ErrorIs(t, err, types.ErrAddressCollision)
+
+	// Accounts that sent transactions block the deployment.
+	used := types.NewSaltedContractAddress(deployer, binary.Int64ToWord256(3), crypto.Keccak256(code))
+	account = app.AccountKeeper.NewAccountWithAddress(ctx, used.Bytes())
+	require.NoError(t, account.SetSequence(1))
+	app.AccountKeeper.SetAccount(ctx, account)
+	deploy.Salt = binary.Int64ToWord256(3).Bytes()
+	_, err = msgServer.Deploy(sdk.WrapSDKContext(ctx), &deploy)
+	require.ErrorIs(t, err, types.ErrAddressCollision)
+	deploy.Salt = salt.Bytes()
+
+	// Other deployers and salts deploy the same code at other addresses.
+	for _, msg := range []types.MsgDeploy{
+		{Caller: addrs[1].String(), Code: code, Salt: salt.Bytes()},
+		{Caller: addrs[0].String(), Code: code, Salt: binary.Int64ToWord256(2).Bytes()},
+	} {
+		deployRes, err := msgServer.Deploy(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+		require.NotEqual(t, expected, sdk.AccAddress(deployRes.Result))
+	}
+
+	_, err = querier.DeployAddress(sdk.WrapSDKContext(ctx), &types.QueryDeployAddressRequest{
+		Deployer: addrs[0].String(),
+		Salt:     "01",
+		CodeHash: request.CodeHash,
+	})
+	require.Error(t, err)
+	deploy.Salt = []byte{1}
+	require.Error(t, deploy.ValidateBasic())
+}
+
 func TestProperExecution(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
@@ -220,6 +311,211 @@ func TestView(t *testing.T) {
 	})
 }
 
+func TestSimulate(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+	code, err := hex.DecodeString(BasicTestsBytecodeString)
+	require.Nil(t, err)
+	gasRate := app.CVMKeeper.GetGasRate(ctx)
+
+	t.Run("simulate a deployment and ensure the contract is NOT created", func(t *testing.T) {
+		res, err := app.CVMKeeper.Simulate(ctx, addrs[0], nil, 0, code, false, false)
+		require.Nil(t, err)
+		require.False(t, res.Reverted)
+		require.NotZero(t, res.GasUsed)
+		require.GreaterOrEqual(t, res.SdkGasUsed, (res.GasUsed+gasRate-1)/gasRate)
+		require.Len(t, res.ReturnData, crypto.AddressLength)
+		contractCode, err := app.CVMKeeper.GetCode(ctx, crypto.MustAddressFromBytes(res.ReturnData))
+		require.Nil(t, err)
+		require.Empty(t, contractCode)
+	})
+
+	result, err := app.CVMKeeper.Tx(ctx, addrs[0], nil, 0, code, []*payload.ContractMeta{}, false, false, false)
+	require.Nil(t, err)
+	newContractAddress := sdk.AccAddress(result)
+
+	t.Run("simulate a call and ensure the gas matches the execution", func(t *testing.T) {
+		setMyFavoriteNumberCall, _, err := abi.EncodeFunctionCall(
+			BasicTestsAbiJsonString,
+			"setMyFavoriteNumber",
+			WrapLogger(ctx.Logger()),
+			777,
+		)
+		require.Nil(t, err)
+		res, err := app.CVMKeeper.Simulate(ctx, addrs[0], newContractAddress, 0, setMyFavoriteNumberCall, false, false)
+		require.Nil(t, err)
+		require.False(t, res.Reverted)
+		storage, err := app.CVMKeeper.GetStorage(ctx, crypto.MustAddressFromBytes(newContractAddress), binary.Int64ToWord256(0))
+		require.Nil(t, err)
+		require.Equal(t, int64(34), new(big.Int).SetBytes(storage).Int64())
+
+		// The simulated SDK gas is enough to run the call.
+		gasCtx := ctx.WithGasMeter(sdk.NewGasMeter(res.SdkGasUsed))
+		_, err = app.CVMKeeper.Tx(gasCtx, addrs[0], newContractAddress, 0, setMyFavoriteNumberCall, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		storage, err = app.CVMKeeper.GetStorage(ctx, crypto.MustAddressFromBytes(newContractAddress), binary.Int64ToWord256(0))
+		require.Nil(t, err)
+		require.Equal(t, int64(777), new(big.Int).SetBytes(storage).Int64())
+	})
+
+	t.Run("simulate a call that reverts and ensure the reason is reported", func(t *testing.T) {
+		failureFunctionCall, _, err := abi.EncodeFunctionCall(
+			BasicTestsAbiJsonString,
+			"failureFunction",
+			WrapLogger(ctx.Logger()),
+		)
+		require.Nil(t, err)
+		res, err := app.CVMKeeper.Simulate(ctx, addrs[0], newContractAddress, 0, failureFunctionCall, false, false)
+		require.Nil(t, err)
+		require.True(t, res.Reverted)
+		require.Equal(t, "Go away!!", res.RevertReason)
+		require.NotZero(t, res.GasUsed)
+	})
+
+	t.Run("simulate a call to a contract with no code", func(t *testing.T) {
+		_, err := app.CVMKeeper.Simulate(ctx, addrs[0], addrs[1], 0, []byte{0x1}, false, false)
+		require.Equal(t, types.ErrCodedError(errors.Codes.CodeOutOfBounds), err)
+	})
+}
+
+func TestCallFailure(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(80000*1e6))
+	msgServer := NewMsgServerImpl(app.CVMKeeper)
+
+	deploy := func(deployer sdk.AccAddress, runtime []byte) sdk.AccAddress {
+		code := bc.MustSplice(PUSH1, len(runtime), PUSH1, 12, PUSH1, 0, CODECOPY, PUSH1, len(runtime), PUSH1, 0, RETURN, runtime)
+		result, err := app.CVMKeeper.Tx(ctx, deployer, nil, 0, code, []*payload.ContractMeta{}, false, false, false)
+		require.NoError(t, err)
+		return result
+	}
+	call := func(callee sdk.AccAddress, data []byte) *types.CallFailure {
+		msg := types.NewMsgCall(addrs[0].String(), callee.String(), 0, data)
+		_, err := msgServer.Call(sdk.WrapSDKContext(ctx), &msg)
+		require.Error(t, err)
+		failure, ok := err.(*types.CallFailure)
+		require.True(t, ok, err)
+
+		// Clients parse the failure from the ABCI error of the transaction, which wraps it.
+		codespace, code, log := sdkerrors.ABCIInfo(sdkerrors.Wrapf(err, "failed to execute message; message index: 0"), false)
+		require.Equal(t, failure, types.ParseCallFailure(codespace, code, log))
+		return failure
+	}
+
+	t.Run("a revert with an error reason fails the call", func(t *testing.T) {
+		code, err := hex.DecodeString(BasicTestsBytecodeString)
+		require.NoError(t, err)
+		result, err := app.CVMKeeper.Tx(ctx, addrs[1], nil, 0, code, []*payload.ContractMeta{}, false, false, false)
+		require.NoError(t, err)
+		failureFunctionCall, _, err := abi.EncodeFunctionCall(BasicTestsAbiJsonString, "failureFunction", WrapLogger(ctx.Logger()))
+		require.NoError(t, err)
+
+		failure := call(result, failureFunctionCall)
+		require.Equal(t, errors.Codes.ExecutionReverted.Number, failure.Code)
+		require.Equal(t, "Go away!!", failure.Reason)
+		require.NotEmpty(t, failure.RevertData)
+
+		codespace, abciCode, log := sdkerrors.ABCIInfo(failure, false)
+		require.Equal(t, types.ModuleName, codespace)
+		require.Equal(t, types.ErrCodedError(errors.Codes.ExecutionReverted).ABCICode(), abciCode)
+		require.Contains(t, log, "Go away!!")
+	})
+
+	t.Run("a revert with a panic code fails the call", func(t *testing.T) {
+		// The contract reverts with Panic(0x11).
+		selector := make([]byte, 32)
+		copy(selector, []byte{0x4e, 0x48, 0x7b, 0x71})
+		contract := deploy(addrs[2], bc.MustSplice(PUSH32, selector, PUSH1, 0, MSTORE, PUSH1, 0x11, PUSH1, 4, MSTORE,
+			PUSH1, 36, PUSH1, 0, REVERT))
+
+		failure := call(contract, nil)
+		require.Equal(t, errors.Codes.ExecutionReverted.Number, failure.Code)
+		require.Equal(t, "panic: 0x11 (arithmetic underflow or overflow)", failure.Reason)
+		require.Len(t, failure.RevertData, 36)
+	})
+
+	t.Run("other execution errors fail the call without revert data", func(t *testing.T) {
+		contract := deploy(addrs[3], bc.MustSplice(INVALID))
+
+		failure := call(contract, nil)
+		require.Equal(t, errors.Codes.ExecutionAborted.Number, failure.Code)
+		require.Empty(t, failure.Reason)
+		require.Empty(t, failure.RevertData)
+	})
+
+	t.Run("revert data without a reason", func(t *testing.T) {
+		require.Empty(t, types.DecodeRevertReason(nil))
+		require.Empty(t, types.DecodeRevertReason([]byte{0x08, 0xc3, 0x79, 0xa0, 0x01}))
+		require.Equal(t, "panic: 0x99", types.DecodeRevertReason(append([]byte{0x4e, 0x48, 0x7b, 0x71}, padOrTrim([]byte{0x99}, 32)...)))
+	})
+}
+
+func TestTraceCall(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+	code, err := hex.DecodeString(BasicTestsBytecodeString)
+	require.Nil(t, err)
+	result, err := app.CVMKeeper.Tx(ctx, addrs[0], nil, 0, code, []*payload.ContractMeta{}, false, false, false)
+	require.Nil(t, err)
+	newContractAddress := sdk.AccAddress(result)
+
+	t.Run("trace a call and ensure the state is NOT changed", func(t *testing.T) {
+		setMyFavoriteNumberCall, _, err := abi.EncodeFunctionCall(
+			BasicTestsAbiJsonString,
+			"setMyFavoriteNumber",
+			WrapLogger(ctx.Logger()),
+			777,
+		)
+		require.Nil(t, err)
+		res, err := app.CVMKeeper.TraceCall(ctx, addrs[0], newContractAddress, 0, setMyFavoriteNumberCall, vm.StructLoggerConfig{})
+		require.Nil(t, err)
+		require.False(t, res.Failed)
+		require.NotZero(t, res.Gas)
+		require.NotEmpty(t, res.StructLogs)
+
+		var stored bool
+		for _, log := range res.StructLogs {
+			if log.Op == "SSTORE" {
+				require.Len(t, log.Storage, 1)
+				stored = true
+			}
+		}
+		require.True(t, stored)
+		storage, err := app.CVMKeeper.GetStorage(ctx, crypto.MustAddressFromBytes(newContractAddress), binary.Int64ToWord256(0))
+		require.Nil(t, err)
+		require.Equal(t, int64(34), new(big.Int).SetBytes(storage).Int64())
+	})
+
+	t.Run("trace a call that reverts", func(t *testing.T) {
+		failureFunctionCall, _, err := abi.EncodeFunctionCall(
+			BasicTestsAbiJsonString,
+			"failureFunction",
+			WrapLogger(ctx.Logger()),
+		)
+		require.Nil(t, err)
+		res, err := app.CVMKeeper.TraceCall(ctx, addrs[0], newContractAddress, 0, failureFunctionCall, vm.StructLoggerConfig{})
+		require.Nil(t, err)
+		require.True(t, res.Failed)
+		last := res.StructLogs[len(res.StructLogs)-1]
+		require.Equal(t, "REVERT", last.Op)
+		require.NotEmpty(t, last.Error)
+		returnData, err := hex.DecodeString(res.ReturnValue)
+		require.Nil(t, err)
+		reason, err := abi.UnpackRevert(returnData)
+		require.Nil(t, err)
+		require.Equal(t, "Go away!!", *reason)
+	})
+
+	t.Run("trace a call from an unknown account", func(t *testing.T) {
+		unknown := sdk.AccAddress([]byte("unknown_____address_"))
+		_, err := app.CVMKeeper.TraceCall(ctx, unknown, newContractAddress, 0, nil, vm.StructLoggerConfig{})
+		require.Error(t, err)
+	})
+}
+
 func TestGasPrice(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
@@ -419,6 +715,114 @@ func TestGasRefund(t *testing.T) {
 
 }
 
+func TestGasParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(80000*1e6))
+	handler := params.NewParamChangeProposalHandler(app.ParamsKeeper)
+	code := bc.MustSplice(PUSH1, 7, PUSH1, 3, MUL, STOP)
+
+	require.Equal(t, uint64(types.DefaultTransactionGasLimit), app.CVMKeeper.GetTransactionGasLimit(ctx))
+	require.Equal(t, types.DefaultGasSchedule(), app.CVMKeeper.GetGasSchedule(ctx))
+
+	res, err := app.CVMKeeper.Simulate(ctx, addrs[0], nil, 0, code, false, true)
+	require.NoError(t, err)
+	gasUsed := res.GasUsed
+
+	t.Run("raise the opcode costs through governance", func(t *testing.T) {
+		schedule := types.DefaultGasSchedule()
+		schedule.VeryLow += 10
+		schedule.Low += 100
+		value, err := app.LegacyAmino().MarshalJSON(schedule)
+		require.NoError(t, err)
+		change := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+			proposal.NewParamChange(types.ModuleName, string(types.ParamStoreKeyGasSchedule), string(value)),
+		})
+		require.NoError(t, handler(ctx, change))
+		require.Equal(t, schedule, app.CVMKeeper.GetGasSchedule(ctx))
+
+		res, err := app.CVMKeeper.Simulate(ctx, addrs[0], nil, 0, code, false, true)
+		require.NoError(t, err)
+		require.Equal(t, gasUsed+120, res.GasUsed)
+	})
+
+	t.Run("reject an invalid gas schedule", func(t *testing.T) {
+		change := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+			proposal.NewParamChange(types.ModuleName, string(types.ParamStoreKeyGasSchedule), `{"quad_coeff_div":"0"}`),
+		})
+		require.Error(t, handler(ctx, change))
+		require.NotZero(t, app.CVMKeeper.GetGasSchedule(ctx).QuadCoeffDiv)
+
+		// The costs of JUMP and JUMPDEST cannot be zero, while refunds can.
+		for _, value := range []string{`{"mid":"0"}`, `{"jumpdest":"0"}`, `{"memory":"0"}`} {
+			change = proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+				proposal.NewParamChange(types.ModuleName, string(types.ParamStoreKeyGasSchedule), value),
+			})
+			require.Error(t, handler(ctx, change), value)
+		}
+		change = proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+			proposal.NewParamChange(types.ModuleName, string(types.ParamStoreKeyGasSchedule), `{"sstore_refund":"0"}`),
+		})
+		require.NoError(t, handler(ctx, change))
+		require.Zero(t, app.CVMKeeper.GetGasSchedule(ctx).SstoreRefund)
+	})
+
+	t.Run("lower the transaction gas limit", func(t *testing.T) {
+		change := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+			proposal.NewParamChange(types.ModuleName, string(types.ParamStoreKeyTransactionGasLimit), `"10"`),
+		})
+		require.NoError(t, handler(ctx, change))
+		require.Equal(t, uint64(10), app.CVMKeeper.GetTransactionGasLimit(ctx))
+
+		_, err := app.CVMKeeper.Tx(ctx, addrs[0], nil, 0, code, []*payload.ContractMeta{}, false, false, true)
+		require.Equal(t, types.ErrCodedError(errors.Codes.InsufficientGas), err)
+	})
+}
+
+func TestForks(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(80000*1e6))
+	code := bc.MustSplice(vm.SELFBALANCE, STOP)
+
+	require.Equal(t, types.DefaultForks(), app.CVMKeeper.GetForks(ctx))
+	app.CVMKeeper.SetForks(ctx, types.Forks{IstanbulHeight: 10, BerlinHeight: 20, GasChargeHeight: 10})
+
+	t.Run("upgrades are disabled before their height", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(9)
+		require.Equal(t, vm.Rules{}, app.CVMKeeper.GetForks(ctx).Rules(ctx.BlockHeight()))
+		_, err := app.CVMKeeper.Simulate(ctx, addrs[0], nil, 0, code, false, true)
+		require.Equal(t, types.ErrCodedError(errors.Codes.Generic), err)
+
+		require.False(t, app.CVMKeeper.GetForks(ctx).IsGasCharged(ctx.BlockHeight()))
+		res, err := app.CVMKeeper.Simulate(ctx, addrs[0], nil, 0, bc.MustSplice(PUSH1, 1, POP, STOP), false, true)
+		require.NoError(t, err)
+		require.Zero(t, res.GasUsed)
+	})
+
+	t.Run("upgrades apply from their height", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(10)
+		require.Equal(t, vm.Rules{IsIstanbul: true}, app.CVMKeeper.GetForks(ctx).Rules(ctx.BlockHeight()))
+		_, err := app.CVMKeeper.Simulate(ctx, addrs[0], nil, 0, code, false, true)
+		require.NoError(t, err)
+
+		require.True(t, app.CVMKeeper.GetForks(ctx).IsGasCharged(ctx.BlockHeight()))
+		res, err := app.CVMKeeper.Simulate(ctx, addrs[0], nil, 0, bc.MustSplice(PUSH1, 1, POP, STOP), false, true)
+		require.NoError(t, err)
+		require.NotZero(t, res.GasUsed)
+
+		ctx = ctx.WithBlockHeight(20)
+		require.Equal(t, vm.Rules{IsIstanbul: true, IsBerlin: true}, app.CVMKeeper.GetForks(ctx).Rules(ctx.BlockHeight()))
+	})
+
+	t.Run("reject berlin before istanbul", func(t *testing.T) {
+		change := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+			proposal.NewParamChange(types.ModuleName, string(types.ParamStoreKeyForks), `{"istanbul_height":"30"}`),
+		})
+		require.Error(t, params.NewParamChangeProposalHandler(app.ParamsKeeper)(ctx, change))
+	})
+}
+
 func TestCTKTransfer(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
@@ -682,3 +1086,297 @@ func TestPrecompiles(t *testing.T) {
 		require.Nil(t, err)
 	})
 }
+
+func TestOraclePrecompile(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+
+	code, err := hex.DecodeString(TestCheckOracleBytecodeString)
+	require.Nil(t, err)
+	result, err := app.CVMKeeper.Tx(ctx, addrs[0], nil, 0, code, []*payload.ContractMeta{}, false, false, false)
+	require.Nil(t, err)
+	newContractAddress := sdk.AccAddress(result)
+
+	task := oracletypes.NewTask("0x1234567890abcdef", "func", ctx.BlockHeight(), sdk.Coins{}, "",
+		time.Now().UTC().Add(time.Hour), addrs[1], 50, 10)
+	task.Result = sdk.NewInt(85)
+	task.Status = oracletypes.TaskStatusSucceeded
+	app.OracleKeeper.SetTask(ctx, task)
+
+	t.Run("read an existing task", func(t *testing.T) {
+		call, _, err := abi.EncodeFunctionCall(TestCheckOracleAbiJsonString, "getScore", WrapLogger(ctx.Logger()),
+			"0x1234567890abcdef", "func")
+		require.Nil(t, err)
+		result, err := app.CVMKeeper.Tx(ctx, addrs[0], newContractAddress, 0, call, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		out, err := abi.DecodeFunctionReturn(TestCheckOracleAbiJsonString, "getScore", result)
+		require.Nil(t, err)
+		require.Equal(t, "85", out[0].Value)
+		require.Equal(t, fmt.Sprint(int(oracletypes.TaskStatusSucceeded)), out[1].Value)
+		require.Equal(t, "50", out[2].Value)
+		require.Equal(t, "true", out[3].Value)
+	})
+
+	t.Run("read a nonexistent task", func(t *testing.T) {
+		call, _, err := abi.EncodeFunctionCall(TestCheckOracleAbiJsonString, "getScore", WrapLogger(ctx.Logger()),
+			"0x1234567890abcdef", "otherFunc")
+		require.Nil(t, err)
+		result, err := app.CVMKeeper.Tx(ctx, addrs[0], newContractAddress, 0, call, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		out, err := abi.DecodeFunctionReturn(TestCheckOracleAbiJsonString, "getScore", result)
+		require.Nil(t, err)
+		require.Equal(t, "0", out[0].Value)
+		require.Equal(t, "false", out[3].Value)
+	})
+}
+
+func TestShieldPrecompile(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(80000*1e6))
+
+	code, err := hex.DecodeString(TestCheckShieldBytecodeString)
+	require.Nil(t, err)
+	result, err := app.CVMKeeper.Tx(ctx, addrs[0], nil, 0, code, []*payload.ContractMeta{}, false, false, false)
+	require.Nil(t, err)
+	newContractAddress := sdk.AccAddress(result)
+
+	app.ShieldKeeper.SetPool(ctx, shieldtypes.NewPool(1, "pool", "sponsor", addrs[2], sdk.NewInt(1e10), sdk.NewInt(3e6)))
+	endTime := now.Add(time.Hour)
+	purchases := []shieldtypes.Purchase{
+		shieldtypes.NewPurchase(1, endTime, endTime.Add(time.Hour), "", sdk.NewInt(1e6), shieldtypes.MixedDecCoins{}),
+		shieldtypes.NewPurchase(2, endTime.Add(-time.Minute), endTime, "", sdk.NewInt(2e6), shieldtypes.MixedDecCoins{}),
+		shieldtypes.NewPurchase(3, now.Add(-time.Minute), now.Add(time.Hour), "", sdk.NewInt(4e6), shieldtypes.MixedDecCoins{}),
+	}
+	app.ShieldKeeper.SetPurchaseList(ctx, shieldtypes.NewPurchaseList(1, addrs[1], purchases))
+
+	getPurchase := func(poolID uint64, purchaser sdk.AccAddress) []*abi.Variable {
+		call, _, err := abi.EncodeFunctionCall(TestCheckShieldAbiJsonString, "getPurchase", WrapLogger(ctx.Logger()),
+			poolID, crypto.MustAddressFromBytes(purchaser))
+		require.Nil(t, err)
+		result, err := app.CVMKeeper.Tx(ctx, addrs[0], newContractAddress, 0, call, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		out, err := abi.DecodeFunctionReturn(TestCheckShieldAbiJsonString, "getPurchase", result)
+		require.Nil(t, err)
+		return out
+	}
+
+	t.Run("read purchases in protection", func(t *testing.T) {
+		out := getPurchase(1, addrs[1])
+		require.Equal(t, "3000000", out[0].Value)
+		require.Equal(t, fmt.Sprint(endTime.Unix()), out[1].Value)
+		require.Equal(t, "true", out[2].Value)
+	})
+
+	t.Run("read a purchaser without purchases", func(t *testing.T) {
+		out := getPurchase(1, addrs[0])
+		require.Equal(t, "0", out[0].Value)
+		require.Equal(t, "0", out[1].Value)
+		require.Equal(t, "true", out[2].Value)
+	})
+
+	t.Run("read an inactive pool", func(t *testing.T) {
+		pool, found := app.ShieldKeeper.GetPool(ctx, 1)
+		require.True(t, found)
+		pool.Active = false
+		app.ShieldKeeper.SetPool(ctx, pool)
+		out := getPurchase(1, addrs[1])
+		require.Equal(t, "3000000", out[0].Value)
+		require.Equal(t, "false", out[2].Value)
+	})
+
+	t.Run("read a nonexistent pool", func(t *testing.T) {
+		out := getPurchase(2, addrs[1])
+		require.Equal(t, "0", out[0].Value)
+		require.Equal(t, "false", out[2].Value)
+	})
+}
+
+func TestBankPrecompiles(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(80000*1e6))
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	code, err := hex.DecodeString(TestCheckBankBalanceBytecodeString)
+	require.Nil(t, err)
+	result, err := app.CVMKeeper.Tx(ctx, addrs[0], nil, 0, code, []*payload.ContractMeta{}, false, false, false)
+	require.Nil(t, err)
+	balanceContract := sdk.AccAddress(result)
+
+	code, err = hex.DecodeString(TestCheckBankTransferBytecodeString)
+	require.Nil(t, err)
+	result, err = app.CVMKeeper.Tx(ctx, addrs[2], nil, 1000, code, []*payload.ContractMeta{}, false, false, false)
+	require.Nil(t, err)
+	transferContract := sdk.AccAddress(result)
+	require.Nil(t, app.BankKeeper.AddCoins(ctx, transferContract, sdk.NewCoins(sdk.NewInt64Coin("uabc", 1000))))
+
+	balanceOf := func(addr sdk.AccAddress, denom string) string {
+		call, _, err := abi.EncodeFunctionCall(TestCheckBankBalanceAbiJsonString, "balanceOf", WrapLogger(ctx.Logger()),
+			crypto.MustAddressFromBytes(addr), denom)
+		require.Nil(t, err)
+		result, err := app.CVMKeeper.Tx(ctx, addrs[0], balanceContract, 0, call, []*payload.ContractMeta{}, false, false, false)
+		require.Nil(t, err)
+		out, err := abi.DecodeFunctionReturn(TestCheckBankBalanceAbiJsonString, "balanceOf", result)
+		require.Nil(t, err)
+		return out[0].Value
+	}
+	transfer := func(to sdk.AccAddress, denom string, amount interface{}) error {
+		call, _, err := abi.EncodeFunctionCall(TestCheckBankTransferAbiJsonString, "transfer", WrapLogger(ctx.Logger()),
+			crypto.MustAddressFromBytes(to), denom, amount)
+		require.Nil(t, err)
+		_, err = app.CVMKeeper.Tx(ctx, addrs[0], transferContract, 0, call, []*payload.ContractMeta{}, false, false, false)
+		return err
+	}
+
+	t.Run("query balances", func(t *testing.T) {
+		require.Equal(t, "1000", balanceOf(transferContract, "uabc"))
+		require.Equal(t, "1000", balanceOf(transferContract, bondDenom))
+		require.Equal(t, "0", balanceOf(addrs[1], "uabc"))
+		require.Equal(t, app.BankKeeper.GetBalance(ctx, addrs[1], bondDenom).Amount.String(), balanceOf(addrs[1], bondDenom))
+	})
+
+	t.Run("transfer a non-native denom", func(t *testing.T) {
+		require.Nil(t, transfer(addrs[1], "uabc", 400))
+		require.Equal(t, int64(600), app.BankKeeper.GetBalance(ctx, transferContract, "uabc").Amount.Int64())
+		require.Equal(t, int64(400), app.BankKeeper.GetBalance(ctx, addrs[1], "uabc").Amount.Int64())
+		require.Equal(t, int64(1000), app.BankKeeper.GetBalance(ctx, transferContract, bondDenom).Amount.Int64())
+		require.Equal(t, "400", balanceOf(addrs[1], "uabc"))
+	})
+
+	t.Run("transfer the bond denom", func(t *testing.T) {
+		before := app.BankKeeper.GetBalance(ctx, addrs[2], bondDenom).Amount
+		require.Nil(t, transfer(addrs[2], bondDenom, 300))
+		require.Equal(t, int64(700), app.BankKeeper.GetBalance(ctx, transferContract, bondDenom).Amount.Int64())
+		require.Equal(t, before.AddRaw(300), app.BankKeeper.GetBalance(ctx, addrs[2], bondDenom).Amount)
+		require.Equal(t, int64(600), app.BankKeeper.GetBalance(ctx, transferContract, "uabc").Amount.Int64())
+	})
+
+	t.Run("transfer more than the balance", func(t *testing.T) {
+		require.NotNil(t, transfer(addrs[1], "uabc", 601))
+		require.NotNil(t, transfer(addrs[1], bondDenom, 701))
+		require.Equal(t, int64(600), app.BankKeeper.GetBalance(ctx, transferContract, "uabc").Amount.Int64())
+		require.Equal(t, int64(700), app.BankKeeper.GetBalance(ctx, transferContract, bondDenom).Amount.Int64())
+	})
+
+	t.Run("transfer an amount overflowing a coin", func(t *testing.T) {
+		amount := new(big.Int).Lsh(big.NewInt(1), 255).String()
+		require.NotPanics(t, func() {
+			require.NotNil(t, transfer(addrs[1], "uabc", amount))
+		})
+		require.Equal(t, int64(600), app.BankKeeper.GetBalance(ctx, transferContract, "uabc").Amount.Int64())
+	})
+
+	t.Run("transfer to a new account", func(t *testing.T) {
+		newAddr := sdk.AccAddress(crypto.Address{0x42}.Bytes())
+		require.Nil(t, transfer(newAddr, "uabc", 100))
+		require.NotNil(t, app.AccountKeeper.GetAccount(ctx, newAddr))
+		require.Equal(t, int64(100), app.BankKeeper.GetBalance(ctx, newAddr, "uabc").Amount.Int64())
+	})
+}
+
+func TestStakingPrecompiles(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(80000*1e6))
+	pks := simapp.CreateTestPubKeys(2)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	val1, val2 := sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])
+	tstaking.CreateValidatorWithValPower(val1, pks[0], 1, true)
+	tstaking.CreateValidatorWithValPower(val2, pks[1], 1, true)
+	tstaking.TurnBlock(ctx)
+
+	code, err := hex.DecodeString(TestCheckStakingBytecodeString)
+	require.Nil(t, err)
+	result, err := app.CVMKeeper.Tx(ctx, addrs[2], nil, 10000, code, []*payload.ContractMeta{}, false, false, false)
+	require.Nil(t, err)
+	contract := sdk.AccAddress(result)
+
+	call := func(function string, args ...interface{}) ([]*abi.Variable, error) {
+		data, _, err := abi.EncodeFunctionCall(TestCheckStakingAbiJsonString, function, WrapLogger(ctx.Logger()), args...)
+		require.Nil(t, err)
+		// Failed calls leave partial staking changes behind, discard them like a failed tx would.
+		cacheCtx, write := ctx.CacheContext()
+		result, err := app.CVMKeeper.Tx(cacheCtx, addrs[2], contract, 0, data, []*payload.ContractMeta{}, false, false, false)
+		if err != nil {
+			return nil, err
+		}
+		write()
+		out, err := abi.DecodeFunctionReturn(TestCheckStakingAbiJsonString, function, result)
+		require.Nil(t, err)
+		return out, nil
+	}
+	delegation := func(val sdk.ValAddress) string {
+		out, err := call("delegation", crypto.MustAddressFromBytes(contract), val.String())
+		require.Nil(t, err)
+		return out[0].Value
+	}
+	balance := func() int64 {
+		return app.BankKeeper.GetBalance(ctx, contract, bondDenom).Amount.Int64()
+	}
+
+	t.Run("delegate", func(t *testing.T) {
+		_, err := call("delegate", val1.String(), 4000)
+		require.Nil(t, err)
+		require.Equal(t, int64(6000), balance())
+		require.Equal(t, "4000", delegation(val1))
+
+		out, err := call("delegation", crypto.MustAddressFromBytes(contract), val2.String())
+		require.Nil(t, err)
+		require.Equal(t, "0", out[0].Value)
+		require.Equal(t, "false", out[1].Value)
+	})
+
+	t.Run("delegate more than the balance", func(t *testing.T) {
+		_, err := call("delegate", val1.String(), 6001)
+		require.NotNil(t, err)
+		require.Equal(t, int64(6000), balance())
+		require.Equal(t, "4000", delegation(val1))
+	})
+
+	t.Run("delegate to an unknown validator", func(t *testing.T) {
+		_, err := call("delegate", sdk.ValAddress(addrs[2]).String(), 1000)
+		require.NotNil(t, err)
+		require.Equal(t, int64(6000), balance())
+	})
+
+	t.Run("undelegate", func(t *testing.T) {
+		out, err := call("undelegate", val1.String(), 1000)
+		require.Nil(t, err)
+		unbondingTime := app.StakingKeeper.UnbondingTime(ctx)
+		require.Equal(t, fmt.Sprint(ctx.BlockTime().Add(unbondingTime).Unix()), out[0].Value)
+		require.Equal(t, "3000", delegation(val1))
+		require.Equal(t, int64(6000), balance())
+
+		_, err = call("undelegate", val1.String(), 3001)
+		require.NotNil(t, err)
+		require.Equal(t, "3000", delegation(val1))
+	})
+
+	t.Run("redelegate", func(t *testing.T) {
+		_, err := call("redelegate", val1.String(), val2.String(), 1000)
+		require.Nil(t, err)
+		require.Equal(t, "2000", delegation(val1))
+		require.Equal(t, "1000", delegation(val2))
+	})
+
+	t.Run("withdraw rewards", func(t *testing.T) {
+		// Delegations do not earn rewards in the block they are created in.
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		rewards := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10*1e6))
+		distrAcc := app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+		require.Nil(t, app.BankKeeper.AddCoins(ctx, distrAcc, rewards))
+		validator, found := app.StakingKeeper.GetValidator(ctx, val1)
+		require.True(t, found)
+		app.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+
+		out, err := call("withdrawRewards", val1.String())
+		require.Nil(t, err)
+		require.NotEqual(t, "0", out[0].Value)
+		require.Equal(t, out[0].Value, fmt.Sprint(balance()-6000))
+	})
+}
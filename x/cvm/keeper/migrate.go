@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/engine"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/txs/payload"
+
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// SetAdmin sets the admin allowed to migrate the code of a contract.
+func (k Keeper) SetAdmin(ctx sdk.Context, address crypto.Address, admin sdk.AccAddress) {
+	ctx.KVStore(k.key).Set(types.AdminStoreKey(address), admin)
+}
+
+// GetAdmin returns the admin of a contract, or nil if the contract has no admin.
+func (k Keeper) GetAdmin(ctx sdk.Context, address crypto.Address) sdk.AccAddress {
+	return ctx.KVStore(k.key).Get(types.AdminStoreKey(address))
+}
+
+// Migrate replaces the code of a contract with new runtime code of the same code type. The storage
+// and the balance of the contract are kept, while its ABI and metadata are replaced by those of the
+// new code and its verified source code is removed. Authorization is left to the callers.
+func (k Keeper) Migrate(ctx sdk.Context, contract sdk.AccAddress, code acm.Bytecode, abi string,
+	meta []*payload.ContractMeta) error {
+	state := k.NewState(ctx)
+	address := crypto.MustAddressFromBytes(contract)
+	account, err := state.GetAccount(address)
+	if err != nil {
+		return err
+	}
+	if account == nil || (len(account.EVMCode) == 0 && len(account.WASMCode) == 0) {
+		return sdkerrors.Wrapf(types.ErrNotContract, "%s", contract)
+	}
+
+	if len(account.WASMCode) > 0 {
+		account.WASMCode = code
+	} else {
+		account.EVMCode = code
+	}
+	account.ContractMeta = nil
+	if err := state.UpdateAccount(account); err != nil {
+		return err
+	}
+	if err := engine.UpdateContractMeta(state, state, address, meta); err != nil {
+		return types.ErrCodedError(errors.GetCode(err))
+	}
+	k.SetAbi(ctx, address, []byte(abi))
+	// The verified source code does not match the new code.
+	k.DeleteVerifiedContract(ctx, address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMigrate,
+			sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
+			sdk.NewAttribute(types.AttributeKeyCodeHash, hex.EncodeToString(crypto.Keccak256(code))),
+		),
+	)
+	return nil
+}
+
+// HandleContractMigrationProposal migrates the code of a contract on behalf of the governance.
+func HandleContractMigrationProposal(ctx sdk.Context, k Keeper, p *types.ContractMigrationProposal) error {
+	contract, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return err
+	}
+	return k.Migrate(ctx, contract, p.Code, p.Abi, p.Meta)
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/hyperledger/burrow/crypto"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/txs/payload"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/cvm/keeper"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+func TestMigrate(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(80000*1e6))
+	msgServer := keeper.NewMsgServerImpl(app.CVMKeeper)
+	querier := keeper.Querier{Keeper: app.CVMKeeper}
+
+	// The first version stores 7 at slot 0, the second one returns the word at slot 0.
+	v1 := bc.MustSplice(PUSH1, 7, PUSH1, 0, SSTORE, STOP)
+	v2 := bc.MustSplice(PUSH1, 0, SLOAD, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN)
+	code := bc.MustSplice(PUSH1, len(v1), PUSH1, 12, PUSH1, 0, CODECOPY, PUSH1, len(v1), PUSH1, 0, RETURN, v1)
+
+	deploy := types.NewMsgDeploy(addrs[0].String(), 0, code, "", nil, false, false)
+	deploy.Admin = addrs[0].String()
+	deployRes, err := msgServer.Deploy(sdk.WrapSDKContext(ctx), &deploy)
+	require.NoError(t, err)
+	contract := sdk.AccAddress(deployRes.Result)
+
+	call := types.NewMsgCall(addrs[1].String(), contract.String(), 0, nil)
+	_, err = msgServer.Call(sdk.WrapSDKContext(ctx), &call)
+	require.NoError(t, err)
+
+	t.Run("query the admin", func(t *testing.T) {
+		res, err := querier.Admin(sdk.WrapSDKContext(ctx), &types.QueryAdminRequest{Address: contract.String()})
+		require.NoError(t, err)
+		require.Equal(t, addrs[0].String(), res.Admin)
+	})
+
+	t.Run("only the admin can migrate", func(t *testing.T) {
+		migrate := types.NewMsgMigrate(addrs[1].String(), contract.String(), v2, "", nil)
+		_, err := msgServer.Migrate(sdk.WrapSDKContext(ctx), &migrate)
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("migrate keeping the storage", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		migrate := types.NewMsgMigrate(addrs[0].String(), contract.String(), v2, "[]", nil)
+		_, err := msgServer.Migrate(sdk.WrapSDKContext(ctx), &migrate)
+		require.NoError(t, err)
+
+		var found bool
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeMigrate {
+				found = true
+			}
+		}
+		require.True(t, found)
+
+		result, err := app.CVMKeeper.Tx(ctx, addrs[1], contract, 0, nil, []*payload.ContractMeta{}, false, false, false)
+		require.NoError(t, err)
+		require.Equal(t, int64(7), new(big.Int).SetBytes(result).Int64())
+		require.Equal(t, []byte("[]"), app.CVMKeeper.GetAbi(ctx, crypto.MustAddressFromBytes(contract)))
+	})
+
+	t.Run("migrate through governance", func(t *testing.T) {
+		proposal := types.NewContractMigrationProposal("title", "description", contract.String(), v1, "", nil)
+		require.NoError(t, proposal.ValidateBasic())
+		require.NoError(t, keeper.HandleContractMigrationProposal(ctx, app.CVMKeeper, proposal))
+
+		res, err := querier.Code(sdk.WrapSDKContext(ctx), &types.QueryCodeRequest{Address: contract.String()})
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(v1), res.Code)
+	})
+
+	t.Run("contracts without an admin cannot be migrated", func(t *testing.T) {
+		deploy := types.NewMsgDeploy(addrs[2].String(), 0, code, "", nil, false, false)
+		deployRes, err := msgServer.Deploy(sdk.WrapSDKContext(ctx), &deploy)
+		require.NoError(t, err)
+
+		migrate := types.NewMsgMigrate(addrs[2].String(), sdk.AccAddress(deployRes.Result).String(), v2, "", nil)
+		_, err = msgServer.Migrate(sdk.WrapSDKContext(ctx), &migrate)
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("only contracts can be migrated", func(t *testing.T) {
+		err := app.CVMKeeper.Migrate(ctx, addrs[1], v2, "", nil)
+		require.ErrorIs(t, err, types.ErrNotContract)
+	})
+
+	t.Run("export the admin", func(t *testing.T) {
+		var admin string
+		for _, c := range app.CVMKeeper.GetAllContracts(ctx) {
+			if c.Address == crypto.MustAddressFromBytes(contract) {
+				admin = c.Admin
+			}
+		}
+		require.Equal(t, addrs[0].String(), admin)
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/certikfoundation/shentu/x/cvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/hyperledger/burrow/crypto"
 )
 
@@ -83,3 +84,252 @@ func (k msgServer) Call(goCtx context.Context, msg *types.MsgCall) (*types.MsgCa
 		Result: result,
 	}, nil
 }
+
+func (k msgServer) Migrate(goCtx context.Context, msg *types.MsgMigrate) (*types.MsgMigrateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+	admin := k.Keeper.GetAdmin(ctx, crypto.MustAddressFromBytes(contract))
+	if admin == nil || !admin.Equals(sender) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s", msg.Contract)
+	}
+	if err := k.Keeper.Migrate(ctx, contract, msg.Code, msg.Abi, msg.Meta); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgMigrateResponse{}, nil
+}
+
+func (k msgServer) VerifyContract(goCtx context.Context, msg *types.MsgVerifyContract) (*types.MsgVerifyContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.VerifyContract(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgVerifyContractResponse{}, nil
+}
+
+func (k msgServer) FreezeContract(goCtx context.Context, msg *types.MsgFreezeContract) (*types.MsgFreezeContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	certifier, err := sdk.AccAddressFromBech32(msg.Certifier)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if !k.ck.IsCertifier(ctx, certifier) {
+		return nil, sdkerrors.Wrapf(types.ErrNotCertifier, "%s", msg.Certifier)
+	}
+	if err := k.Keeper.FreezeContract(ctx, contract, msg.Reason, certifier, 0); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Certifier),
+		),
+	)
+
+	return &types.MsgFreezeContractResponse{}, nil
+}
+
+func (k msgServer) UnfreezeContract(goCtx context.Context, msg *types.MsgUnfreezeContract) (*types.MsgUnfreezeContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	certifier, err := sdk.AccAddressFromBech32(msg.Certifier)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if !k.ck.IsCertifier(ctx, certifier) {
+		return nil, sdkerrors.Wrapf(types.ErrNotCertifier, "%s", msg.Certifier)
+	}
+	if err := k.Keeper.UnfreezeContract(ctx, contract, certifier); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Certifier),
+		),
+	)
+
+	return &types.MsgUnfreezeContractResponse{}, nil
+}
+
+func (k msgServer) SetSponsorship(goCtx context.Context, msg *types.MsgSetSponsorship) (*types.MsgSetSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+	address := crypto.MustAddressFromBytes(contract)
+	if !admin.Equals(k.GetAdmin(ctx, address)) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s", msg.Admin)
+	}
+
+	sponsorship := types.NewSponsorship(msg.Contract, msg.Sponsor, msg.DailyCap, msg.Selectors, msg.Expiry)
+	k.Keeper.SetSponsorship(ctx, address, sponsorship)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetSponsorship,
+			sdk.NewAttribute(types.AttributeKeyContract, sponsorship.Contract),
+			sdk.NewAttribute(types.AttributeKeySponsor, sponsorship.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyDailyCap, sponsorship.DailyCap.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Admin),
+		),
+	})
+
+	return &types.MsgSetSponsorshipResponse{}, nil
+}
+
+func (k msgServer) RevokeSponsorship(goCtx context.Context, msg *types.MsgRevokeSponsorship) (*types.MsgRevokeSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+	address := crypto.MustAddressFromBytes(contract)
+	sponsorship, ok := k.GetSponsorship(ctx, address)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotSponsored, "%s", msg.Contract)
+	}
+	if sender.String() != sponsorship.Sponsor && !sender.Equals(k.GetAdmin(ctx, address)) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the admin of the contract nor the sponsor", msg.Sender)
+	}
+	k.DeleteSponsorship(ctx, address)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeSponsorship,
+			sdk.NewAttribute(types.AttributeKeyContract, sponsorship.Contract),
+			sdk.NewAttribute(types.AttributeKeySponsor, sponsorship.Sponsor),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgRevokeSponsorshipResponse{}, nil
+}
+
+func (k msgServer) ScheduleCall(goCtx context.Context, msg *types.MsgScheduleCall) (*types.MsgScheduleCallResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := k.Keeper.ScheduleCall(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeScheduleCall,
+			sdk.NewAttribute(types.AttributeKeyScheduledCallID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Callee),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Caller),
+		),
+	})
+
+	return &types.MsgScheduleCallResponse{Id: id}, nil
+}
+
+func (k msgServer) CancelScheduledCall(goCtx context.Context, msg *types.MsgCancelScheduledCall) (*types.MsgCancelScheduledCallResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	caller, err := sdk.AccAddressFromBech32(msg.Caller)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.CancelScheduledCall(ctx, caller, msg.Id); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Caller),
+		),
+	)
+
+	return &types.MsgCancelScheduledCallResponse{}, nil
+}
+
+func (k msgServer) MultiCall(goCtx context.Context, msg *types.MsgMultiCall) (*types.MsgMultiCallResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	results, err := k.Keeper.MultiCall(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Caller),
+		),
+	)
+	for i, result := range results {
+		if result.Failure != nil {
+			continue
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCall,
+				sdk.NewAttribute(types.AttributeKeyRecipient, msg.Calls[i].Callee),
+				sdk.NewAttribute(types.AttributeKeyValue, strconv.FormatUint(msg.Calls[i].Value, 10)),
+			),
+		)
+	}
+
+	return &types.MsgMultiCallResponse{
+		Results: results,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// MultiCall runs the calls of a multi-call message in order and returns their results. The calls
+// share the gas meter of the context, and each call runs on a cached context whose state changes
+// are only written if the call succeeds. A failed call of an atomic message fails the message with
+// its CallFailure, wrapped with the index of the call, and no results, as the failure fails the
+// transaction. Errors that are not failures of the CVM execution, like calls into frozen contracts,
+// fail the message in both modes.
+func (k Keeper) MultiCall(ctx sdk.Context, msg *types.MsgMultiCall) ([]types.MultiCallResult, error) {
+	caller, err := sdk.AccAddressFromBech32(msg.Caller)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]types.MultiCallResult, 0, len(msg.Calls))
+	receipts := make([]types.CallReceipt, 0, len(msg.Calls))
+	var logs []types.Log
+	var gasUsed uint64
+	for i, call := range msg.Calls {
+		callee, err := sdk.AccAddressFromBech32(call.Callee)
+		if err != nil {
+			return nil, err
+		}
+		callCtx, write := ctx.CacheContext()
+		res, err := k.execute(callCtx, caller, callee, call.Value, call.Data, nil, nil, false, false, false, nil)
+		gasUsed += res.GasUsed
+		receipt := types.CallReceipt{
+			Callee:     call.Callee,
+			Status:     types.ReceiptStatusSuccessful,
+			ReturnData: res.ReturnData,
+			GasUsed:    res.GasUsed,
+		}
+		if err != nil {
+			failure, ok := callFailure(err, res.ReturnData).(*types.CallFailure)
+			if !ok {
+				return nil, err
+			}
+			if msg.Atomic {
+				return nil, sdkerrors.Wrapf(failure, "call %d", i)
+			}
+			receipt.Status = types.ReceiptStatusFailed
+			receipts = append(receipts, receipt)
+			results = append(results, types.MultiCallResult{Failure: failure})
+			continue
+		}
+
+		write()
+		ctx.EventManager().EmitEvents(callCtx.EventManager().Events())
+		logs = append(logs, res.Logs...)
+		receipts = append(receipts, receipt)
+		results = append(results, types.MultiCallResult{Result: res.ReturnData})
+	}
+
+	k.recordMultiCallReceipt(ctx, msg.Caller, receipts, logs, gasUsed)
+	return results, nil
+}
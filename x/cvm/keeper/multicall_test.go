@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/cvm/keeper"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+func TestMultiCall(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+	caller := addrs[0]
+	msgServer := keeper.NewMsgServerImpl(app.CVMKeeper)
+
+	// The counter increments its first storage slot and returns the new count, and the other contract
+	// always reverts.
+	counter := deployRuntime(t, ctx, app.CVMKeeper, addrs[0], bc.MustSplice(PUSH1, 0, SLOAD, PUSH1, 1, ADD,
+		DUP1, PUSH1, 0, SSTORE, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN))
+	reverter := deployRuntime(t, ctx, app.CVMKeeper, addrs[1], bc.MustSplice(PUSH1, 0, DUP1, REVERT))
+	count := func(ctx sdk.Context) int64 {
+		value, err := app.CVMKeeper.GetStorage(ctx, crypto.MustAddressFromBytes(counter), binary.Int64ToWord256(0))
+		require.NoError(t, err)
+		return binary.Int64FromWord256(binary.LeftPadWord256(value))
+	}
+	calls := []types.MultiCallEntry{
+		{Callee: counter.String()},
+		{Callee: reverter.String()},
+		{Callee: counter.String()},
+	}
+
+	t.Run("non-atomic calls continue after a failure", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		msg := types.NewMsgMultiCall(caller.String(), calls, false)
+		txBytes := encodeTx(t, &msg)
+		ctx = ctx.WithTxBytes(txBytes).WithGasMeter(sdk.NewGasMeter(10000000))
+		res, err := msgServer.MultiCall(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), count(ctx))
+		require.Len(t, res.Results, 3)
+		require.Equal(t, binary.Int64ToWord256(1).Bytes(), res.Results[0].Result)
+		require.Nil(t, res.Results[0].Failure)
+		require.NotNil(t, res.Results[1].Failure)
+		require.Equal(t, binary.Int64ToWord256(2).Bytes(), res.Results[2].Result)
+
+		receipt, ok := app.CVMKeeper.GetReceipt(ctx, tmhash.Sum(txBytes), 0)
+		require.True(t, ok)
+		require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+		require.Empty(t, receipt.Callee)
+		require.Len(t, receipt.Calls, 3)
+		require.Equal(t, types.ReceiptStatusFailed, receipt.Calls[1].Status)
+		// The calls share the gas meter of the message.
+		require.Equal(t, receipt.Calls[0].GasUsed+receipt.Calls[1].GasUsed+receipt.Calls[2].GasUsed, receipt.GasUsed)
+		require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), receipt.GasUsed)
+	})
+
+	t.Run("atomic calls stop and fail at the first failure", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		msg := types.NewMsgMultiCall(caller.String(), calls, true)
+		txBytes := encodeTx(t, &msg)
+		ctx = ctx.WithTxBytes(txBytes)
+		// Only the failure is returned, since it fails the transaction along with its response.
+		res, err := msgServer.MultiCall(sdk.WrapSDKContext(ctx), &msg)
+		require.Nil(t, res)
+		var failure *types.CallFailure
+		require.ErrorAs(t, err, &failure)
+		require.Equal(t, errors.Codes.ExecutionReverted.Number, failure.Code)
+		require.Contains(t, err.Error(), "call 1")
+		codespace, code, log := sdkerrors.ABCIInfo(err, false)
+		require.Equal(t, failure, types.ParseCallFailure(codespace, code, log))
+
+		require.Equal(t, int64(1), count(ctx))
+		_, found := app.CVMKeeper.GetReceipt(ctx, tmhash.Sum(txBytes), 0)
+		require.False(t, found)
+	})
+
+	t.Run("calls into frozen contracts fail the message in both modes", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		app.CVMKeeper.SetFrozenContract(ctx, crypto.MustAddressFromBytes(reverter), types.FrozenContract{Address: reverter.String()})
+		for _, atomic := range []bool{false, true} {
+			msg := types.NewMsgMultiCall(caller.String(), calls, atomic)
+			_, err := msgServer.MultiCall(sdk.WrapSDKContext(ctx), &msg)
+			require.ErrorIs(t, err, types.ErrContractFrozen)
+		}
+	})
+}
+
+func TestMsgMultiCallValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(crypto.Address{1}.Bytes()).String()
+	tests := []struct {
+		name     string
+		msg      types.MsgMultiCall
+		expected bool
+	}{
+		{"valid", types.NewMsgMultiCall(addr, []types.MultiCallEntry{{Callee: addr}, {Callee: addr, Value: 1}}, false), true},
+		{"invalid caller", types.NewMsgMultiCall("", []types.MultiCallEntry{{Callee: addr}}, false), false},
+		{"no calls", types.NewMsgMultiCall(addr, nil, true), false},
+		{"invalid callee", types.NewMsgMultiCall(addr, []types.MultiCallEntry{{Callee: addr}, {Callee: ""}}, true), false},
+	}
+	for _, tc := range tests {
+		err := tc.msg.ValidateBasic()
+		require.Equal(t, tc.expected, err == nil, tc.name)
+	}
+}
@@ -2,16 +2,23 @@ package keeper
 
 import (
 	"math/big"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/engine"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/native"
 	"github.com/hyperledger/burrow/permission"
 
+	"github.com/certikfoundation/shentu/vm"
 	"github.com/certikfoundation/shentu/x/cvm/types"
 )
 
@@ -20,19 +27,145 @@ type CertificateCallable struct {
 	certKeeper types.CertKeeper
 }
 
+type OracleCallable struct {
+	ctx          sdk.Context
+	oracleKeeper types.OracleKeeper
+}
+
+type ShieldCallable struct {
+	ctx          sdk.Context
+	shieldKeeper types.ShieldKeeper
+}
+
+type BankCallable struct {
+	ctx           sdk.Context
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+	effects       *vm.NativeEffects
+}
+
+type StakingCallable struct {
+	ctx                sdk.Context
+	bankKeeper         types.BankKeeper
+	distributionKeeper types.DistributionKeeper
+	stakingKeeper      types.StakingKeeper
+	effects            *vm.NativeEffects
+}
+
+// OracleScoreArgs is the ABI-encoded input of the oracle score precompile.
+type OracleScoreArgs struct {
+	Contract string
+	Function string
+}
+
+// OracleScoreRets is the ABI-encoded output of the oracle score precompile.
+type OracleScoreRets struct {
+	Score        int64
+	Status       uint64
+	ClosingBlock int64
+	Exists       bool
+}
+
+// ShieldPurchaseArgs is the ABI-encoded input of the shield purchase precompile.
+type ShieldPurchaseArgs struct {
+	PoolID    uint64
+	Purchaser crypto.Address
+}
+
+// ShieldPurchaseRets is the ABI-encoded output of the shield purchase precompile.
+type ShieldPurchaseRets struct {
+	Shield            uint64
+	ProtectionEndTime int64
+	Active            bool
+}
+
+// BankTransferArgs is the ABI-encoded input of the bank transfer precompile.
+type BankTransferArgs struct {
+	To     crypto.Address
+	Denom  string
+	Amount big.Int
+}
+
+// BankTransferRets is the ABI-encoded output of the bank transfer precompile.
+type BankTransferRets struct{}
+
+// StakingArgs is the ABI-encoded input of the delegate and undelegate precompiles.
+type StakingArgs struct {
+	Validator string
+	Amount    uint64
+}
+
+// StakingDelegateRets is the ABI-encoded output of the delegate precompile.
+type StakingDelegateRets struct{}
+
+// StakingUnbondRets is the ABI-encoded output of the undelegate and redelegate precompiles.
+type StakingUnbondRets struct {
+	CompletionTime int64
+}
+
+// StakingRedelegateArgs is the ABI-encoded input of the redelegate precompile.
+type StakingRedelegateArgs struct {
+	SrcValidator string
+	DstValidator string
+	Amount       uint64
+}
+
+// StakingWithdrawRewardsArgs is the ABI-encoded input of the withdraw rewards precompile.
+type StakingWithdrawRewardsArgs struct {
+	Validator string
+}
+
+// StakingWithdrawRewardsRets is the ABI-encoded output of the withdraw rewards precompile.
+type StakingWithdrawRewardsRets struct {
+	Amount uint64
+}
+
+// StakingDelegationArgs is the ABI-encoded input of the delegation query precompile.
+type StakingDelegationArgs struct {
+	Delegator crypto.Address
+	Validator string
+}
+
+// StakingDelegationRets is the ABI-encoded output of the delegation query precompile.
+type StakingDelegationRets struct {
+	Balance uint64
+	Exists  bool
+}
+
+// bankBalanceInputs is the ABI spec of the bank balance precompile input,
+// which is (address account, string denom).
+var bankBalanceInputs = []abi.Argument{{EVM: abi.EVMAddress{}}, {EVM: abi.EVMString{}}}
+
 const (
 	// TODO: consolidate native contract gas consumption
 	GasBase int64 = 1000
 )
 
 // registerCVMNative registers precompile contracts in CVM.
-func registerCVMNative(cc CertificateCallable, nonce []byte) engine.Options {
+func registerCVMNative(cc CertificateCallable, oc OracleCallable, sc ShieldCallable, bc BankCallable,
+	stc StakingCallable, dc DispatchCallable, nonce []byte) engine.Options {
+	natives := native.MustDefaultNatives().
+		MustFunction("General", leftPadAddress(101), permission.None, cc.checkGeneral).
+		MustFunction("Proof", leftPadAddress(102), permission.None, cc.checkProof).
+		MustFunction("Compilation", leftPadAddress(103), permission.None, cc.checkCompilation).
+		MustFunction("OracleScore", leftPadAddress(104), permission.None, oc.getOracleScore).
+		MustFunction("ShieldPurchase", leftPadAddress(105), permission.None, sc.getShieldPurchase).
+		MustFunction("BankBalance", leftPadAddress(106), permission.None, bc.balanceOf).
+		MustFunction("BankTransfer", leftPadAddress(107), permission.None, bc.transfer).
+		MustFunction("StakingDelegate", leftPadAddress(108), permission.None, stc.delegate).
+		MustFunction("StakingUndelegate", leftPadAddress(109), permission.None, stc.undelegate).
+		MustFunction("StakingRedelegate", leftPadAddress(110), permission.None, stc.redelegate).
+		MustFunction("StakingWithdrawRewards", leftPadAddress(111), permission.None, stc.withdrawRewards).
+		MustFunction("StakingDelegation", leftPadAddress(112), permission.None, stc.delegation)
+	// The dispatcher is only registered on chains that allow messages, so that the calls to its
+	// address are unchanged on the other chains.
+	if len(dc.allowlist) > 0 {
+		natives = natives.MustFunction("Dispatch", leftPadAddress(113), permission.None, dc.dispatch)
+	}
 	return engine.Options{
-		Natives: native.MustDefaultNatives().
-			MustFunction("General", leftPadAddress(101), permission.None, cc.checkGeneral).
-			MustFunction("Proof", leftPadAddress(102), permission.None, cc.checkProof).
-			MustFunction("Compilation", leftPadAddress(103), permission.None, cc.checkCompilation),
-		Nonce: nonce,
+		Natives: natives,
+		Nonce:   nonce,
 	}
 }
 
@@ -84,6 +217,410 @@ func (cc CertificateCallable) checkCompilation(ctx native.Context) (output []byt
 	return []byte{0x00}, nil
 }
 
+// getOracleScore returns the latest aggregated result, status and closing block
+// of the oracle task for a given contract and function.
+func (oc OracleCallable) getOracleScore(ctx native.Context, args OracleScoreArgs) (OracleScoreRets, error) {
+	gasRequired := big.NewInt(GasBase)
+	if ctx.Gas.Cmp(gasRequired) == -1 {
+		return OracleScoreRets{}, errors.Codes.InsufficientGas
+	} else {
+		*ctx.Gas = *ctx.Gas.Sub(ctx.Gas, gasRequired)
+	}
+	task, err := oc.oracleKeeper.GetTask(oc.ctx, args.Contract, args.Function)
+	if err != nil {
+		return OracleScoreRets{}, nil
+	}
+	rets := OracleScoreRets{
+		Status:       uint64(task.Status),
+		ClosingBlock: task.ClosingBlock,
+		Exists:       true,
+	}
+	if !task.Result.IsNil() {
+		if !task.Result.IsInt64() {
+			return OracleScoreRets{}, errors.Codes.IntegerOverflow
+		}
+		rets.Score = task.Result.Int64()
+	}
+	return rets, nil
+}
+
+// getShieldPurchase returns the shield still in protection, the latest protection
+// end time of a purchaser in a given pool and whether the pool is active.
+func (sc ShieldCallable) getShieldPurchase(ctx native.Context, args ShieldPurchaseArgs) (ShieldPurchaseRets, error) {
+	gasRequired := big.NewInt(GasBase)
+	if ctx.Gas.Cmp(gasRequired) == -1 {
+		return ShieldPurchaseRets{}, errors.Codes.InsufficientGas
+	} else {
+		*ctx.Gas = *ctx.Gas.Sub(ctx.Gas, gasRequired)
+	}
+	var rets ShieldPurchaseRets
+	pool, found := sc.shieldKeeper.GetPool(sc.ctx, args.PoolID)
+	if !found {
+		return rets, nil
+	}
+	rets.Active = pool.Active
+
+	purchaseList, found := sc.shieldKeeper.GetPurchaseList(sc.ctx, args.PoolID, args.Purchaser.Bytes())
+	if !found {
+		return rets, nil
+	}
+	shield := sdk.ZeroInt()
+	for _, entry := range purchaseList.Entries {
+		if !entry.ProtectionEndTime.After(sc.ctx.BlockTime()) {
+			continue
+		}
+		shield = shield.Add(entry.Shield)
+		if entry.ProtectionEndTime.Unix() > rets.ProtectionEndTime {
+			rets.ProtectionEndTime = entry.ProtectionEndTime.Unix()
+		}
+	}
+	if !shield.IsUint64() {
+		return ShieldPurchaseRets{}, errors.Codes.IntegerOverflow
+	}
+	rets.Shield = shield.Uint64()
+	return rets, nil
+}
+
+// balanceOf returns the balance of an account in a given denom as a uint256.
+// The bond denom balance is read through the CVM state so that it reflects
+// transfers made earlier in the same execution.
+func (bc BankCallable) balanceOf(ctx native.Context) ([]byte, error) {
+	gasRequired := big.NewInt(GasBase)
+	if ctx.Gas.Cmp(gasRequired) == -1 {
+		return nil, errors.Codes.InsufficientGas
+	} else {
+		*ctx.Gas = *ctx.Gas.Sub(ctx.Gas, gasRequired)
+	}
+	var account crypto.Address
+	var denom string
+	if err := abi.Unpack(bankBalanceInputs, ctx.Input, &account, &denom); err != nil {
+		return nil, err
+	}
+
+	balance := sdk.ZeroInt()
+	if denom == bc.stakingKeeper.BondDenom(bc.ctx) {
+		acc, err := ctx.State.CallFrame.GetAccount(account)
+		if err != nil {
+			return nil, err
+		}
+		if acc != nil {
+			balance = sdk.NewIntFromUint64(acc.Balance)
+		}
+	} else {
+		balance = bc.bankKeeper.GetBalance(bc.ctx, account.Bytes(), denom).Amount
+	}
+	return binary.LeftPadWord256(balance.BigInt().Bytes()).Bytes(), nil
+}
+
+// transfer sends coins of a given denom from the calling contract to an account.
+// Bond denom transfers go through the CVM state like value transfers do. Other
+// denoms are moved in the bank module directly, hence they are only reverted
+// together with the whole execution.
+func (bc BankCallable) transfer(ctx native.Context, args BankTransferArgs) (BankTransferRets, error) {
+	gasRequired := big.NewInt(GasBase)
+	if ctx.Gas.Cmp(gasRequired) == -1 {
+		return BankTransferRets{}, errors.Codes.InsufficientGas
+	} else {
+		*ctx.Gas = *ctx.Gas.Sub(ctx.Gas, gasRequired)
+	}
+	// Only a direct call can spend the caller's coins, otherwise a contract could
+	// move the funds of its own caller through DELEGATECALL.
+	if ctx.CallType != exec.CallTypeCall {
+		return BankTransferRets{}, errors.Codes.PermissionDenied
+	}
+	if args.Amount.Sign() < 0 {
+		return BankTransferRets{}, errors.Wrap(errors.Codes.NativeFunction, "negative transfer amount")
+	}
+	// Coin amounts are limited to 255 bits.
+	if args.Amount.BitLen() > 255 {
+		return BankTransferRets{}, errors.Codes.IntegerOverflow
+	}
+	if err := sdk.ValidateDenom(args.Denom); err != nil {
+		return BankTransferRets{}, errors.Wrap(errors.Codes.InvalidString, err.Error())
+	}
+	to := sdk.AccAddress(args.To.Bytes())
+	if bc.bankKeeper.BlockedAddr(to) {
+		return BankTransferRets{}, errors.Codes.PermissionDenied
+	}
+
+	if args.Denom == bc.stakingKeeper.BondDenom(bc.ctx) {
+		acc, err := ctx.State.CallFrame.GetAccount(args.To)
+		if err != nil {
+			return BankTransferRets{}, err
+		}
+		if acc == nil {
+			if err := ctx.State.CallFrame.CreateAccount(ctx.Caller, args.To); err != nil {
+				return BankTransferRets{}, err
+			}
+		}
+		return BankTransferRets{}, engine.Transfer(ctx.State.CallFrame, ctx.Caller, args.To, &args.Amount)
+	}
+
+	// Touch the caller account so that transfers are rejected in a read-only call frame.
+	err := engine.UpdateAccount(ctx.State.CallFrame, ctx.Caller, func(*acm.Account) error { return nil })
+	if err != nil {
+		return BankTransferRets{}, err
+	}
+	from := sdk.AccAddress(ctx.Caller.Bytes())
+	coins := sdk.NewCoins(sdk.NewCoin(args.Denom, sdk.NewIntFromBigInt(&args.Amount)))
+	bc.effects.Record()
+	if err := bc.bankKeeper.SubtractCoins(bc.ctx, from, coins); err != nil {
+		return BankTransferRets{}, errors.Wrap(errors.Codes.InsufficientBalance, err.Error())
+	}
+	if bc.accountKeeper.GetAccount(bc.ctx, to) == nil {
+		bc.accountKeeper.SetAccount(bc.ctx, bc.accountKeeper.NewAccountWithAddress(bc.ctx, to))
+	}
+	if err := bc.bankKeeper.AddCoins(bc.ctx, to, coins); err != nil {
+		return BankTransferRets{}, err
+	}
+	bc.ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			banktypes.EventTypeTransfer,
+			sdk.NewAttribute(banktypes.AttributeKeyRecipient, to.String()),
+			sdk.NewAttribute(banktypes.AttributeKeySender, from.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	)
+	return BankTransferRets{}, nil
+}
+
+// delegate delegates coins of the calling contract to a validator.
+func (stc StakingCallable) delegate(ctx native.Context, args StakingArgs) (StakingDelegateRets, error) {
+	gasRequired := big.NewInt(GasBase)
+	if ctx.Gas.Cmp(gasRequired) == -1 {
+		return StakingDelegateRets{}, errors.Codes.InsufficientGas
+	} else {
+		*ctx.Gas = *ctx.Gas.Sub(ctx.Gas, gasRequired)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(args.Validator)
+	if err != nil {
+		return StakingDelegateRets{}, errors.Wrap(errors.Codes.InvalidAddress, err.Error())
+	}
+	validator, found := stc.stakingKeeper.GetValidator(stc.ctx, valAddr)
+	if !found {
+		return StakingDelegateRets{}, errors.Wrap(errors.Codes.NativeFunction, stakingtypes.ErrNoValidatorFound.Error())
+	}
+	if args.Amount == 0 {
+		return StakingDelegateRets{}, errors.Wrap(errors.Codes.NativeFunction, "delegation amount must be positive")
+	}
+	amount := sdk.NewIntFromUint64(args.Amount)
+
+	err = stc.execute(ctx, func(delegator sdk.AccAddress) error {
+		_, err := stc.stakingKeeper.Delegate(stc.ctx, delegator, amount, stakingtypes.Unbonded, validator, true)
+		return err
+	})
+	if err != nil {
+		return StakingDelegateRets{}, err
+	}
+	stc.ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			stakingtypes.EventTypeDelegate,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, args.Validator),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return StakingDelegateRets{}, nil
+}
+
+// undelegate undelegates coins of the calling contract from a validator.
+func (stc StakingCallable) undelegate(ctx native.Context, args StakingArgs) (StakingUnbondRets, error) {
+	gasRequired := big.NewInt(GasBase)
+	if ctx.Gas.Cmp(gasRequired) == -1 {
+		return StakingUnbondRets{}, errors.Codes.InsufficientGas
+	} else {
+		*ctx.Gas = *ctx.Gas.Sub(ctx.Gas, gasRequired)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(args.Validator)
+	if err != nil {
+		return StakingUnbondRets{}, errors.Wrap(errors.Codes.InvalidAddress, err.Error())
+	}
+	if args.Amount == 0 {
+		return StakingUnbondRets{}, errors.Wrap(errors.Codes.NativeFunction, "undelegation amount must be positive")
+	}
+	amount := sdk.NewIntFromUint64(args.Amount)
+
+	var completionTime time.Time
+	err = stc.execute(ctx, func(delegator sdk.AccAddress) error {
+		shares, err := stc.stakingKeeper.ValidateUnbondAmount(stc.ctx, delegator, valAddr, amount)
+		if err != nil {
+			return err
+		}
+		completionTime, err = stc.stakingKeeper.Undelegate(stc.ctx, delegator, valAddr, shares)
+		return err
+	})
+	if err != nil {
+		return StakingUnbondRets{}, err
+	}
+	stc.ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			stakingtypes.EventTypeUnbond,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, args.Validator),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+	return StakingUnbondRets{CompletionTime: completionTime.Unix()}, nil
+}
+
+// redelegate moves a delegation of the calling contract from one validator to another.
+func (stc StakingCallable) redelegate(ctx native.Context, args StakingRedelegateArgs) (StakingUnbondRets, error) {
+	gasRequired := big.NewInt(GasBase)
+	if ctx.Gas.Cmp(gasRequired) == -1 {
+		return StakingUnbondRets{}, errors.Codes.InsufficientGas
+	} else {
+		*ctx.Gas = *ctx.Gas.Sub(ctx.Gas, gasRequired)
+	}
+	valSrcAddr, err := sdk.ValAddressFromBech32(args.SrcValidator)
+	if err != nil {
+		return StakingUnbondRets{}, errors.Wrap(errors.Codes.InvalidAddress, err.Error())
+	}
+	valDstAddr, err := sdk.ValAddressFromBech32(args.DstValidator)
+	if err != nil {
+		return StakingUnbondRets{}, errors.Wrap(errors.Codes.InvalidAddress, err.Error())
+	}
+	if args.Amount == 0 {
+		return StakingUnbondRets{}, errors.Wrap(errors.Codes.NativeFunction, "redelegation amount must be positive")
+	}
+	amount := sdk.NewIntFromUint64(args.Amount)
+
+	var completionTime time.Time
+	err = stc.execute(ctx, func(delegator sdk.AccAddress) error {
+		shares, err := stc.stakingKeeper.ValidateUnbondAmount(stc.ctx, delegator, valSrcAddr, amount)
+		if err != nil {
+			return err
+		}
+		completionTime, err = stc.stakingKeeper.BeginRedelegation(stc.ctx, delegator, valSrcAddr, valDstAddr, shares)
+		return err
+	})
+	if err != nil {
+		return StakingUnbondRets{}, err
+	}
+	stc.ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			stakingtypes.EventTypeRedelegate,
+			sdk.NewAttribute(stakingtypes.AttributeKeySrcValidator, args.SrcValidator),
+			sdk.NewAttribute(stakingtypes.AttributeKeyDstValidator, args.DstValidator),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+	return StakingUnbondRets{CompletionTime: completionTime.Unix()}, nil
+}
+
+// withdrawRewards withdraws the rewards of the calling contract's delegation to a
+// validator and returns the withdrawn amount in the bond denom.
+func (stc StakingCallable) withdrawRewards(ctx native.Context, args StakingWithdrawRewardsArgs) (StakingWithdrawRewardsRets, error) {
+	gasRequired := big.NewInt(GasBase)
+	if ctx.Gas.Cmp(gasRequired) == -1 {
+		return StakingWithdrawRewardsRets{}, errors.Codes.InsufficientGas
+	} else {
+		*ctx.Gas = *ctx.Gas.Sub(ctx.Gas, gasRequired)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(args.Validator)
+	if err != nil {
+		return StakingWithdrawRewardsRets{}, errors.Wrap(errors.Codes.InvalidAddress, err.Error())
+	}
+
+	var rewards sdk.Coins
+	err = stc.execute(ctx, func(delegator sdk.AccAddress) error {
+		rewards, err = stc.distributionKeeper.WithdrawDelegationRewards(stc.ctx, delegator, valAddr)
+		return err
+	})
+	if err != nil {
+		return StakingWithdrawRewardsRets{}, err
+	}
+	amount := rewards.AmountOf(stc.stakingKeeper.BondDenom(stc.ctx))
+	if !amount.IsUint64() {
+		return StakingWithdrawRewardsRets{}, errors.Codes.IntegerOverflow
+	}
+	return StakingWithdrawRewardsRets{Amount: amount.Uint64()}, nil
+}
+
+// delegation returns the balance of the delegation from a delegator to a validator.
+func (stc StakingCallable) delegation(ctx native.Context, args StakingDelegationArgs) (StakingDelegationRets, error) {
+	gasRequired := big.NewInt(GasBase)
+	if ctx.Gas.Cmp(gasRequired) == -1 {
+		return StakingDelegationRets{}, errors.Codes.InsufficientGas
+	} else {
+		*ctx.Gas = *ctx.Gas.Sub(ctx.Gas, gasRequired)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(args.Validator)
+	if err != nil {
+		return StakingDelegationRets{}, errors.Wrap(errors.Codes.InvalidAddress, err.Error())
+	}
+	delegation, found := stc.stakingKeeper.GetDelegation(stc.ctx, args.Delegator.Bytes(), valAddr)
+	if !found {
+		return StakingDelegationRets{}, nil
+	}
+	validator, found := stc.stakingKeeper.GetValidator(stc.ctx, valAddr)
+	if !found {
+		return StakingDelegationRets{}, nil
+	}
+	balance := validator.TokensFromShares(delegation.Shares).TruncateInt()
+	if !balance.IsUint64() {
+		return StakingDelegationRets{}, errors.Codes.IntegerOverflow
+	}
+	return StakingDelegationRets{Balance: balance.Uint64(), Exists: true}, nil
+}
+
+// execute runs f on behalf of the calling contract. The bond denom balance changes f makes
+// in the bank module to the contract and its reward withdraw address are applied to the CVM
+// state as well, so that they are not overwritten when the CVM state is written back.
+func (stc StakingCallable) execute(ctx native.Context, f func(delegator sdk.AccAddress) error) error {
+	// Only a direct call can act on behalf of the caller, otherwise a contract could
+	// stake the funds of its own caller through DELEGATECALL.
+	if ctx.CallType != exec.CallTypeCall {
+		return errors.Codes.PermissionDenied
+	}
+	delegator := sdk.AccAddress(ctx.Caller.Bytes())
+	addrs := []crypto.Address{ctx.Caller}
+	if withdrawAddr := stc.distributionKeeper.GetDelegatorWithdrawAddr(stc.ctx, delegator); !withdrawAddr.Equals(delegator) {
+		addrs = append(addrs, crypto.MustAddressFromBytes(withdrawAddr))
+	}
+
+	// Load the accounts into the CVM state before the bank module changes them. Touching
+	// them also rejects the call in a read-only call frame.
+	bondDenom := stc.stakingKeeper.BondDenom(stc.ctx)
+	exists := make([]bool, len(addrs))
+	balances := make([]sdk.Int, len(addrs))
+	for i, addr := range addrs {
+		acc, err := ctx.State.CallFrame.GetAccount(addr)
+		if err != nil {
+			return err
+		}
+		if acc != nil {
+			err = engine.UpdateAccount(ctx.State.CallFrame, addr, func(*acm.Account) error { return nil })
+			if err != nil {
+				return err
+			}
+			exists[i] = true
+		}
+		balances[i] = stc.bankKeeper.GetBalance(stc.ctx, addr.Bytes(), bondDenom).Amount
+	}
+
+	stc.effects.Record()
+	if err := f(delegator); err != nil {
+		return errors.Wrap(errors.Codes.NativeFunction, err.Error())
+	}
+
+	for i, addr := range addrs {
+		change := stc.bankKeeper.GetBalance(stc.ctx, addr.Bytes(), bondDenom).Amount.Sub(balances[i])
+		if !exists[i] || change.IsZero() {
+			continue
+		}
+		err := engine.UpdateAccount(ctx.State.CallFrame, addr, func(acc *acm.Account) error {
+			if change.IsNegative() {
+				return acc.SubtractFromBalance(change.Neg().Uint64())
+			}
+			return acc.AddToBalance(change.Uint64())
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func leftPadAddress(bs ...byte) crypto.Address {
 	return crypto.AddressFromWord256(binary.LeftPadWord256(bs))
 }
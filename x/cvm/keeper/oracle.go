@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/certikfoundation/shentu/x/cvm/types"
+	oracletypes "github.com/certikfoundation/shentu/x/oracle/types"
+)
+
+// TaskFunction returns the function of the oracle tasks scoring the calls with the given data, which
+// is the hex encoded function selector of the data prefixed with 0x.
+func TaskFunction(data []byte) string {
+	if len(data) < 4 {
+		return ""
+	}
+	return "0x" + hex.EncodeToString(data[:4])
+}
+
+// CheckOracleScore returns an error if the security oracle scores a call into a contract below the
+// threshold score. The call is scored by the task of the contract for the function of the call, or
+// else by the task of the contract for all its functions, whose function is empty. Calls without
+// a succeeded task pass the check.
+func (k Keeper) CheckOracleScore(ctx sdk.Context, callee sdk.AccAddress, data []byte) error {
+	params := k.GetOracleCheckParams(ctx)
+	if !params.Enabled {
+		return nil
+	}
+
+	threshold := params.ThresholdScore
+	if threshold.IsZero() {
+		threshold = k.ok.GetTaskParams(ctx).ThresholdScore
+	}
+	functions := []string{""}
+	if function := TaskFunction(data); function != "" {
+		functions = []string{function, ""}
+	}
+	for _, function := range functions {
+		task, err := k.ok.GetTask(ctx, callee.String(), function)
+		if err != nil || task.Status != oracletypes.TaskStatusSucceeded {
+			continue
+		}
+		if task.Result.LT(threshold) {
+			return sdkerrors.Wrapf(types.ErrOracleScoreTooLow, "contract %s scores %s, below %s", callee, task.Result, threshold)
+		}
+		return nil
+	}
+	return nil
+}
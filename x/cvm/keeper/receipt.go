@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// receiptMsgTypeURLs are the type URLs of the messages receipts are recorded for.
+var receiptMsgTypeURLs = map[string]bool{
+	"/" + proto.MessageName(&types.MsgDeploy{}):    true,
+	"/" + proto.MessageName(&types.MsgCall{}):      true,
+	"/" + proto.MessageName(&types.MsgMultiCall{}): true,
+}
+
+// recordReceipt stores the receipt of a succeeded CVM message if receipts are enabled. Failed
+// messages fail their transactions, which discards their receipts along with their state changes.
+func (k Keeper) recordReceipt(ctx sdk.Context, caller, callee string, res TxResult) {
+	if !k.GetReceiptParams(ctx).Enabled {
+		return
+	}
+	txHash := tmhash.Sum(ctx.TxBytes())
+	receipt := types.Receipt{
+		TxHash:     txHash,
+		MsgIndex:   k.nextMsgIndex(ctx, txHash),
+		Height:     ctx.BlockHeight(),
+		Status:     types.ReceiptStatusSuccessful,
+		Caller:     caller,
+		Callee:     callee,
+		ReturnData: res.ReturnData,
+		GasUsed:    res.GasUsed,
+		Logs:       res.Logs,
+	}
+	if callee == "" {
+		receipt.ContractAddress = sdk.AccAddress(res.ReturnData).String()
+		receipt.ReturnData = nil
+	}
+	k.SetReceipt(ctx, receipt)
+}
+
+// recordMultiCallReceipt stores the receipt of a succeeded multi-call message if receipts are enabled.
+// The receipt has no callee, and holds the outcomes of the calls along with the logs of the succeeded calls.
+func (k Keeper) recordMultiCallReceipt(ctx sdk.Context, caller string, calls []types.CallReceipt, logs []types.Log,
+	gasUsed uint64) {
+	if !k.GetReceiptParams(ctx).Enabled {
+		return
+	}
+	txHash := tmhash.Sum(ctx.TxBytes())
+	receipt := types.Receipt{
+		TxHash:   txHash,
+		MsgIndex: k.nextMsgIndex(ctx, txHash),
+		Height:   ctx.BlockHeight(),
+		Status:   types.ReceiptStatusSuccessful,
+		Caller:   caller,
+		GasUsed:  gasUsed,
+		Logs:     logs,
+		Calls:    calls,
+	}
+	k.SetReceipt(ctx, receipt)
+}
+
+// nextMsgIndex returns the position in the transaction of the message the next receipt is recorded for.
+// Receipts are counted in the transient store, and the count is mapped to a message position by decoding
+// the transaction. The count itself is used if the transaction cannot be decoded.
+func (k Keeper) nextMsgIndex(ctx sdk.Context, txHash []byte) uint32 {
+	count := incrementCount(ctx.TransientStore(k.tkey), types.TxReceiptCountKey(txHash))
+
+	var raw txtypes.TxRaw
+	var body txtypes.TxBody
+	if raw.Unmarshal(ctx.TxBytes()) != nil || body.Unmarshal(raw.BodyBytes) != nil {
+		return uint32(count)
+	}
+	n := count
+	for i, msg := range body.Messages {
+		if !receiptMsgTypeURLs[msg.TypeUrl] {
+			continue
+		}
+		if n == 0 {
+			return uint32(i)
+		}
+		n--
+	}
+	return uint32(count)
+}
+
+// SetReceipt stores a receipt and indexes it by height. If the receipt index is enabled, it is also
+// indexed by the addresses of the contracts it involves and the topics of its logs.
+func (k Keeper) SetReceipt(ctx sdk.Context, receipt types.Receipt) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.ReceiptStoreKey(receipt.TxHash, receipt.MsgIndex), k.cdc.MustMarshalBinaryBare(&receipt))
+
+	indexKey := types.ReceiptIndexKey(receipt.Height, receipt.TxHash, receipt.MsgIndex)
+	store.Set(append(types.ReceiptHeightIndexKeyPrefix, indexKey...), []byte{0x01})
+	if !k.GetReceiptParams(ctx).IndexEnabled {
+		return
+	}
+	for _, prefix := range receiptIndexPrefixes(receipt) {
+		store.Set(append(prefix, indexKey...), []byte{0x01})
+	}
+}
+
+// GetReceipt returns the receipt of a message in a transaction.
+func (k Keeper) GetReceipt(ctx sdk.Context, txHash []byte, msgIndex uint32) (types.Receipt, bool) {
+	bz := ctx.KVStore(k.key).Get(types.ReceiptStoreKey(txHash, msgIndex))
+	if bz == nil {
+		return types.Receipt{}, false
+	}
+	var receipt types.Receipt
+	k.cdc.MustUnmarshalBinaryBare(bz, &receipt)
+	return receipt, true
+}
+
+// DeleteReceipt deletes a receipt together with its index entries.
+func (k Keeper) DeleteReceipt(ctx sdk.Context, receipt types.Receipt) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.ReceiptStoreKey(receipt.TxHash, receipt.MsgIndex))
+
+	indexKey := types.ReceiptIndexKey(receipt.Height, receipt.TxHash, receipt.MsgIndex)
+	store.Delete(append(types.ReceiptHeightIndexKeyPrefix, indexKey...))
+	for _, prefix := range receiptIndexPrefixes(receipt) {
+		store.Delete(append(prefix, indexKey...))
+	}
+}
+
+// PruneReceipts deletes the receipts that are older than the retention period.
+func (k Keeper) PruneReceipts(ctx sdk.Context) {
+	retention := k.GetReceiptParams(ctx).RetentionBlocks
+	if retention == 0 || ctx.BlockHeight() <= int64(retention) {
+		return
+	}
+	// Receipts up to this height are out of the retention period.
+	lastHeight := ctx.BlockHeight() - int64(retention)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ReceiptHeightIndexKeyPrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(lastHeight+1)))
+	var receiptKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		_, receiptKey := types.SplitReceiptIndexKey(iterator.Key())
+		receiptKeys = append(receiptKeys, receiptKey)
+	}
+	iterator.Close()
+
+	receiptStore := prefix.NewStore(ctx.KVStore(k.key), types.ReceiptStoreKeyPrefix)
+	for _, receiptKey := range receiptKeys {
+		var receipt types.Receipt
+		k.cdc.MustUnmarshalBinaryBare(receiptStore.Get(receiptKey), &receipt)
+		k.DeleteReceipt(ctx, receipt)
+	}
+}
+
+// receiptIndexPrefixes returns the address and topic index prefixes of a receipt.
+func receiptIndexPrefixes(receipt types.Receipt) [][]byte {
+	var prefixes [][]byte
+	seen := make(map[string]bool)
+	add := func(prefix []byte) {
+		if !seen[string(prefix)] {
+			seen[string(prefix)] = true
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	addresses := []string{receipt.Callee, receipt.ContractAddress}
+	for _, call := range receipt.Calls {
+		addresses = append(addresses, call.Callee)
+	}
+	for _, log := range receipt.Logs {
+		addresses = append(addresses, log.Address)
+	}
+	for _, address := range addresses {
+		if addr, err := sdk.AccAddressFromBech32(address); err == nil {
+			add(types.ReceiptAddressIndexPrefix(addr))
+		}
+	}
+	for _, log := range receipt.Logs {
+		for _, topic := range log.Topics {
+			add(types.ReceiptTopicIndexPrefix(topic))
+		}
+	}
+	return prefixes
+}
@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/cvm/keeper"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// encodeTx returns the bytes of an unsigned transaction with the messages.
+func encodeTx(t *testing.T, msgs ...proto.Message) []byte {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys[i] = any
+	}
+	body, err := (&txtypes.TxBody{Messages: anys}).Marshal()
+	require.NoError(t, err)
+	bz, err := (&txtypes.TxRaw{BodyBytes: body}).Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestReceipts(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+	msgServer := keeper.NewMsgServerImpl(app.CVMKeeper)
+	querier := keeper.Querier{Keeper: app.CVMKeeper}
+
+	// The contract logs Transfer(caller, <first word of the call data>) on every call.
+	topic := abi.GetEventID("Transfer(address,uint256)")
+	runtime := bc.MustSplice(PUSH1, 0, CALLDATALOAD, PUSH1, 0, MSTORE,
+		CALLER, PUSH32, topic.Bytes(), PUSH1, 32, PUSH1, 0, LOG2, STOP)
+	code := bc.MustSplice(PUSH1, len(runtime), PUSH1, 12, PUSH1, 0, CODECOPY, PUSH1, len(runtime), PUSH1, 0, RETURN, runtime)
+
+	deploy := types.NewMsgDeploy(addrs[0].String(), 0, code, transferAbiJsonString, nil, false, false)
+	deployTx := encodeTx(t, &deploy)
+	deployRes, err := msgServer.Deploy(sdk.WrapSDKContext(ctx.WithBlockHeight(10).WithTxBytes(deployTx)), &deploy)
+	require.NoError(t, err)
+	contract := sdk.AccAddress(deployRes.Result)
+
+	data := make([]byte, 32)
+	data[31] = 7
+	call := types.NewMsgCall(addrs[1].String(), contract.String(), 0, data)
+	send := banktypes.NewMsgSend(addrs[1], addrs[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	callTx := encodeTx(t, send, &call)
+	_, err = msgServer.Call(sdk.WrapSDKContext(ctx.WithBlockHeight(11).WithTxBytes(callTx)), &call)
+	require.NoError(t, err)
+
+	// The contract always reverts.
+	revertCode := bc.MustSplice(PUSH1, 4, PUSH1, 12, PUSH1, 0, CODECOPY, PUSH1, 4, PUSH1, 0, RETURN, PUSH1, 0, DUP1, REVERT)
+	revertDeploy := types.NewMsgDeploy(addrs[1].String(), 0, revertCode, "", nil, false, false)
+	revertDeployTx := encodeTx(t, &revertDeploy)
+	revertDeployRes, err := msgServer.Deploy(sdk.WrapSDKContext(ctx.WithBlockHeight(12).WithTxBytes(revertDeployTx)), &revertDeploy)
+	require.NoError(t, err)
+	failedCall := types.NewMsgCall(addrs[0].String(), sdk.AccAddress(revertDeployRes.Result).String(), 0, nil)
+	failedTx := encodeTx(t, &failedCall)
+	_, err = msgServer.Call(sdk.WrapSDKContext(ctx.WithBlockHeight(12).WithTxBytes(failedTx)), &failedCall)
+	require.IsType(t, &types.CallFailure{}, err)
+
+	ctx = ctx.WithBlockHeight(12)
+	getReceipt := func(txBytes []byte, msgIndex uint32) types.Receipt {
+		res, err := querier.Receipt(sdk.WrapSDKContext(ctx), &types.QueryReceiptRequest{
+			TxHash:   hex.EncodeToString(tmhash.Sum(txBytes)),
+			MsgIndex: msgIndex,
+		})
+		require.NoError(t, err)
+		return res.Receipt
+	}
+	listReceipts := func(req types.QueryReceiptsRequest) []types.Receipt {
+		res, err := querier.Receipts(sdk.WrapSDKContext(ctx), &req)
+		require.NoError(t, err)
+		return res.Receipts
+	}
+
+	t.Run("record receipts", func(t *testing.T) {
+		receipt := getReceipt(deployTx, 0)
+		require.Equal(t, int64(10), receipt.Height)
+		require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+		require.Equal(t, contract.String(), receipt.ContractAddress)
+		require.Empty(t, receipt.Callee)
+		require.NotZero(t, receipt.GasUsed)
+
+		receipt = getReceipt(callTx, 1)
+		require.Equal(t, tmhash.Sum(callTx), receipt.TxHash)
+		require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+		require.Equal(t, addrs[1].String(), receipt.Caller)
+		require.Equal(t, contract.String(), receipt.Callee)
+		require.Empty(t, receipt.ContractAddress)
+		require.Len(t, receipt.Logs, 1)
+		require.Equal(t, contract.String(), receipt.Logs[0].Address)
+		require.Equal(t, topic.Bytes(), receipt.Logs[0].Topics[0])
+		require.Equal(t, data, receipt.Logs[0].Data)
+
+		// A failed call fails its transaction, which records no receipt.
+		_, found := app.CVMKeeper.GetReceipt(ctx, tmhash.Sum(failedTx), 0)
+		require.False(t, found)
+
+		_, err := querier.Receipt(sdk.WrapSDKContext(ctx), &types.QueryReceiptRequest{
+			TxHash: hex.EncodeToString(tmhash.Sum(callTx)),
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("list receipts", func(t *testing.T) {
+		require.Len(t, listReceipts(types.QueryReceiptsRequest{}), 3)
+		require.Len(t, listReceipts(types.QueryReceiptsRequest{FromHeight: 11}), 2)
+		require.Len(t, listReceipts(types.QueryReceiptsRequest{ToHeight: 10}), 1)
+		require.Len(t, listReceipts(types.QueryReceiptsRequest{Address: contract.String()}), 2)
+		require.Len(t, listReceipts(types.QueryReceiptsRequest{Address: contract.String(), FromHeight: 11}), 1)
+		require.Len(t, listReceipts(types.QueryReceiptsRequest{Topic: hex.EncodeToString(topic.Bytes())}), 1)
+		require.Len(t, listReceipts(types.QueryReceiptsRequest{
+			Address: contract.String(),
+			Topic:   hex.EncodeToString(make([]byte, 32)),
+		}), 0)
+
+		res, err := querier.Receipts(sdk.WrapSDKContext(ctx), &types.QueryReceiptsRequest{
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Receipts, 2)
+		require.Equal(t, uint64(3), res.Pagination.Total)
+		require.NotNil(t, res.Pagination.NextKey)
+	})
+
+	t.Run("prune receipts", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(13)
+		params := types.DefaultReceiptParams()
+		params.RetentionBlocks = 2
+		app.CVMKeeper.SetReceiptParams(ctx, params)
+		app.CVMKeeper.PruneReceipts(ctx)
+
+		_, found := app.CVMKeeper.GetReceipt(ctx, tmhash.Sum(callTx), 1)
+		require.False(t, found)
+		_, found = app.CVMKeeper.GetReceipt(ctx, tmhash.Sum(revertDeployTx), 0)
+		require.True(t, found)
+		require.Len(t, listReceipts(types.QueryReceiptsRequest{}), 1)
+		require.Len(t, listReceipts(types.QueryReceiptsRequest{Address: contract.String()}), 0)
+		require.Len(t, listReceipts(types.QueryReceiptsRequest{Topic: hex.EncodeToString(topic.Bytes())}), 0)
+	})
+
+	t.Run("disable receipts", func(t *testing.T) {
+		app.CVMKeeper.SetReceiptParams(ctx, types.ReceiptParams{})
+		tx := encodeTx(t, &call)
+		_, err := msgServer.Call(sdk.WrapSDKContext(ctx.WithTxBytes(tx)), &call)
+		require.NoError(t, err)
+		_, found := app.CVMKeeper.GetReceipt(ctx, tmhash.Sum(tx), 0)
+		require.False(t, found)
+	})
+}
+
+func TestReceiptsOfRevertedCalls(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()}).WithBlockHeight(10)
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+	msgServer := keeper.NewMsgServerImpl(app.CVMKeeper)
+
+	// The callee logs Reverted() and reverts; the caller ignores the failure and logs Caught().
+	reverted, caught := abi.GetEventID("Reverted()"), abi.GetEventID("Caught()")
+	callee := deployRuntime(t, ctx, app.CVMKeeper, addrs[0], bc.MustSplice(
+		PUSH32, reverted.Bytes(), PUSH1, 0, DUP1, LOG1, PUSH1, 0, DUP1, REVERT))
+	caller := deployRuntime(t, ctx, app.CVMKeeper, addrs[1], bc.MustSplice(
+		PUSH1, 0, DUP1, DUP1, DUP1, DUP1, PUSH20, callee.Bytes(), GAS, CALL, POP,
+		PUSH32, caught.Bytes(), PUSH1, 0, DUP1, LOG1, STOP))
+
+	call := types.NewMsgCall(addrs[0].String(), caller.String(), 0, nil)
+	tx := encodeTx(t, &call)
+	ctx = ctx.WithTxBytes(tx).WithEventManager(sdk.NewEventManager())
+	_, err := msgServer.Call(sdk.WrapSDKContext(ctx), &call)
+	require.NoError(t, err)
+
+	receipt, found := app.CVMKeeper.GetReceipt(ctx, tmhash.Sum(tx), 0)
+	require.True(t, found)
+	require.Len(t, receipt.Logs, 1)
+	require.Equal(t, caller.String(), receipt.Logs[0].Address)
+	require.Equal(t, caught.Bytes(), receipt.Logs[0].Topics[0])
+}
@@ -0,0 +1,293 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/hyperledger/burrow/crypto"
+
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// Reasons of the ends of scheduled calls.
+const (
+	scheduledCallCompleted = "completed"
+	scheduledCallExpired   = "expired"
+	scheduledCallCanceled  = "canceled"
+)
+
+// SetScheduleBlockGasLimit sets the gas the scheduled calls can use at the beginning of each block in
+// the parameters subspace.
+func (k Keeper) SetScheduleBlockGasLimit(ctx sdk.Context, limit uint64) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyScheduleBlockGasLimit, &limit)
+}
+
+// GetScheduleBlockGasLimit returns the gas the scheduled calls can use at the beginning of each block
+// in the parameters subspace. Chains that have not set it use the default limit, and the read is
+// not charged, so that the transactions of chains without scheduled calls use the same gas.
+func (k Keeper) GetScheduleBlockGasLimit(ctx sdk.Context) uint64 {
+	limit := types.DefaultScheduleBlockGasLimit
+	k.paramSpace.GetIfExists(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), types.ParamStoreKeyScheduleBlockGasLimit, &limit)
+	return limit
+}
+
+// SetNextScheduledCallID sets the ID of the next scheduled call.
+func (k Keeper) SetNextScheduledCallID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.key).Set(types.NextScheduledCallIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetNextScheduledCallID returns the ID of the next scheduled call. IDs start at 1.
+func (k Keeper) GetNextScheduledCallID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.NextScheduledCallIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetScheduledCall sets a scheduled call and queues it at the height of its next run.
+func (k Keeper) SetScheduledCall(ctx sdk.Context, call types.ScheduledCall) {
+	store := ctx.KVStore(k.key)
+	if previous, ok := k.GetScheduledCall(ctx, call.Id); ok {
+		store.Delete(types.ScheduledCallQueueKey(previous.NextHeight, previous.Id))
+	}
+	store.Set(types.ScheduledCallStoreKey(call.Id), k.cdc.MustMarshalBinaryBare(&call))
+	store.Set(types.ScheduledCallQueueKey(call.NextHeight, call.Id), []byte{})
+}
+
+// GetScheduledCall returns a scheduled call.
+func (k Keeper) GetScheduledCall(ctx sdk.Context, id uint64) (types.ScheduledCall, bool) {
+	bz := ctx.KVStore(k.key).Get(types.ScheduledCallStoreKey(id))
+	if bz == nil {
+		return types.ScheduledCall{}, false
+	}
+	var call types.ScheduledCall
+	k.cdc.MustUnmarshalBinaryBare(bz, &call)
+	return call, true
+}
+
+// DeleteScheduledCall deletes a scheduled call and removes it from the queue.
+func (k Keeper) DeleteScheduledCall(ctx sdk.Context, call types.ScheduledCall) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.ScheduledCallStoreKey(call.Id))
+	store.Delete(types.ScheduledCallQueueKey(call.NextHeight, call.Id))
+}
+
+// IterateScheduledCalls iterates over the scheduled calls by ID.
+func (k Keeper) IterateScheduledCalls(ctx sdk.Context, callback func(call types.ScheduledCall) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.ScheduledCallStoreKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var call types.ScheduledCall
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &call)
+		if callback(call) {
+			break
+		}
+	}
+}
+
+// GetAllScheduledCalls returns all the scheduled calls.
+func (k Keeper) GetAllScheduledCalls(ctx sdk.Context) []types.ScheduledCall {
+	calls := []types.ScheduledCall{}
+	k.IterateScheduledCalls(ctx, func(call types.ScheduledCall) bool {
+		calls = append(calls, call)
+		return false
+	})
+	return calls
+}
+
+// ScheduleCall schedules a contract call, whose first run is an interval after the current block,
+// holds its prepaid fee in escrow and returns its ID.
+func (k Keeper) ScheduleCall(ctx sdk.Context, msg *types.MsgScheduleCall) (uint64, error) {
+	caller, err := sdk.AccAddressFromBech32(msg.Caller)
+	if err != nil {
+		return 0, err
+	}
+	callee, err := sdk.AccAddressFromBech32(msg.Callee)
+	if err != nil {
+		return 0, err
+	}
+	code, err := k.GetCode(ctx, crypto.MustAddressFromBytes(callee))
+	if err != nil {
+		return 0, err
+	}
+	if len(code) == 0 {
+		return 0, sdkerrors.Wrapf(types.ErrNotContract, "%s", msg.Callee)
+	}
+	if limit := k.GetScheduleBlockGasLimit(ctx); msg.GasLimit > limit {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "gas limit %d exceeds the schedule block gas limit %d",
+			msg.GasLimit, limit)
+	}
+	if err := k.CheckOracleScore(ctx, callee, msg.Data); err != nil {
+		return 0, err
+	}
+
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, caller, types.ModuleName, msg.PrepaidFee); err != nil {
+		return 0, err
+	}
+
+	id := k.GetNextScheduledCallID(ctx)
+	k.SetNextScheduledCallID(ctx, id+1)
+	k.SetScheduledCall(ctx, types.ScheduledCall{
+		Id:         id,
+		Caller:     msg.Caller,
+		Callee:     msg.Callee,
+		Data:       msg.Data,
+		Interval:   msg.Interval,
+		NextHeight: ctx.BlockHeight() + int64(msg.Interval),
+		MaxRuns:    msg.MaxRuns,
+		GasLimit:   msg.GasLimit,
+		PrepaidGas: msg.PrepaidGas,
+		PrepaidFee: msg.PrepaidFee,
+	})
+	return id, nil
+}
+
+// RunScheduledCalls runs the scheduled calls due at the current block in the order of their
+// heights, up to MaxScheduledCallsPerBlock calls within the schedule block gas limit. The calls that
+// do not fit are skipped until the next blocks, where they come first. Each run pays the fee
+// collector its share of the prepaid fee for the gas it uses. The calls whose prepaid gas runs out
+// or whose gas limit exceeds the schedule block gas limit expire.
+func (k Keeper) RunScheduledCalls(ctx sdk.Context) {
+	blockLimit := k.GetScheduleBlockGasLimit(ctx)
+	store := ctx.KVStore(k.key)
+	iterator := store.Iterator(types.ScheduledCallQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.ScheduledCallQueueHeightPrefix(ctx.BlockHeight())))
+	var ids []uint64
+	for ; iterator.Valid() && len(ids) < types.MaxScheduledCallsPerBlock; iterator.Next() {
+		ids = append(ids, types.SplitScheduledCallQueueKey(iterator.Key()))
+	}
+	iterator.Close()
+
+	gasLeft := blockLimit
+	for _, id := range ids {
+		call, ok := k.GetScheduledCall(ctx, id)
+		if !ok {
+			panic("scheduled call in the queue does not exist")
+		}
+		if call.IsUnderfunded() || call.GasLimit > blockLimit {
+			k.endScheduledCall(ctx, call, scheduledCallExpired)
+			continue
+		}
+		if call.GasLimit > gasLeft {
+			continue
+		}
+
+		gasUsed, err := k.runScheduledCall(ctx, call)
+		gasLeft -= gasUsed
+		fee := scheduledCallFee(call, gasUsed)
+		if err := k.bk.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, fee); err != nil {
+			panic(err)
+		}
+		call.PrepaidFee = call.PrepaidFee.Sub(fee)
+		call.PrepaidGas -= gasUsed
+		call.Runs++
+		ctx.EventManager().EmitEvent(newScheduledCallEvent(call, gasUsed, err))
+
+		switch {
+		case call.IsCompleted():
+			k.endScheduledCall(ctx, call, scheduledCallCompleted)
+		case call.IsUnderfunded():
+			k.endScheduledCall(ctx, call, scheduledCallExpired)
+		default:
+			call.NextHeight = ctx.BlockHeight() + int64(call.Interval)
+			k.SetScheduledCall(ctx, call)
+		}
+	}
+}
+
+// runScheduledCall runs a scheduled call on a cached context limited to its gas limit, whose state
+// changes are only written if the call succeeds, and returns the gas used. Scheduled calls run in
+// BeginBlock, where a panic would halt the chain, so a run that panics fails and uses its gas limit.
+func (k Keeper) runScheduledCall(ctx sdk.Context, call types.ScheduledCall) (gasUsed uint64, err error) {
+	gasMeter := sdk.NewGasMeter(call.GasLimit)
+	cacheCtx, write := ctx.WithGasMeter(gasMeter).CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			gasUsed = call.GasLimit
+			if outOfGas, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, outOfGas.Descriptor)
+			} else {
+				err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+			}
+		}
+	}()
+
+	caller, err := sdk.AccAddressFromBech32(call.Caller)
+	if err != nil {
+		return 0, err
+	}
+	callee, err := sdk.AccAddressFromBech32(call.Callee)
+	if err != nil {
+		return 0, err
+	}
+	if err = k.CheckOracleScore(cacheCtx, callee, call.Data); err == nil {
+		_, err = k.execute(cacheCtx, caller, callee, 0, call.Data, nil, nil, false, false, false, nil)
+	}
+	if err == nil {
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	return gasMeter.GasConsumedToLimit(), err
+}
+
+// scheduledCallFee returns the share of the prepaid fee of a scheduled call that pays for the gas
+// used by a run, rounded down.
+func scheduledCallFee(call types.ScheduledCall, gasUsed uint64) sdk.Coins {
+	fee := sdk.NewCoins()
+	for _, coin := range call.PrepaidFee {
+		amount := coin.Amount.Mul(sdk.NewIntFromUint64(gasUsed)).Quo(sdk.NewIntFromUint64(call.PrepaidGas))
+		fee = fee.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return fee
+}
+
+// endScheduledCall deletes a scheduled call that ended for a reason and refunds its prepaid fee
+// left to its caller.
+func (k Keeper) endScheduledCall(ctx sdk.Context, call types.ScheduledCall, reason string) {
+	caller, err := sdk.AccAddressFromBech32(call.Caller)
+	if err != nil {
+		panic(err)
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, caller, call.PrepaidFee); err != nil {
+		panic(err)
+	}
+	k.DeleteScheduledCall(ctx, call)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEndScheduledCall,
+			sdk.NewAttribute(types.AttributeKeyScheduledCallID, strconv.FormatUint(call.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+}
+
+// CancelScheduledCall cancels a scheduled call of a caller and refunds its prepaid fee left.
+func (k Keeper) CancelScheduledCall(ctx sdk.Context, caller sdk.AccAddress, id uint64) error {
+	call, ok := k.GetScheduledCall(ctx, id)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrScheduledCallNotFound, "%d", id)
+	}
+	if call.Caller != caller.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the caller of scheduled call %d", caller, id)
+	}
+	k.endScheduledCall(ctx, call, scheduledCallCanceled)
+	return nil
+}
+
+func newScheduledCallEvent(call types.ScheduledCall, gasUsed uint64, err error) sdk.Event {
+	event := sdk.NewEvent(
+		types.EventTypeScheduledCall,
+		sdk.NewAttribute(types.AttributeKeyScheduledCallID, strconv.FormatUint(call.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+	)
+	if err != nil {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyReason, err.Error()))
+	}
+	return event
+}
@@ -0,0 +1,268 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/cvm/keeper"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+func TestScheduledCalls(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(80000*1e6))
+	caller, other := addrs[0], addrs[1]
+	msgServer := keeper.NewMsgServerImpl(app.CVMKeeper)
+	querier := keeper.Querier{Keeper: app.CVMKeeper}
+
+	// The counter increments its first storage slot, and the loop runs out of gas.
+	counter := deployRuntime(t, ctx, app.CVMKeeper, addrs[0], bc.MustSplice(PUSH1, 0, SLOAD, PUSH1, 1, ADD, PUSH1, 0, SSTORE, STOP))
+	loop := deployRuntime(t, ctx, app.CVMKeeper, addrs[1], bc.MustSplice(JUMPDEST, PUSH1, 0, JUMP))
+	count := func(ctx sdk.Context) int64 {
+		value, err := app.CVMKeeper.GetStorage(ctx, crypto.MustAddressFromBytes(counter), binary.Int64ToWord256(0))
+		require.NoError(t, err)
+		return binary.Int64FromWord256(binary.LeftPadWord256(value))
+	}
+	// The prepaid fee is a tenth of the prepaid gas in the bond denom.
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	fee := func(gas uint64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, int64(gas/10)))
+	}
+	balance := func(ctx sdk.Context, addr sdk.AccAddress) int64 {
+		return app.BankKeeper.GetBalance(ctx, addr, bondDenom).Amount.Int64()
+	}
+	escrow := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	// scheduledCallEvents returns the events of the runs and the ends of scheduled calls.
+	scheduledCallEvents := func(ctx sdk.Context) sdk.Events {
+		var events sdk.Events
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeScheduledCall || event.Type == types.EventTypeEndScheduledCall {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+	schedule := func(ctx sdk.Context, callee sdk.AccAddress, interval, maxRuns, gasLimit, prepaidGas uint64) (uint64, error) {
+		msg := types.NewMsgScheduleCall(caller.String(), callee.String(), nil, interval, maxRuns, gasLimit, prepaidGas,
+			fee(prepaidGas))
+		res, err := msgServer.ScheduleCall(sdk.WrapSDKContext(ctx), &msg)
+		if err != nil {
+			return 0, err
+		}
+		return res.Id, nil
+	}
+
+	t.Run("scheduling holds the prepaid fee in escrow", func(t *testing.T) {
+		_, err := schedule(ctx, other, 1, 0, 100000, 100000)
+		require.ErrorIs(t, err, types.ErrNotContract)
+		_, err = schedule(ctx, counter, 1, 0, types.DefaultScheduleBlockGasLimit+1, types.DefaultScheduleBlockGasLimit+1)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+		ctx, _ := ctx.CacheContext()
+		callerBalance := balance(ctx, caller)
+		ctx = ctx.WithGasMeter(sdk.NewGasMeter(1000000))
+		id, err := schedule(ctx, counter, 1, 0, 100000, 1000000)
+		require.NoError(t, err)
+		require.Less(t, ctx.GasMeter().GasConsumed(), uint64(100000))
+		require.Equal(t, callerBalance-100000, balance(ctx, caller))
+		require.Equal(t, int64(100000), balance(ctx, escrow))
+		call, _ := app.CVMKeeper.GetScheduledCall(ctx, id)
+		require.Equal(t, fee(1000000), call.PrepaidFee)
+
+		msg := types.NewMsgScheduleCall(caller.String(), counter.String(), nil, 1, 0, 100000, 1000000,
+			sdk.NewCoins(sdk.NewInt64Coin(bondDenom, callerBalance)))
+		_, err = msgServer.ScheduleCall(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	})
+
+	t.Run("calls run every interval up to their maximum number of runs", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		id, err := schedule(ctx, counter, 2, 2, 100000, 1000000)
+		require.NoError(t, err)
+
+		for height, expected := range []int64{0, 0, 0, 1, 1, 2, 2} {
+			ctx = ctx.WithBlockHeight(int64(height))
+			app.CVMKeeper.RunScheduledCalls(ctx)
+			require.Equal(t, expected, count(ctx), height)
+		}
+		_, ok := app.CVMKeeper.GetScheduledCall(ctx, id)
+		require.False(t, ok)
+	})
+
+	t.Run("failed runs are not written and underfunded calls expire", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		callerBalance, collected := balance(ctx, caller), balance(ctx, feeCollector)
+		id, err := schedule(ctx, loop, 1, 0, 100000, 150000)
+		require.NoError(t, err)
+
+		// The run pays the fee of its gas limit, and the rest is refunded.
+		ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+		app.CVMKeeper.RunScheduledCalls(ctx)
+		_, ok := app.CVMKeeper.GetScheduledCall(ctx, id)
+		require.False(t, ok)
+		require.Equal(t, callerBalance-10000, balance(ctx, caller))
+		require.Equal(t, collected+10000, balance(ctx, feeCollector))
+		require.Zero(t, balance(ctx, escrow))
+		events := scheduledCallEvents(ctx)
+		require.Len(t, events, 2)
+		require.Equal(t, types.EventTypeScheduledCall, events[0].Type)
+		require.Equal(t, "false", string(events[0].Attributes[1].Value))
+		require.Equal(t, "100000", string(events[0].Attributes[2].Value))
+		require.Equal(t, types.EventTypeEndScheduledCall, events[1].Type)
+		require.Equal(t, "expired", string(events[1].Attributes[1].Value))
+	})
+
+	t.Run("panicking runs fail and use their gas limit", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		id, err := schedule(ctx, counter, 1, 0, 100000, 1000000)
+		require.NoError(t, err)
+		// The account of the callee cannot be decoded, which panics outside of the CVM.
+		ctx.KVStore(app.GetKey(authtypes.StoreKey)).Set(authtypes.AddressStoreKey(counter), []byte("corrupted"))
+
+		ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+		require.NotPanics(t, func() { app.CVMKeeper.RunScheduledCalls(ctx) })
+		call, ok := app.CVMKeeper.GetScheduledCall(ctx, id)
+		require.True(t, ok)
+		require.Equal(t, uint64(1), call.Runs)
+		require.Equal(t, uint64(900000), call.PrepaidGas)
+		require.Equal(t, fee(900000), call.PrepaidFee)
+		events := scheduledCallEvents(ctx)
+		require.Len(t, events, 1)
+		require.Equal(t, "false", string(events[0].Attributes[1].Value))
+		require.Equal(t, "100000", string(events[0].Attributes[2].Value))
+		require.Contains(t, string(events[0].Attributes[3].Value), sdkerrors.ErrPanic.Error())
+	})
+
+	t.Run("calls beyond the block gas limit are skipped", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		app.CVMKeeper.SetScheduleBlockGasLimit(ctx, 150000)
+		first, err := schedule(ctx, loop, 1, 0, 100000, 1000000)
+		require.NoError(t, err)
+		second, err := schedule(ctx, counter, 1, 0, 100000, 1000000)
+		require.NoError(t, err)
+
+		ctx = ctx.WithBlockHeight(2)
+		app.CVMKeeper.RunScheduledCalls(ctx)
+		require.Equal(t, int64(0), count(ctx))
+		call, _ := app.CVMKeeper.GetScheduledCall(ctx, first)
+		require.Equal(t, uint64(1), call.Runs)
+		call, _ = app.CVMKeeper.GetScheduledCall(ctx, second)
+		require.Equal(t, uint64(0), call.Runs)
+
+		// The skipped call is due since an earlier height, so it runs first, and leaves enough gas
+		// for the other call.
+		ctx = ctx.WithBlockHeight(3)
+		app.CVMKeeper.RunScheduledCalls(ctx)
+		require.Equal(t, int64(1), count(ctx))
+		call, _ = app.CVMKeeper.GetScheduledCall(ctx, first)
+		require.Equal(t, uint64(2), call.Runs)
+
+		// At most MaxScheduledCallsPerBlock calls run in a block.
+		app.CVMKeeper.SetScheduleBlockGasLimit(ctx, types.DefaultScheduleBlockGasLimit)
+		for _, call := range app.CVMKeeper.GetAllScheduledCalls(ctx) {
+			require.NoError(t, app.CVMKeeper.CancelScheduledCall(ctx, caller, call.Id))
+		}
+		var ids []uint64
+		for i := 0; i <= types.MaxScheduledCallsPerBlock; i++ {
+			id, err := schedule(ctx, counter, 1, 0, 50000, 1000000)
+			require.NoError(t, err)
+			ids = append(ids, id)
+		}
+		app.CVMKeeper.RunScheduledCalls(ctx.WithBlockHeight(4))
+		for i, id := range ids {
+			call, _ := app.CVMKeeper.GetScheduledCall(ctx, id)
+			require.Equal(t, i < types.MaxScheduledCallsPerBlock, call.Runs == 1, i)
+		}
+
+		// The calls whose gas limit exceeds the block gas limit expire, within the cap of each block.
+		app.CVMKeeper.SetScheduleBlockGasLimit(ctx, 40000)
+		app.CVMKeeper.RunScheduledCalls(ctx.WithBlockHeight(5))
+		require.Len(t, app.CVMKeeper.GetAllScheduledCalls(ctx), 1)
+		app.CVMKeeper.RunScheduledCalls(ctx.WithBlockHeight(6))
+		require.Empty(t, app.CVMKeeper.GetAllScheduledCalls(ctx))
+		require.Zero(t, balance(ctx, escrow))
+	})
+
+	t.Run("callers query and cancel their calls", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		id, err := schedule(ctx, counter, 1, 0, 100000, 1000000)
+		require.NoError(t, err)
+
+		res, err := querier.ScheduledCall(sdk.WrapSDKContext(ctx), &types.QueryScheduledCallRequest{Id: id})
+		require.NoError(t, err)
+		require.Equal(t, types.ScheduledCall{
+			Id:         id,
+			Caller:     caller.String(),
+			Callee:     counter.String(),
+			Interval:   1,
+			NextHeight: 2,
+			GasLimit:   100000,
+			PrepaidGas: 1000000,
+			PrepaidFee: fee(1000000),
+		}, res.ScheduledCall)
+		for _, tc := range []struct {
+			caller   string
+			expected []types.ScheduledCall
+		}{
+			{"", []types.ScheduledCall{res.ScheduledCall}},
+			{caller.String(), []types.ScheduledCall{res.ScheduledCall}},
+			{other.String(), nil},
+		} {
+			calls, err := querier.ScheduledCalls(sdk.WrapSDKContext(ctx), &types.QueryScheduledCallsRequest{Caller: tc.caller})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, calls.ScheduledCalls, tc.caller)
+		}
+
+		msg := types.NewMsgCancelScheduledCall(other.String(), id)
+		_, err = msgServer.CancelScheduledCall(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		callerBalance := balance(ctx, caller)
+		msg = types.NewMsgCancelScheduledCall(caller.String(), id)
+		_, err = msgServer.CancelScheduledCall(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+		require.Equal(t, callerBalance+100000, balance(ctx, caller))
+		_, err = msgServer.CancelScheduledCall(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, types.ErrScheduledCallNotFound)
+
+		ctx = ctx.WithBlockHeight(2)
+		app.CVMKeeper.RunScheduledCalls(ctx)
+		require.Equal(t, int64(0), count(ctx))
+	})
+}
+
+func TestMsgScheduleCallValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(crypto.Address{1}.Bytes()).String()
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	tests := []struct {
+		name     string
+		msg      types.MsgScheduleCall
+		expected bool
+	}{
+		{"valid", types.NewMsgScheduleCall(addr, addr, nil, 10, 0, 100000, 1000000, fee), true},
+		{"invalid callee", types.NewMsgScheduleCall(addr, "", nil, 10, 0, 100000, 1000000, fee), false},
+		{"zero interval", types.NewMsgScheduleCall(addr, addr, nil, 0, 0, 100000, 1000000, fee), false},
+		{"gas limit below the minimum", types.NewMsgScheduleCall(addr, addr, nil, 10, 0, types.MinScheduledCallGasLimit-1, 1000000, fee), false},
+		{"prepaid gas below the gas limit", types.NewMsgScheduleCall(addr, addr, nil, 10, 0, 100000, 99999, fee), false},
+		{"no prepaid fee", types.NewMsgScheduleCall(addr, addr, nil, 10, 0, 100000, 1000000, nil), false},
+	}
+	for _, tc := range tests {
+		err := tc.msg.ValidateBasic()
+		require.Equal(t, tc.expected, err == nil, tc.name)
+	}
+}
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyperledger/burrow/crypto"
+
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// SetSponsorship sets the sponsorship of the calls into a contract.
+func (k Keeper) SetSponsorship(ctx sdk.Context, address crypto.Address, sponsorship types.Sponsorship) {
+	ctx.KVStore(k.key).Set(types.SponsorshipStoreKey(address), k.cdc.MustMarshalBinaryBare(&sponsorship))
+}
+
+// GetSponsorship returns the sponsorship of the calls into a contract, which may have expired.
+func (k Keeper) GetSponsorship(ctx sdk.Context, address crypto.Address) (types.Sponsorship, bool) {
+	bz := ctx.KVStore(k.key).Get(types.SponsorshipStoreKey(address))
+	if bz == nil {
+		return types.Sponsorship{}, false
+	}
+	var sponsorship types.Sponsorship
+	k.cdc.MustUnmarshalBinaryBare(bz, &sponsorship)
+	return sponsorship, true
+}
+
+// DeleteSponsorship deletes the sponsorship of the calls into a contract along with its usages.
+func (k Keeper) DeleteSponsorship(ctx sdk.Context, address crypto.Address) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.SponsorshipStoreKey(address))
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SponsorUsagesStoreKeyPrefix(address))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateSponsorships iterates over the sponsorships of contracts.
+func (k Keeper) IterateSponsorships(ctx sdk.Context, callback func(sponsorship types.Sponsorship) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.SponsorshipStoreKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sponsorship types.Sponsorship
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &sponsorship)
+		if callback(sponsorship) {
+			break
+		}
+	}
+}
+
+// GetAllSponsorships returns the sponsorships of all contracts.
+func (k Keeper) GetAllSponsorships(ctx sdk.Context) []types.Sponsorship {
+	sponsorships := []types.Sponsorship{}
+	k.IterateSponsorships(ctx, func(sponsorship types.Sponsorship) bool {
+		sponsorships = append(sponsorships, sponsorship)
+		return false
+	})
+	return sponsorships
+}
+
+// SetSponsorUsage sets the fees the sponsor of a contract paid for the calls of a user.
+func (k Keeper) SetSponsorUsage(ctx sdk.Context, address crypto.Address, user sdk.AccAddress, usage types.SponsorUsage) {
+	ctx.KVStore(k.key).Set(types.SponsorUsageStoreKey(address, user), k.cdc.MustMarshalBinaryBare(&usage))
+}
+
+// GetSponsorUsage returns the fees the sponsor of a contract paid for the calls of a user today,
+// which are empty if it paid none.
+func (k Keeper) GetSponsorUsage(ctx sdk.Context, address crypto.Address, user sdk.AccAddress) types.SponsorUsage {
+	usage := types.SponsorUsage{
+		Contract: sdk.AccAddress(address.Bytes()).String(),
+		User:     user.String(),
+		Day:      types.SponsorshipDay(ctx.BlockTime()),
+	}
+	bz := ctx.KVStore(k.key).Get(types.SponsorUsageStoreKey(address, user))
+	if bz == nil {
+		return usage
+	}
+	var previous types.SponsorUsage
+	k.cdc.MustUnmarshalBinaryBare(bz, &previous)
+	if previous.Day != usage.Day {
+		return usage
+	}
+	return previous
+}
+
+// IterateSponsorUsages iterates over the latest usages of the sponsorships of contracts.
+func (k Keeper) IterateSponsorUsages(ctx sdk.Context, callback func(usage types.SponsorUsage) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.SponsorUsageStoreKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.SponsorUsage
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &usage)
+		if callback(usage) {
+			break
+		}
+	}
+}
+
+// GetAllSponsorUsages returns the latest usages of the sponsorships of all contracts.
+func (k Keeper) GetAllSponsorUsages(ctx sdk.Context) []types.SponsorUsage {
+	usages := []types.SponsorUsage{}
+	k.IterateSponsorUsages(ctx, func(usage types.SponsorUsage) bool {
+		usages = append(usages, usage)
+		return false
+	})
+	return usages
+}
+
+// SponsorRemaining returns the fees a sponsorship pays for the calls of a user for the rest of the day.
+func (k Keeper) SponsorRemaining(ctx sdk.Context, sponsorship types.Sponsorship, user sdk.AccAddress) sdk.Coins {
+	contract, err := sdk.AccAddressFromBech32(sponsorship.Contract)
+	if err != nil {
+		panic(err)
+	}
+	spent := k.GetSponsorUsage(ctx, crypto.MustAddressFromBytes(contract), user).Spent
+	remaining := sdk.NewCoins()
+	for _, coin := range sponsorship.DailyCap {
+		if amount := coin.Amount.Sub(spent.AmountOf(coin.Denom)); amount.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return remaining
+}
+
+// GetTxSponsorship returns the sponsorship paying the fee of a transaction, if any. The transaction
+// is sponsored if its messages are calls or multi-calls of the fee payer into the same contract,
+// whose sponsorship allows the functions of the calls and covers the fee within the daily cap of
+// the fee payer and the spendable balance of the sponsor. The reads are not charged, so that the
+// transactions of chains without sponsorships replay identically.
+func (k Keeper) GetTxSponsorship(ctx sdk.Context, msgs []sdk.Msg, payer sdk.AccAddress, fee sdk.Coins) (types.Sponsorship, bool) {
+	calls, ok := payerCalls(msgs, payer)
+	if fee.IsZero() || !ok {
+		return types.Sponsorship{}, false
+	}
+	callee := calls[0].Callee
+	for _, call := range calls {
+		if call.Callee != callee {
+			return types.Sponsorship{}, false
+		}
+	}
+	contract, err := sdk.AccAddressFromBech32(callee)
+	if err != nil {
+		return types.Sponsorship{}, false
+	}
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	sponsorship, ok := k.GetSponsorship(ctx, crypto.MustAddressFromBytes(contract))
+	if !ok || sponsorship.IsExpired(ctx.BlockTime()) {
+		return types.Sponsorship{}, false
+	}
+	for _, call := range calls {
+		if !sponsorship.Allows(TaskFunction(call.Data)) {
+			return types.Sponsorship{}, false
+		}
+	}
+	if !fee.IsAllLTE(k.SponsorRemaining(ctx, sponsorship, payer)) {
+		return types.Sponsorship{}, false
+	}
+	sponsor, err := sdk.AccAddressFromBech32(sponsorship.Sponsor)
+	if err != nil || k.ak.GetAccount(ctx, sponsor) == nil || !fee.IsAllLTE(k.bk.SpendableCoins(ctx, sponsor)) {
+		return types.Sponsorship{}, false
+	}
+	return sponsorship, true
+}
+
+// payerCalls returns the calls of the messages, if they are all calls or multi-calls of the payer
+// with at least one call.
+func payerCalls(msgs []sdk.Msg, payer sdk.AccAddress) ([]types.MultiCallEntry, bool) {
+	var calls []types.MultiCallEntry
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *types.MsgCall:
+			if msg.Caller != payer.String() {
+				return nil, false
+			}
+			calls = append(calls, types.MultiCallEntry{Callee: msg.Callee, Value: msg.Value, Data: msg.Data})
+		case *types.MsgMultiCall:
+			if msg.Caller != payer.String() {
+				return nil, false
+			}
+			calls = append(calls, msg.Calls...)
+		default:
+			return nil, false
+		}
+	}
+	return calls, len(calls) > 0
+}
+
+// UseSponsorship records the fee a sponsorship paid for the calls of a user. Deducting the fee from
+// the sponsor is left to the callers.
+func (k Keeper) UseSponsorship(ctx sdk.Context, sponsorship types.Sponsorship, user sdk.AccAddress, fee sdk.Coins) {
+	contract, err := sdk.AccAddressFromBech32(sponsorship.Contract)
+	if err != nil {
+		panic(err)
+	}
+	address := crypto.MustAddressFromBytes(contract)
+	usage := k.GetSponsorUsage(ctx, address, user)
+	usage.Spent = usage.Spent.Add(fee...)
+	k.SetSponsorUsage(ctx, address, user, usage)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSponsor,
+			sdk.NewAttribute(types.AttributeKeyContract, sponsorship.Contract),
+			sdk.NewAttribute(types.AttributeKeySponsor, sponsorship.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyUser, user.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+}
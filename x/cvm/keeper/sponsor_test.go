@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/hyperledger/burrow/crypto"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/cvm/keeper"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+func TestSponsorship(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(80000*1e6))
+	admin, sponsor, user, other := addrs[0], addrs[1], addrs[2], addrs[3]
+	msgServer := keeper.NewMsgServerImpl(app.CVMKeeper)
+	querier := keeper.Querier{Keeper: app.CVMKeeper}
+	denom := app.StakingKeeper.BondDenom(ctx)
+	dailyCap := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+
+	contract := deployRuntime(t, ctx, app.CVMKeeper, admin, bc.MustSplice(STOP))
+	address := crypto.MustAddressFromBytes(contract)
+	app.CVMKeeper.SetAdmin(ctx, address, admin)
+
+	set := func(sender sdk.AccAddress) error {
+		msg := types.NewMsgSetSponsorship(sender.String(), contract.String(), sponsor.String(), dailyCap,
+			[]string{"0xA9059CBB"}, time.Time{})
+		_, err := msgServer.SetSponsorship(sdk.WrapSDKContext(ctx), &msg)
+		return err
+	}
+	require.Equal(t, []sdk.AccAddress{admin, sponsor}, types.NewMsgSetSponsorship(admin.String(), contract.String(),
+		sponsor.String(), dailyCap, nil, time.Time{}).GetSigners())
+	require.True(t, types.ErrUnauthorized.Is(set(sponsor)))
+	require.NoError(t, set(admin))
+
+	res, err := querier.Sponsorship(sdk.WrapSDKContext(ctx), &types.QuerySponsorshipRequest{Contract: contract.String()})
+	require.NoError(t, err)
+	require.Equal(t, sponsor.String(), res.Sponsorship.Sponsor)
+	require.Equal(t, []string{"0xa9059cbb"}, res.Sponsorship.Selectors)
+	require.Equal(t, app.BankKeeper.GetAllBalances(ctx, sponsor), res.Balance)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(denom, 300))
+	sponsorship, ok := app.CVMKeeper.GetSponsorship(ctx, address)
+	require.True(t, ok)
+	app.CVMKeeper.UseSponsorship(ctx, sponsorship, user, fee)
+	usage, err := querier.SponsorUsage(sdk.WrapSDKContext(ctx), &types.QuerySponsorUsageRequest{
+		Contract: contract.String(),
+		User:     user.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, fee, usage.Usage.Spent)
+	require.Equal(t, dailyCap.Sub(fee), usage.Remaining)
+	usages, err := querier.SponsorUsages(sdk.WrapSDKContext(ctx), &types.QuerySponsorUsagesRequest{Contract: contract.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.SponsorUsage{usage.Usage}, usages.Usages)
+
+	revoke := func(sender sdk.AccAddress) error {
+		msg := types.NewMsgRevokeSponsorship(sender.String(), contract.String())
+		_, err := msgServer.RevokeSponsorship(sdk.WrapSDKContext(ctx), &msg)
+		return err
+	}
+	require.True(t, sdkerrors.ErrUnauthorized.Is(revoke(other)))
+	for _, sender := range []sdk.AccAddress{admin, sponsor} {
+		cacheCtx, _ := ctx.CacheContext()
+		msg := types.NewMsgRevokeSponsorship(sender.String(), contract.String())
+		_, err := msgServer.RevokeSponsorship(sdk.WrapSDKContext(cacheCtx), &msg)
+		require.NoError(t, err)
+		_, ok := app.CVMKeeper.GetSponsorship(cacheCtx, address)
+		require.False(t, ok)
+		require.Empty(t, app.CVMKeeper.GetAllSponsorUsages(cacheCtx))
+	}
+	require.NoError(t, revoke(sponsor))
+	require.True(t, types.ErrNotSponsored.Is(revoke(sponsor)))
+}
+
+func TestMsgSetSponsorshipValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(crypto.Address{1}.Bytes()).String()
+	dailyCap := sdk.NewCoins(sdk.NewInt64Coin("uctk", 1000))
+	tests := []struct {
+		name     string
+		msg      types.MsgSetSponsorship
+		expected bool
+	}{
+		{"valid", types.NewMsgSetSponsorship(addr, addr, addr, dailyCap, []string{"0xa9059cbb"}, time.Time{}), true},
+		{"all functions", types.NewMsgSetSponsorship(addr, addr, addr, dailyCap, nil, time.Time{}), true},
+		{"invalid sponsor", types.NewMsgSetSponsorship(addr, addr, "", dailyCap, nil, time.Time{}), false},
+		{"no daily cap", types.NewMsgSetSponsorship(addr, addr, addr, nil, nil, time.Time{}), false},
+		{"unprefixed selector", types.NewMsgSetSponsorship(addr, addr, addr, dailyCap, []string{"a9059cbb"}, time.Time{}), false},
+		{"short selector", types.NewMsgSetSponsorship(addr, addr, addr, dailyCap, []string{"0xa9059c"}, time.Time{}), false},
+	}
+	for _, tc := range tests {
+		err := tc.msg.ValidateBasic()
+		require.Equal(t, tc.expected, err == nil, tc.name)
+	}
+}
@@ -24,6 +24,10 @@ type State struct {
 	store       sdk.KVStore
 	cdc         codec.BinaryMarshaler
 	legacyAmino *codec.LegacyAmino
+
+	// The addresses of the accounts read by the CVM, in the order they were first read
+	loaded    []crypto.Address
+	loadedSet map[crypto.Address]bool
 }
 
 // NewState returns a new instance of State type data.
@@ -41,6 +45,13 @@ func (k Keeper) NewState(ctx sdk.Context) *State {
 // GetAccount gets an account by its address and returns nil if it does not
 // exist (which should not be an error).
 func (s *State) GetAccount(address crypto.Address) (*acm.Account, error) {
+	if !s.loadedSet[address] {
+		if s.loadedSet == nil {
+			s.loadedSet = make(map[crypto.Address]bool)
+		}
+		s.loadedSet[address] = true
+		s.loaded = append(s.loaded, address)
+	}
 	addr := sdk.AccAddress(address.Bytes())
 	account := s.ak.GetAccount(s.ctx, addr)
 	if account == nil {
@@ -80,6 +91,12 @@ func (s *State) GetAccount(address crypto.Address) (*acm.Account, error) {
 	return &acc, nil
 }
 
+// LoadedAccounts returns the addresses of the accounts read by the CVM, whose balances the CVM may
+// write back when its state is synced.
+func (s *State) LoadedAccounts() []crypto.Address {
+	return s.loaded
+}
+
 // UpdateAccount updates the fields of updatedAccount by address, creating the
 // account if it does not exist.
 func (s *State) UpdateAccount(updatedAccount *acm.Account) error {
@@ -98,7 +115,8 @@ func (s *State) UpdateAccount(updatedAccount *acm.Account) error {
 		cvmCode = types.NewCVMCode(types.CVMCodeTypeEVMCode, updatedAccount.EVMCode)
 	}
 	s.store.Set(types.CodeStoreKey(updatedAccount.Address), s.cdc.MustMarshalBinaryBare(&cvmCode))
-	err := s.bk.SetBalances(s.ctx, address, sdk.Coins{sdk.NewInt64Coin(s.sk.BondDenom(s.ctx), int64(updatedAccount.Balance))})
+	// Only the bond denom is tracked by the CVM, balances in other denoms are left untouched.
+	err := s.bk.SetBalance(s.ctx, address, sdk.NewInt64Coin(s.sk.BondDenom(s.ctx), int64(updatedAccount.Balance)))
 	if err != nil {
 		return err
 	}
@@ -115,6 +133,8 @@ func (s *State) RemoveAccount(address crypto.Address) error {
 	s.store.Delete(types.CodeStoreKey(address))
 	s.store.Delete(types.AbiStoreKey(address))
 	s.store.Delete(types.AddressMetaStoreKey(address))
+	s.store.Delete(types.AdminStoreKey(address))
+	s.store.Delete(types.DeployerStoreKey(address))
 	return nil
 }
 
@@ -77,6 +77,18 @@ func TestState_UpdateAccount(t *testing.T) {
 	accAddressHex, err = sdk.AccAddressFromHex(acc.Address.String())
 	sdkCoins = app.BankKeeper.GetAllBalances(ctx, accAddressHex.Bytes()).AmountOf("uctk").Uint64()
 	require.Equal(t, sdkCoins, acc.Balance)
+
+	err = app.BankKeeper.SetBalances(ctx, addrs[1], sdk.Coins{sdk.NewInt64Coin("uabc", 42), sdk.NewInt64Coin(bondDenom, 1234)})
+	require.Nil(t, err)
+	addr, err = crypto.AddressFromBytes(addrs[1].Bytes())
+	require.Nil(t, err)
+	acc, err = state.GetAccount(addr)
+	require.Nil(t, err)
+	acc.Balance = 100
+	err = state.UpdateAccount(acc)
+	require.Nil(t, err)
+	require.Equal(t, int64(100), app.BankKeeper.GetBalance(ctx, addrs[1], bondDenom).Amount.Int64())
+	require.Equal(t, int64(42), app.BankKeeper.GetBalance(ctx, addrs[1], "uabc").Amount.Int64())
 }
 
 func TestState_RemoveAccount(t *testing.T) {
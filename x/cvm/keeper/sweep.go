@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyperledger/burrow/crypto"
+
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// ZeroAddress returns the account address of the CVM zero address, to which contracts burn coins.
+func ZeroAddress() sdk.AccAddress {
+	return crypto.ZeroAddress.Bytes()
+}
+
+// SweepZeroAddress sends all the spendable coins held by the zero address to the community pool,
+// and adds them to the swept funds.
+func (k Keeper) SweepZeroAddress(ctx sdk.Context) {
+	coins := k.bk.SpendableCoins(ctx, ZeroAddress())
+	if coins.IsZero() {
+		return
+	}
+	if err := k.dk.FundCommunityPool(ctx, coins, ZeroAddress()); err != nil {
+		panic(err)
+	}
+	k.SetSweptFunds(ctx, k.GetSweptFunds(ctx).Add(coins...))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSweep,
+			sdk.NewAttribute(sdk.AttributeKeySender, ZeroAddress().String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	)
+}
+
+// SetSweptFunds sets the total coins swept from the zero address.
+func (k Keeper) SetSweptFunds(ctx sdk.Context, coins sdk.Coins) {
+	ctx.KVStore(k.key).Set(types.SweptFundsKey, k.cdc.MustMarshalBinaryBare(&types.CoinsProto{Coins: coins}))
+}
+
+// GetSweptFunds returns the total coins swept from the zero address.
+func (k Keeper) GetSweptFunds(ctx sdk.Context) sdk.Coins {
+	bz := ctx.KVStore(k.key).Get(types.SweptFundsKey)
+	if bz == nil {
+		return sdk.Coins{}
+	}
+	var coins types.CoinsProto
+	k.cdc.MustUnmarshalBinaryBare(bz, &coins)
+	return coins.Coins
+}
@@ -315,6 +315,16 @@ var (
 	TestCheckBytecodeString = "60806040526040518060600160405280602d8152602001610812602d91396000908051906020019061003292919061014d565b506040518060600160405280602d815260200161086c602d91396001908051906020019061006192919061014d565b506040518060600160405280602d815260200161083f602d91396002908051906020019061009092919061014d565b506040518060600160405280602d8152602001610812602d9139600390805190602001906100bf92919061014d565b506040518060600160405280602d815260200161086c602d9139600490805190602001906100ee92919061014d565b506040518060400160405280601381526020017f64756d6d79736f75726365636f646568617368000000000000000000000000008152506005908051906020019061013a92919061014d565b5034801561014757600080fd5b50610251565b828054610159906101f0565b90600052602060002090601f01602090048101928261017b57600085556101c2565b82601f1061019457805160ff19168380011785556101c2565b828001600101855582156101c2579182015b828111156101c15782518255916020019190600101906101a6565b5b5090506101cf91906101d3565b5090565b5b808211156101ec5760008160009055506001016101d4565b5090565b6000600282049050600182168061020857607f821691505b6020821081141561021c5761021b610222565b5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b6105b2806102606000396000f3fe608060405234801561001057600080fd5b50600436106100575760003560e01c80630bfe74e81461005c5780634a6abcda1461007a5780638504647c146100985780639ca131af146100b6578063de78f9fc146100d4575b600080fd5b6100646100f2565b6040516100719190610499565b60405180910390f35b6100826101c1565b60405161008f9190610499565b60405180910390f35b6100a0610269565b6040516100ad9190610499565b60405180910390f35b6100be610310565b6040516100cb9190610499565b60405180910390f35b6100dc6103b8565b6040516100e99190610499565b60405180910390f35b60606000600480546101039061050a565b80601f016020809104026020016040519081016040528092919081815260200182805461012f9061050a565b801561017c5780601f106101515761010080835404028352916020019161017c565b820191906000526020600020905b81548152906001019060200180831161015f57829003601f168201915b50505050509050600181516001828260208601606561c350fa6001838360208701606661c350fa60018214156101bb5760018114156101ba57600180f35b5b60016000f35b60606000600180546101d29061050a565b80601f01602080910402602001604051908101604052809291908181526020018280546101fe9061050a565b801561024b5780601f106102205761010080835404028352916020019161024b565b820191906000526020600020905b81548152906001019060200180831161022e57829003601f168201915b50505050509050600181516001828260208601606561c350fa600183f35b606060008080546102799061050a565b80601f01602080910402602001604051908101604052809291908181526020018280546102a59061050a565b80156102f25780601f106102c7576101008083540402835291602001916102f2565b820191906000526020600020905b8154815290600101906020018083116102d557829003601f168201915b50505050509050600181516001828260208601606561c350fa600183f35b60606000600280546103219061050a565b80601f016020809104026020016040519081016040528092919081815260200182805461034d9061050a565b801561039a5780601f1061036f5761010080835404028352916020019161039a565b820191906000526020600020905b81548152906001019060200180831161037d57829003601f168201915b50505050509050600181516001828260208601606661c350fa600183f35b60606000600580546103c99061050a565b80601f01602080910402602001604051908101604052809291908181526020018280546103f59061050a565b80156104425780601f1061041757610100808354040283529160200191610442565b820191906000526020600020905b81548152906001019060200180831161042557829003601f168201915b50505050509050600181516001828260208601606761c350fa600183f35b600061046b826104bb565b61047581856104c6565b93506104858185602086016104d7565b61048e8161056b565b840191505092915050565b600060208201905081810360008301526104b38184610460565b905092915050565b600081519050919050565b600082825260208201905092915050565b60005b838110156104f55780820151818401526020810190506104da565b83811115610504576000848401525b50505050565b6000600282049050600182168061052257607f821691505b602082108114156105365761053561053c565b5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b6000601f19601f830116905091905056fea2646970667358221220169b18dba603a9571f2f7778926c03823b3affd9b07cb7f74781bc87379ab4c864736f6c6343000801003363657274696b3175647a7032337477663461367066343765723236756a67637a7a683374637374376335617a65636f736d6f7331723630686a327861786e373971746834706b6a6d397432376c3938357866736d6e7a39706177636f736d6f733178786b75656b6c616c3976656a7639756e717538307739767074796570666139357064353375"
 	TestCheckAbiJsonString  = `[{"inputs":[],"name":"callCheck","outputs":[{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"callCheckNotCertified","outputs":[{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"compilationCheck","outputs":[{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"proofAndAuditingCheck","outputs":[{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"proofCheck","outputs":[{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"nonpayable","type":"function"}]`
 
-	TestCertifyValidatorString        = "60806040526040518060800160405280605381526020016103a16053913960009080519060200190610032929190610045565b5034801561003f57600080fd5b50610149565b828054610051906100e8565b90600052602060002090601f01602090048101928261007357600085556100ba565b82601f1061008c57805160ff19168380011785556100ba565b828001600101855582156100ba579182015b828111156100b957825182559160200191906001019061009e565b5b5090506100c791906100cb565b5090565b5b808211156100e45760008160009055506001016100cc565b5090565b6000600282049050600182168061010057607f821691505b602082108114156101145761011361011a565b5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b610249806101586000396000f3fe608060405234801561001057600080fd5b506004361061002b5760003560e01c80633c1bf57b14610030575b600080fd5b61003861004e565b6040516100459190610130565b60405180910390f35b6060600080805461005e906101a1565b80601f016020809104026020016040519081016040528092919081815260200182805461008a906101a1565b80156100d75780601f106100ac576101008083540402835291602001916100d7565b820191906000526020600020905b8154815290600101906020018083116100ba57829003601f168201915b505050505090506001815160018282602086016000606861c350f1600183f35b600061010282610152565b61010c818561015d565b935061011c81856020860161016e565b61012581610202565b840191505092915050565b6000602082019050818103600083015261014a81846100f7565b905092915050565b600081519050919050565b600082825260208201905092915050565b60005b8381101561018c578082015181840152602081019050610171565b8381111561019b576000848401525b50505050565b600060028204905060018216806101b957607f821691505b602082108114156101cd576101cc6101d3565b5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b6000601f19601f830116905091905056fea26469706673582212206432f04b3863a71e348225305d55be91def584a696225e327741a5583432d26764736f6c63430008010033636f736d6f7376616c636f6e73707562317a636a647565707178687936383635686639306c776d636b6a756567666476716d797a6e6864366134646b6a72393070713061383266787867327171637066716174"
-	TestCertifyValidatorAbiJsonString = `[{"inputs":[],"name":"certifyValidator","outputs":[{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"nonpayable","type":"function"}]`
+	TestCertifyValidatorString          = "60806040526040518060800160405280605381526020016103a16053913960009080519060200190610032929190610045565b5034801561003f57600080fd5b50610149565b828054610051906100e8565b90600052602060002090601f01602090048101928261007357600085556100ba565b82601f1061008c57805160ff19168380011785556100ba565b828001600101855582156100ba579182015b828111156100b957825182559160200191906001019061009e565b5b5090506100c791906100cb565b5090565b5b808211156100e45760008160009055506001016100cc565b5090565b6000600282049050600182168061010057607f821691505b602082108114156101145761011361011a565b5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b610249806101586000396000f3fe608060405234801561001057600080fd5b506004361061002b5760003560e01c80633c1bf57b14610030575b600080fd5b61003861004e565b6040516100459190610130565b60405180910390f35b6060600080805461005e906101a1565b80601f016020809104026020016040519081016040528092919081815260200182805461008a906101a1565b80156100d75780601f106100ac576101008083540402835291602001916100d7565b820191906000526020600020905b8154815290600101906020018083116100ba57829003601f168201915b505050505090506001815160018282602086016000606861c350f1600183f35b600061010282610152565b61010c818561015d565b935061011c81856020860161016e565b61012581610202565b840191505092915050565b6000602082019050818103600083015261014a81846100f7565b905092915050565b600081519050919050565b600082825260208201905092915050565b60005b8381101561018c578082015181840152602081019050610171565b8381111561019b576000848401525b50505050565b600060028204905060018216806101b957607f821691505b602082108114156101cd576101cc6101d3565b5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b6000601f19601f830116905091905056fea26469706673582212206432f04b3863a71e348225305d55be91def584a696225e327741a5583432d26764736f6c63430008010033636f736d6f7376616c636f6e73707562317a636a647565707178687936383635686639306c776d636b6a756567666476716d797a6e6864366134646b6a72393070713061383266787867327171637066716174"
+	TestCertifyValidatorAbiJsonString   = `[{"inputs":[],"name":"certifyValidator","outputs":[{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"nonpayable","type":"function"}]`
+	TestCheckOracleBytecodeString       = "602780600b6000396000f3600436038060046000376000600082600060685afa3d600060003e6022573d6000fd5b3d6000f3"
+	TestCheckOracleAbiJsonString        = `[{"inputs":[{"internalType":"string","name":"contract","type":"string"},{"internalType":"string","name":"function","type":"string"}],"name":"getScore","outputs":[{"internalType":"int64","name":"score","type":"int64"},{"internalType":"uint64","name":"status","type":"uint64"},{"internalType":"int64","name":"closingBlock","type":"int64"},{"internalType":"bool","name":"exists","type":"bool"}],"stateMutability":"view","type":"function"}]`
+	TestCheckShieldBytecodeString       = "602780600b6000396000f3600436038060046000376000600082600060695afa3d600060003e6022573d6000fd5b3d6000f3"
+	TestCheckShieldAbiJsonString        = `[{"inputs":[{"internalType":"uint64","name":"poolID","type":"uint64"},{"internalType":"address","name":"purchaser","type":"address"}],"name":"getPurchase","outputs":[{"internalType":"uint64","name":"shield","type":"uint64"},{"internalType":"int64","name":"protectionEndTime","type":"int64"},{"internalType":"bool","name":"active","type":"bool"}],"stateMutability":"view","type":"function"}]`
+	TestCheckBankBalanceBytecodeString  = "602780600b6000396000f36004360380600460003760006000826000606a5afa3d600060003e6022573d6000fd5b3d6000f3"
+	TestCheckBankBalanceAbiJsonString   = `[{"inputs":[{"internalType":"address","name":"account","type":"address"},{"internalType":"string","name":"denom","type":"string"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"balance","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+	TestCheckBankTransferBytecodeString = "602980600b6000396000f360043603806004600037600060008260006000606b5af13d600060003e6024573d6000fd5b3d6000f3"
+	TestCheckBankTransferAbiJsonString  = `[{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"string","name":"denom","type":"string"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"transfer","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+	TestCheckStakingBytecodeString      = "607680600b6000396000f360003560e01c806316b347d4146033578063e241b3aa14603957806348ad568214603f578063fcdf9c06146045576070604b565b606c604b565b606d604b565b606e604b565b606f604b565b905060043603806004600037600060008260006000865af13d600060003e6071573d6000fd5b3d6000f3"
+	TestCheckStakingAbiJsonString       = `[{"inputs":[{"internalType":"string","name":"validator","type":"string"},{"internalType":"uint64","name":"amount","type":"uint64"}],"name":"delegate","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"string","name":"validator","type":"string"},{"internalType":"uint64","name":"amount","type":"uint64"}],"name":"undelegate","outputs":[{"internalType":"int64","name":"completionTime","type":"int64"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"string","name":"srcValidator","type":"string"},{"internalType":"string","name":"dstValidator","type":"string"},{"internalType":"uint64","name":"amount","type":"uint64"}],"name":"redelegate","outputs":[{"internalType":"int64","name":"completionTime","type":"int64"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"string","name":"validator","type":"string"}],"name":"withdrawRewards","outputs":[{"internalType":"uint64","name":"amount","type":"uint64"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"delegator","type":"address"},{"internalType":"string","name":"validator","type":"string"}],"name":"delegation","outputs":[{"internalType":"uint64","name":"balance","type":"uint64"},{"internalType":"bool","name":"exists","type":"bool"}],"stateMutability":"view","type":"function"}]`
 )
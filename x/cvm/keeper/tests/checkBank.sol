@@ -0,0 +1,39 @@
+pragma solidity >0.7.0;
+
+// CheckBankBalance forwards its call data, without the function selector, to
+// the bank balance precompile at 0x6a and returns the precompile output as is.
+// Call it with the ABI of
+//   balanceOf(address account, string denom) returns (uint256 balance)
+contract CheckBankBalance {
+    fallback() external {
+        assembly {
+            let len := sub(calldatasize(), 4)
+            calldatacopy(0, 4, len)
+            let success := staticcall(gas(), 0x6a, 0, len, 0, 0)
+            returndatacopy(0, 0, returndatasize())
+            if iszero(success) {
+                revert(0, returndatasize())
+            }
+            return(0, returndatasize())
+        }
+    }
+}
+
+// CheckBankTransfer forwards its call data, without the function selector, to
+// the bank transfer precompile at 0x6b, spending the coins held by this contract.
+// Call it with the ABI of
+//   transfer(address to, string denom, uint256 amount)
+contract CheckBankTransfer {
+    fallback() external payable {
+        assembly {
+            let len := sub(calldatasize(), 4)
+            calldatacopy(0, 4, len)
+            let success := call(gas(), 0x6b, 0, 0, len, 0, 0)
+            returndatacopy(0, 0, returndatasize())
+            if iszero(success) {
+                revert(0, returndatasize())
+            }
+            return(0, returndatasize())
+        }
+    }
+}
@@ -0,0 +1,20 @@
+pragma solidity >0.7.0;
+
+// CheckOracle forwards its call data, without the function selector, to the
+// oracle score precompile at 0x68 and returns the precompile output as is.
+// Call it with the ABI of
+//   getScore(string contract, string function) returns (int64 score, uint64 status, int64 closingBlock, bool exists)
+contract CheckOracle {
+    fallback() external {
+        assembly {
+            let len := sub(calldatasize(), 4)
+            calldatacopy(0, 4, len)
+            let success := staticcall(gas(), 0x68, 0, len, 0, 0)
+            returndatacopy(0, 0, returndatasize())
+            if iszero(success) {
+                revert(0, returndatasize())
+            }
+            return(0, returndatasize())
+        }
+    }
+}
@@ -0,0 +1,32 @@
+pragma solidity >0.7.0;
+
+// CheckStaking forwards its call data, without the function selector, to the
+// staking precompile matching the selector and returns the precompile output
+// as is. Delegations are made on behalf of this contract. Call it with the ABI of
+//   delegate(string validator, uint64 amount)                                   -> 0x6c
+//   undelegate(string validator, uint64 amount) returns (int64 completionTime)  -> 0x6d
+//   redelegate(string srcValidator, string dstValidator, uint64 amount)
+//       returns (int64 completionTime)                                          -> 0x6e
+//   withdrawRewards(string validator) returns (uint64 amount)                   -> 0x6f
+//   delegation(address delegator, string validator)
+//       returns (uint64 balance, bool exists)                                   -> 0x70
+contract CheckStaking {
+    fallback() external payable {
+        assembly {
+            let target := 0x70
+            switch shr(224, calldataload(0))
+            case 0x16b347d4 { target := 0x6c }
+            case 0xe241b3aa { target := 0x6d }
+            case 0x48ad5682 { target := 0x6e }
+            case 0xfcdf9c06 { target := 0x6f }
+            let len := sub(calldatasize(), 4)
+            calldatacopy(0, 4, len)
+            let success := call(gas(), target, 0, 0, len, 0, 0)
+            returndatacopy(0, 0, returndatasize())
+            if iszero(success) {
+                revert(0, returndatasize())
+            }
+            return(0, returndatasize())
+        }
+    }
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/hyperledger/burrow/crypto"
+
+	certtypes "github.com/certikfoundation/shentu/x/cert/types"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+// SetDeployer sets the account that deployed a contract with a deploy message.
+func (k Keeper) SetDeployer(ctx sdk.Context, address crypto.Address, deployer sdk.AccAddress) {
+	ctx.KVStore(k.key).Set(types.DeployerStoreKey(address), deployer)
+}
+
+// GetDeployer returns the deployer of a contract, or nil if the contract was not deployed with a
+// deploy message.
+func (k Keeper) GetDeployer(ctx sdk.Context, address crypto.Address) sdk.AccAddress {
+	return ctx.KVStore(k.key).Get(types.DeployerStoreKey(address))
+}
+
+// SetVerifiedContract sets the verified source code of a contract.
+func (k Keeper) SetVerifiedContract(ctx sdk.Context, address crypto.Address, verified types.VerifiedContract) {
+	ctx.KVStore(k.key).Set(types.VerifiedContractStoreKey(address), k.cdc.MustMarshalBinaryBare(&verified))
+}
+
+// GetVerifiedContract returns the verified source code of a contract.
+func (k Keeper) GetVerifiedContract(ctx sdk.Context, address crypto.Address) (types.VerifiedContract, bool) {
+	bz := ctx.KVStore(k.key).Get(types.VerifiedContractStoreKey(address))
+	if bz == nil {
+		return types.VerifiedContract{}, false
+	}
+	var verified types.VerifiedContract
+	k.cdc.MustUnmarshalBinaryBare(bz, &verified)
+	return verified, true
+}
+
+// DeleteVerifiedContract deletes the verified source code of a contract.
+func (k Keeper) DeleteVerifiedContract(ctx sdk.Context, address crypto.Address) {
+	ctx.KVStore(k.key).Delete(types.VerifiedContractStoreKey(address))
+}
+
+// GetAllVerifiedContracts returns the verified source code of all contracts.
+func (k Keeper) GetAllVerifiedContracts(ctx sdk.Context) []types.VerifiedContract {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.VerifiedContractStoreKeyPrefix)
+	defer iterator.Close()
+
+	verifiedContracts := []types.VerifiedContract{}
+	for ; iterator.Valid(); iterator.Next() {
+		var verified types.VerifiedContract
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &verified)
+		verifiedContracts = append(verifiedContracts, verified)
+	}
+	return verifiedContracts
+}
+
+// VerifyContract binds source code to the code of a contract. The compilation certificate must
+// certify the code of the contract compiled by the given compiler version, and the sender must be
+// the deployer or the admin of the contract, or a certifier. Verifying a verified contract replaces its source code.
+func (k Keeper) VerifyContract(ctx sdk.Context, msg *types.MsgVerifyContract) error {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return err
+	}
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return err
+	}
+	address := crypto.MustAddressFromBytes(contract)
+	account, err := k.NewState(ctx).GetAccount(address)
+	if err != nil {
+		return err
+	}
+	if account == nil || (len(account.EVMCode) == 0 && len(account.WASMCode) == 0) {
+		return sdkerrors.Wrapf(types.ErrNotContract, "%s", contract)
+	}
+	if !sender.Equals(k.GetDeployer(ctx, address)) && !sender.Equals(k.GetAdmin(ctx, address)) &&
+		!k.ck.IsCertifier(ctx, sender) {
+		return types.ErrUnauthorizedVerifier
+	}
+
+	code := account.EVMCode
+	if len(account.WASMCode) > 0 {
+		code = account.WASMCode
+	}
+	codeHash := types.CodeHash(code)
+
+	certificate, err := k.ck.GetCertificateByID(ctx, msg.CertificateId)
+	if err != nil {
+		return err
+	}
+	if certtypes.TranslateCertificateType(certificate) != certtypes.CertificateTypeCompilation {
+		return sdkerrors.Wrapf(types.ErrCertificateMismatch, "certificate %d is not a compilation certificate", msg.CertificateId)
+	}
+	compilation := certificate.CompilationContent
+	if compilation == nil || !types.MatchCodeHash(compilation.BytecodeHash, codeHash) {
+		return sdkerrors.Wrapf(types.ErrCertificateMismatch, "certificate %d does not certify code hash %s", msg.CertificateId, codeHash)
+	}
+	if compilation.Compiler != msg.CompilerVersion {
+		return sdkerrors.Wrapf(types.ErrCertificateMismatch, "certificate %d certifies compiler %s", msg.CertificateId, compilation.Compiler)
+	}
+
+	k.SetVerifiedContract(ctx, address, types.VerifiedContract{
+		Address:          contract.String(),
+		SourceFiles:      msg.SourceFiles,
+		CompilerVersion:  msg.CompilerVersion,
+		CompilerSettings: msg.CompilerSettings,
+		CodeHash:         codeHash,
+		CertificateId:    msg.CertificateId,
+		Submitter:        msg.Sender,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVerifyContract,
+			sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
+			sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash),
+			sdk.NewAttribute(types.AttributeKeyCertificateID, strconv.FormatUint(msg.CertificateId, 10)),
+			sdk.NewAttribute(types.AttributeKeySubmitter, msg.Sender),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/hyperledger/burrow/crypto"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/simapp"
+	certtypes "github.com/certikfoundation/shentu/x/cert/types"
+	"github.com/certikfoundation/shentu/x/cvm/keeper"
+	"github.com/certikfoundation/shentu/x/cvm/types"
+)
+
+func TestVerifyContract(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(80000*1e6))
+	admin, certifier, other := addrs[0], addrs[1], addrs[2]
+	msgServer := keeper.NewMsgServerImpl(app.CVMKeeper)
+	querier := keeper.Querier{Keeper: app.CVMKeeper}
+
+	runtime := bc.MustSplice(PUSH1, 7, PUSH1, 0, SSTORE, STOP)
+	code := bc.MustSplice(PUSH1, len(runtime), PUSH1, 12, PUSH1, 0, CODECOPY, PUSH1, len(runtime), PUSH1, 0, RETURN, runtime)
+	deploy := types.NewMsgDeploy(admin.String(), 0, code, "", nil, false, false)
+	deploy.Admin = admin.String()
+	deployRes, err := msgServer.Deploy(sdk.WrapSDKContext(ctx), &deploy)
+	require.NoError(t, err)
+	contract := sdk.AccAddress(deployRes.Result)
+	codeHash := types.CodeHash(runtime)
+
+	app.CertKeeper.SetCertifier(ctx, certtypes.Certifier{Address: certifier.String()})
+	issue := func(certType, compiler, bytecodeHash string) uint64 {
+		certificate, err := certtypes.NewCertificate(certType, "source hash", compiler, bytecodeHash, "", certifier)
+		require.NoError(t, err)
+		id, err := app.CertKeeper.IssueCertificate(ctx, certificate)
+		require.NoError(t, err)
+		return id
+	}
+	compilationID := issue("compilation", "0.8.4", "0x"+strings.ToUpper(codeHash))
+	sources := []types.SourceFile{{Name: "A.sol", Content: "contract A {}"}}
+
+	t.Run("the certificate must match the code", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			certificateID uint64
+			compiler      string
+		}{
+			{"other code hash", issue("compilation", "0.8.4", types.CodeHash(code)), "0.8.4"},
+			{"other compiler", compilationID, "0.8.5"},
+			{"not a compilation certificate", issue("auditing", "0.8.4", codeHash), "0.8.4"},
+		}
+		for _, tc := range tests {
+			msg := types.NewMsgVerifyContract(admin.String(), contract.String(), sources, tc.compiler, "", tc.certificateID)
+			_, err := msgServer.VerifyContract(sdk.WrapSDKContext(ctx), &msg)
+			require.ErrorIs(t, err, types.ErrCertificateMismatch, tc.name)
+		}
+
+		msg := types.NewMsgVerifyContract(admin.String(), contract.String(), sources, "0.8.4", "", 1000)
+		_, err := msgServer.VerifyContract(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, certtypes.ErrCertificateNotExists)
+	})
+
+	t.Run("only the deployer, the admin or a certifier can verify", func(t *testing.T) {
+		msg := types.NewMsgVerifyContract(other.String(), contract.String(), sources, "0.8.4", "", compilationID)
+		_, err := msgServer.VerifyContract(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, types.ErrUnauthorizedVerifier)
+
+		// The deployer of a contract without an admin can verify it.
+		ctx, _ := ctx.CacheContext()
+		deploy := types.NewMsgDeploy(other.String(), 0, code, "", nil, false, false)
+		deployRes, err := msgServer.Deploy(sdk.WrapSDKContext(ctx), &deploy)
+		require.NoError(t, err)
+		require.Nil(t, app.CVMKeeper.GetAdmin(ctx, crypto.MustAddressFromBytes(deployRes.Result)))
+		require.Equal(t, other, app.CVMKeeper.GetDeployer(ctx, crypto.MustAddressFromBytes(deployRes.Result)))
+		msg = types.NewMsgVerifyContract(other.String(), sdk.AccAddress(deployRes.Result).String(), sources, "0.8.4", "", compilationID)
+		_, err = msgServer.VerifyContract(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+	})
+
+	t.Run("only contracts can be verified", func(t *testing.T) {
+		msg := types.NewMsgVerifyContract(certifier.String(), other.String(), sources, "0.8.4", "", compilationID)
+		_, err := msgServer.VerifyContract(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, types.ErrNotContract)
+	})
+
+	t.Run("verify and query", func(t *testing.T) {
+		_, err := querier.VerifiedContract(sdk.WrapSDKContext(ctx), &types.QueryVerifiedContractRequest{Address: contract.String()})
+		require.Error(t, err)
+
+		for _, sender := range []sdk.AccAddress{admin, certifier} {
+			msg := types.NewMsgVerifyContract(sender.String(), contract.String(), sources, "0.8.4", `{"optimizer":{"enabled":true}}`, compilationID)
+			_, err := msgServer.VerifyContract(sdk.WrapSDKContext(ctx), &msg)
+			require.NoError(t, err)
+		}
+
+		res, err := querier.VerifiedContract(sdk.WrapSDKContext(ctx), &types.QueryVerifiedContractRequest{Address: contract.String()})
+		require.NoError(t, err)
+		require.Equal(t, types.VerifiedContract{
+			Address:          contract.String(),
+			SourceFiles:      sources,
+			CompilerVersion:  "0.8.4",
+			CompilerSettings: `{"optimizer":{"enabled":true}}`,
+			CodeHash:         codeHash,
+			CertificateId:    compilationID,
+			Submitter:        certifier.String(),
+		}, res.VerifiedContract)
+		require.Equal(t, []types.VerifiedContract{res.VerifiedContract}, app.CVMKeeper.GetAllVerifiedContracts(ctx))
+	})
+
+	t.Run("migration removes the verification", func(t *testing.T) {
+		require.NoError(t, app.CVMKeeper.Migrate(ctx, contract, bc.MustSplice(STOP), "", nil))
+		_, ok := app.CVMKeeper.GetVerifiedContract(ctx, crypto.MustAddressFromBytes(contract))
+		require.False(t, ok)
+	})
+}
+
+func TestMsgVerifyContractValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(crypto.Address{1}.Bytes()).String()
+	sources := []types.SourceFile{{Name: "A.sol", Content: "contract A {}"}}
+	tests := []struct {
+		name     string
+		msg      types.MsgVerifyContract
+		expected bool
+	}{
+		{"valid", types.NewMsgVerifyContract(addr, addr, sources, "0.8.4", `{}`, 1), true},
+		{"invalid contract", types.NewMsgVerifyContract(addr, "", sources, "0.8.4", "", 1), false},
+		{"no source files", types.NewMsgVerifyContract(addr, addr, nil, "0.8.4", "", 1), false},
+		{"duplicate source files", types.NewMsgVerifyContract(addr, addr, append(sources, sources...), "0.8.4", "", 1), false},
+		{"no compiler version", types.NewMsgVerifyContract(addr, addr, sources, "", "", 1), false},
+		{"invalid compiler settings", types.NewMsgVerifyContract(addr, addr, sources, "0.8.4", "{", 1), false},
+	}
+	for _, tc := range tests {
+		err := tc.msg.ValidateBasic()
+		require.Equal(t, tc.expected, err == nil, tc.name)
+	}
+}
@@ -34,6 +34,12 @@ var (
 	_ module.AppModuleSimulation = AppModule{}
 )
 
+// Module init related flags
+const (
+	FlagJSONRPC        = "x-cvm-json-rpc"
+	FlagJSONRPCChainID = "x-cvm-json-rpc-chain-id"
+)
+
 // AppModuleBasic specifies the app module basics object.
 type AppModuleBasic struct {
 	cdc codec.Marshaler
@@ -94,6 +100,12 @@ func (a AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry
 	types.RegisterInterfaces(registry)
 }
 
+// AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagJSONRPC, false, "Serve the Ethereum JSON-RPC gateway of the CVM on the API server")
+	startCmd.Flags().Uint64(FlagJSONRPCChainID, 0, "EIP-155 chain ID reported by the Ethereum JSON-RPC gateway")
+}
+
 // AppModule specifies the app module object.
 type AppModule struct {
 	AppModuleBasic
@@ -161,7 +173,9 @@ func (AppModule) QuerierRoute() string {
 }
 
 // RegisterInvariants registers the module invariants.
-func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 //____________________________________________________________________________
 
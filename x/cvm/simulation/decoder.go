@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -51,6 +52,63 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &metadataB)
 			return fmt.Sprintf("%v\n%v", metadataA, metadataB)
 
+		case bytes.Equal(kvA.Key[:1], types.ReceiptStoreKeyPrefix):
+			var receiptA, receiptB types.Receipt
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &receiptA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &receiptB)
+			return fmt.Sprintf("%v\n%v", receiptA, receiptB)
+
+		case bytes.Equal(kvA.Key[:1], types.ReceiptHeightIndexKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.ReceiptAddressIndexKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.ReceiptTopicIndexKeyPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Key[1:], kvB.Key[1:])
+
+		case bytes.Equal(kvA.Key[:1], types.AdminStoreKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.DeployerStoreKeyPrefix):
+			return fmt.Sprintf("%s\n%s", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.SweptFundsKey):
+			var coinsA, coinsB types.CoinsProto
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &coinsA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &coinsB)
+			return fmt.Sprintf("%v\n%v", coinsA.Coins, coinsB.Coins)
+
+		case bytes.Equal(kvA.Key[:1], types.VerifiedContractStoreKeyPrefix):
+			var verifiedA, verifiedB types.VerifiedContract
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &verifiedA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &verifiedB)
+			return fmt.Sprintf("%v\n%v", verifiedA, verifiedB)
+
+		case bytes.Equal(kvA.Key[:1], types.FrozenContractStoreKeyPrefix):
+			var frozenA, frozenB types.FrozenContract
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &frozenA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &frozenB)
+			return fmt.Sprintf("%v\n%v", frozenA, frozenB)
+
+		case bytes.Equal(kvA.Key[:1], types.SponsorshipStoreKeyPrefix):
+			var sponsorshipA, sponsorshipB types.Sponsorship
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &sponsorshipA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &sponsorshipB)
+			return fmt.Sprintf("%v\n%v", sponsorshipA, sponsorshipB)
+
+		case bytes.Equal(kvA.Key[:1], types.SponsorUsageStoreKeyPrefix):
+			var usageA, usageB types.SponsorUsage
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &usageA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &usageB)
+			return fmt.Sprintf("%v\n%v", usageA, usageB)
+
+		case bytes.Equal(kvA.Key[:1], types.ScheduledCallStoreKeyPrefix):
+			var callA, callB types.ScheduledCall
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &callA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &callB)
+			return fmt.Sprintf("%v\n%v", callA, callB)
+
+		case bytes.Equal(kvA.Key[:1], types.ScheduledCallQueueKeyPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Key[1:], kvB.Key[1:])
+
+		case bytes.Equal(kvA.Key[:1], types.NextScheduledCallIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
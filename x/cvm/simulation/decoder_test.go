@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/hyperledger/burrow/acm"
@@ -77,6 +78,48 @@ func TestDecodeStore(t *testing.T) {
 		},
 	}
 
+	receipt := types.Receipt{
+		TxHash:     bytes2,
+		MsgIndex:   1,
+		Height:     int64(height),
+		Status:     types.ReceiptStatusSuccessful,
+		ReturnData: value1,
+		GasUsed:    gasRate,
+	}
+	receiptIndexKey := types.ReceiptHeightIndexKey(int64(height), bytes2, 1)
+
+	sweptFunds := sdk.NewCoins(sdk.NewInt64Coin("uctk", 100))
+	verified := types.VerifiedContract{
+		Address:         sdk.AccAddress(address.Bytes()).String(),
+		SourceFiles:     []types.SourceFile{{Name: "a.sol", Content: "contract A {}"}},
+		CompilerVersion: "0.8.4",
+		CodeHash:        types.CodeHash(value3),
+		CertificateId:   1,
+		Submitter:       sdk.AccAddress(bytes1).String(),
+	}
+	frozen := types.FrozenContract{
+		Address:   sdk.AccAddress(address.Bytes()).String(),
+		Reason:    "exploited",
+		Certifier: sdk.AccAddress(bytes1).String(),
+	}
+	sponsorship := types.NewSponsorship(sdk.AccAddress(address.Bytes()).String(), sdk.AccAddress(bytes1).String(),
+		sdk.NewCoins(sdk.NewInt64Coin("uctk", 1000)), nil, time.Time{})
+	usage := types.SponsorUsage{
+		Contract: sponsorship.Contract,
+		User:     sdk.AccAddress(bytes1).String(),
+		Day:      1,
+		Spent:    sdk.NewCoins(sdk.NewInt64Coin("uctk", 10)),
+	}
+	scheduledCall := types.ScheduledCall{
+		Id:         1,
+		Caller:     sdk.AccAddress(bytes1).String(),
+		Callee:     sponsorship.Contract,
+		Interval:   10,
+		NextHeight: 20,
+		GasLimit:   100000,
+		PrepaidGas: 1000000,
+	}
+
 	KVPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.StorageStoreKey(address, key), Value: value1},
@@ -85,6 +128,18 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.AbiStoreKey(address), Value: value4},
 			{Key: types.MetaHashStoreKey(metahash), Value: []byte(str)},
 			{Key: types.AddressMetaStoreKey(address), Value: cdc.Marshaler.MustMarshalBinaryBare(&metadata)},
+			{Key: types.ReceiptStoreKey(bytes2, 1), Value: cdc.Marshaler.MustMarshalBinaryBare(&receipt)},
+			{Key: receiptIndexKey, Value: []byte{0x01}},
+			{Key: types.AdminStoreKey(address), Value: bytes1},
+			{Key: types.DeployerStoreKey(address), Value: bytes1},
+			{Key: types.SweptFundsKey, Value: cdc.Marshaler.MustMarshalBinaryBare(&types.CoinsProto{Coins: sweptFunds})},
+			{Key: types.VerifiedContractStoreKey(address), Value: cdc.Marshaler.MustMarshalBinaryBare(&verified)},
+			{Key: types.FrozenContractStoreKey(address), Value: cdc.Marshaler.MustMarshalBinaryBare(&frozen)},
+			{Key: types.SponsorshipStoreKey(address), Value: cdc.Marshaler.MustMarshalBinaryBare(&sponsorship)},
+			{Key: types.SponsorUsageStoreKey(address, bytes1), Value: cdc.Marshaler.MustMarshalBinaryBare(&usage)},
+			{Key: types.ScheduledCallStoreKey(1), Value: cdc.Marshaler.MustMarshalBinaryBare(&scheduledCall)},
+			{Key: types.ScheduledCallQueueKey(20, 1), Value: []byte{}},
+			{Key: types.NextScheduledCallIDKey, Value: sdk.Uint64ToBigEndian(2)},
 		},
 	}
 
@@ -98,6 +153,18 @@ func TestDecodeStore(t *testing.T) {
 		{"Abi", fmt.Sprintf("%b\n%b", value4, value4)},
 		{"MetaHash", fmt.Sprintf("%s\n%s", str, str)},
 		{"AddressMetaHash", fmt.Sprintf("%v\n%v", metadata, metadata)},
+		{"Receipt", fmt.Sprintf("%v\n%v", receipt, receipt)},
+		{"ReceiptIndex", fmt.Sprintf("%X\n%X", receiptIndexKey[1:], receiptIndexKey[1:])},
+		{"Admin", fmt.Sprintf("%s\n%s", sdk.AccAddress(bytes1), sdk.AccAddress(bytes1))},
+		{"Deployer", fmt.Sprintf("%s\n%s", sdk.AccAddress(bytes1), sdk.AccAddress(bytes1))},
+		{"SweptFunds", fmt.Sprintf("%v\n%v", sweptFunds, sweptFunds)},
+		{"VerifiedContract", fmt.Sprintf("%v\n%v", verified, verified)},
+		{"FrozenContract", fmt.Sprintf("%v\n%v", frozen, frozen)},
+		{"Sponsorship", fmt.Sprintf("%v\n%v", sponsorship, sponsorship)},
+		{"SponsorUsage", fmt.Sprintf("%v\n%v", usage, usage)},
+		{"ScheduledCall", fmt.Sprintf("%v\n%v", scheduledCall, scheduledCall)},
+		{"ScheduledCallQueue", fmt.Sprintf("%X\n%X", types.ScheduledCallQueueKey(20, 1)[1:], types.ScheduledCallQueueKey(20, 1)[1:])},
+		{"NextScheduledCallID", "2\n2"},
 		{"other", ""},
 	}
 
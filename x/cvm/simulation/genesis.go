@@ -11,6 +11,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 	gs := types.GenesisState{}
 
 	gs.GasRate = 1
+	gs.ReceiptParams = types.DefaultReceiptParams()
+	gs.TransactionGasLimit = types.DefaultTransactionGasLimit
+	gs.GasSchedule = types.DefaultGasSchedule()
+	gs.Forks = types.DefaultForks()
+	gs.CertifierFreezeDuration = types.DefaultCertifierFreezeDuration
+	gs.OracleCheckParams = types.DefaultOracleCheckParams()
+	gs.DispatchAllowlist = types.DefaultDispatchAllowlist()
+	gs.ScheduleBlockGasLimit = types.DefaultScheduleBlockGasLimit
+	gs.NextScheduledCallId = 1
 
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&gs)
 }
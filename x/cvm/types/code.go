@@ -1,5 +1,10 @@
 package types
 
+import (
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+)
+
 // CVM code types
 const (
 	CVMCodeTypeEVMCode   = 0
@@ -13,3 +18,15 @@ func NewCVMCode(codeType int64, code []byte) CVMCode {
 		Code:     code,
 	}
 }
+
+// NewSaltedContractAddress returns the address of a contract deployed with a salt, derived from the
+// deployer, the salt and the Keccak-256 hash of the code like the addresses of CREATE2.
+func NewSaltedContractAddress(deployer crypto.Address, salt binary.Word256, codeHash []byte) crypto.Address {
+	// keccak256(0xff ++ deployer ++ salt ++ codeHash)[12:]
+	data := make([]byte, 0, 1+crypto.AddressLength+2*binary.Word256Bytes)
+	data = append(data, 0xff)
+	data = append(data, deployer.Bytes()...)
+	data = append(data, salt.Bytes()...)
+	data = append(data, codeHash...)
+	return crypto.MustAddressFromBytes(crypto.Keccak256(data)[12:])
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/binary"
@@ -14,6 +15,15 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(MsgCall{}, "cvm/Call", nil)
 	cdc.RegisterConcrete(MsgDeploy{}, "cvm/Deploy", nil)
+	cdc.RegisterConcrete(MsgMigrate{}, "cvm/Migrate", nil)
+	cdc.RegisterConcrete(MsgVerifyContract{}, "cvm/VerifyContract", nil)
+	cdc.RegisterConcrete(MsgFreezeContract{}, "cvm/FreezeContract", nil)
+	cdc.RegisterConcrete(MsgUnfreezeContract{}, "cvm/UnfreezeContract", nil)
+	cdc.RegisterConcrete(MsgSetSponsorship{}, "cvm/SetSponsorship", nil)
+	cdc.RegisterConcrete(MsgRevokeSponsorship{}, "cvm/RevokeSponsorship", nil)
+	cdc.RegisterConcrete(MsgScheduleCall{}, "cvm/ScheduleCall", nil)
+	cdc.RegisterConcrete(MsgCancelScheduledCall{}, "cvm/CancelScheduledCall", nil)
+	cdc.RegisterConcrete(MsgMultiCall{}, "cvm/MultiCall", nil)
 	cdc.RegisterConcrete(acm.Account{}, "cvm/Account", nil)
 	cdc.RegisterConcrete(acm.Bytecode{}, "acm/Bytecode", nil)
 	cdc.RegisterConcrete(binary.Word256{}, "binary/Word256", nil)
@@ -24,6 +34,20 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCall{},
 		&MsgDeploy{},
+		&MsgMigrate{},
+		&MsgVerifyContract{},
+		&MsgFreezeContract{},
+		&MsgUnfreezeContract{},
+		&MsgSetSponsorship{},
+		&MsgRevokeSponsorship{},
+		&MsgScheduleCall{},
+		&MsgCancelScheduledCall{},
+		&MsgMultiCall{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&ContractMigrationProposal{},
+		&ContractFreezeProposal{},
+		&ContractUnfreezeProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
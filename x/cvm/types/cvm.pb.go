@@ -5,16 +5,26 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	payload "github.com/hyperledger/burrow/txs/payload"
 	_ "github.com/regen-network/cosmos-proto"
+	io "io"
 	math "math"
+	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -22,19 +32,6676 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Log is an event log emitted by a CVM execution.
+type Log struct {
+	Address string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Topics  [][]byte `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty" yaml:"topics"`
+	Data    []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty" yaml:"data"`
+	// log_index is the position of the log in the block.
+	LogIndex uint64 `protobuf:"varint,4,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty" yaml:"log_index"`
+	// tx_log_index is the position of the log in the transaction.
+	TxLogIndex uint64 `protobuf:"varint,5,opt,name=tx_log_index,json=txLogIndex,proto3" json:"tx_log_index,omitempty" yaml:"tx_log_index"`
+}
+
+func (m *Log) Reset()         { *m = Log{} }
+func (m *Log) String() string { return proto.CompactTextString(m) }
+func (*Log) ProtoMessage()    {}
+func (*Log) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{0}
+}
+func (m *Log) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Log) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Log.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Log) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Log.Merge(m, src)
+}
+func (m *Log) XXX_Size() int {
+	return m.Size()
+}
+func (m *Log) XXX_DiscardUnknown() {
+	xxx_messageInfo_Log.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Log proto.InternalMessageInfo
+
+// Receipt is the outcome of a CVM message.
+type Receipt struct {
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+	// msg_index is the position of the message in the transaction.
+	MsgIndex uint32 `protobuf:"varint,2,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty" yaml:"msg_index"`
+	Height   int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// status is 1 if the execution succeeded and 0 if it failed.
+	Status uint32 `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty" yaml:"status"`
+	Caller string `protobuf:"bytes,5,opt,name=caller,proto3" json:"caller,omitempty" yaml:"caller"`
+	// callee is empty for contract deployments.
+	Callee     string `protobuf:"bytes,6,opt,name=callee,proto3" json:"callee,omitempty" yaml:"callee"`
+	ReturnData []byte `protobuf:"bytes,7,opt,name=return_data,json=returnData,proto3" json:"return_data,omitempty" yaml:"return_data"`
+	GasUsed    uint64 `protobuf:"varint,8,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+	// contract_address is the address of the contract created by a deployment.
+	ContractAddress string `protobuf:"bytes,9,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	Logs            []Log  `protobuf:"bytes,10,rep,name=logs,proto3" json:"logs" yaml:"logs"`
+	// calls are the outcomes of the calls of a multi-call message, whose receipt has no callee.
+	Calls []CallReceipt `protobuf:"bytes,11,rep,name=calls,proto3" json:"calls" yaml:"calls"`
+}
+
+func (m *Receipt) Reset()         { *m = Receipt{} }
+func (m *Receipt) String() string { return proto.CompactTextString(m) }
+func (*Receipt) ProtoMessage()    {}
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{1}
+}
+func (m *Receipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Receipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Receipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Receipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Receipt.Merge(m, src)
+}
+func (m *Receipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *Receipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_Receipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Receipt proto.InternalMessageInfo
+
+// CallReceipt is the outcome of a call of a multi-call message.
+type CallReceipt struct {
+	Callee string `protobuf:"bytes,1,opt,name=callee,proto3" json:"callee,omitempty" yaml:"callee"`
+	// status is 1 if the call succeeded and 0 if it failed.
+	Status     uint32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty" yaml:"status"`
+	ReturnData []byte `protobuf:"bytes,3,opt,name=return_data,json=returnData,proto3" json:"return_data,omitempty" yaml:"return_data"`
+	GasUsed    uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+}
+
+func (m *CallReceipt) Reset()         { *m = CallReceipt{} }
+func (m *CallReceipt) String() string { return proto.CompactTextString(m) }
+func (*CallReceipt) ProtoMessage()    {}
+func (*CallReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{2}
+}
+func (m *CallReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CallReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallReceipt.Merge(m, src)
+}
+func (m *CallReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallReceipt proto.InternalMessageInfo
+
+// GasSchedule defines the gas costs of the CVM opcodes.
+type GasSchedule struct {
+	Base    uint64 `protobuf:"varint,1,opt,name=base,proto3" json:"base,omitempty" yaml:"base"`
+	VeryLow uint64 `protobuf:"varint,2,opt,name=very_low,json=veryLow,proto3" json:"very_low,omitempty" yaml:"very_low"`
+	Low     uint64 `protobuf:"varint,3,opt,name=low,proto3" json:"low,omitempty" yaml:"low"`
+	Mid     uint64 `protobuf:"varint,4,opt,name=mid,proto3" json:"mid,omitempty" yaml:"mid"`
+	High    uint64 `protobuf:"varint,5,opt,name=high,proto3" json:"high,omitempty" yaml:"high"`
+	// ext_step is the cost of BLOCKHASH.
+	ExtStep         uint64 `protobuf:"varint,6,opt,name=ext_step,json=extStep,proto3" json:"ext_step,omitempty" yaml:"ext_step"`
+	ExtcodeSize     uint64 `protobuf:"varint,7,opt,name=extcode_size,json=extcodeSize,proto3" json:"extcode_size,omitempty" yaml:"extcode_size"`
+	ExtcodeCopy     uint64 `protobuf:"varint,8,opt,name=extcode_copy,json=extcodeCopy,proto3" json:"extcode_copy,omitempty" yaml:"extcode_copy"`
+	ExtcodeCopyBase uint64 `protobuf:"varint,9,opt,name=extcode_copy_base,json=extcodeCopyBase,proto3" json:"extcode_copy_base,omitempty" yaml:"extcode_copy_base"`
+	ExtcodeHash     uint64 `protobuf:"varint,10,opt,name=extcode_hash,json=extcodeHash,proto3" json:"extcode_hash,omitempty" yaml:"extcode_hash"`
+	Balance         uint64 `protobuf:"varint,11,opt,name=balance,proto3" json:"balance,omitempty" yaml:"balance"`
+	Sload           uint64 `protobuf:"varint,12,opt,name=sload,proto3" json:"sload,omitempty" yaml:"sload"`
+	// calls is the cost of CALLCODE, DELEGATECALL and STATICCALL, and the dynamic base cost of CALL.
+	Calls uint64 `protobuf:"varint,13,opt,name=calls,proto3" json:"calls,omitempty" yaml:"calls"`
+	// call is the static cost of CALL.
+	Call                 uint64 `protobuf:"varint,14,opt,name=call,proto3" json:"call,omitempty" yaml:"call"`
+	Create               uint64 `protobuf:"varint,15,opt,name=create,proto3" json:"create,omitempty" yaml:"create"`
+	Selfdestruct         uint64 `protobuf:"varint,16,opt,name=selfdestruct,proto3" json:"selfdestruct,omitempty" yaml:"selfdestruct"`
+	CreateBySelfdestruct uint64 `protobuf:"varint,17,opt,name=create_by_selfdestruct,json=createBySelfdestruct,proto3" json:"create_by_selfdestruct,omitempty" yaml:"create_by_selfdestruct"`
+	SelfdestructRefund   uint64 `protobuf:"varint,18,opt,name=selfdestruct_refund,json=selfdestructRefund,proto3" json:"selfdestruct_refund,omitempty" yaml:"selfdestruct_refund"`
+	Exp                  uint64 `protobuf:"varint,19,opt,name=exp,proto3" json:"exp,omitempty" yaml:"exp"`
+	ExpByte              uint64 `protobuf:"varint,20,opt,name=exp_byte,json=expByte,proto3" json:"exp_byte,omitempty" yaml:"exp_byte"`
+	CallValueTransfer    uint64 `protobuf:"varint,21,opt,name=call_value_transfer,json=callValueTransfer,proto3" json:"call_value_transfer,omitempty" yaml:"call_value_transfer"`
+	CallNewAccount       uint64 `protobuf:"varint,22,opt,name=call_new_account,json=callNewAccount,proto3" json:"call_new_account,omitempty" yaml:"call_new_account"`
+	// memory is the cost per word of memory.
+	Memory uint64 `protobuf:"varint,23,opt,name=memory,proto3" json:"memory,omitempty" yaml:"memory"`
+	// quad_coeff_div is the divisor of the quadratic particle of the memory cost.
+	QuadCoeffDiv uint64 `protobuf:"varint,24,opt,name=quad_coeff_div,json=quadCoeffDiv,proto3" json:"quad_coeff_div,omitempty" yaml:"quad_coeff_div"`
+	Log          uint64 `protobuf:"varint,25,opt,name=log,proto3" json:"log,omitempty" yaml:"log"`
+	// log_data is the cost per byte of the data of a LOG operation.
+	LogData uint64 `protobuf:"varint,26,opt,name=log_data,json=logData,proto3" json:"log_data,omitempty" yaml:"log_data"`
+	// log_topic is the cost per topic of a LOG operation.
+	LogTopic    uint64 `protobuf:"varint,27,opt,name=log_topic,json=logTopic,proto3" json:"log_topic,omitempty" yaml:"log_topic"`
+	Sha3        uint64 `protobuf:"varint,28,opt,name=sha3,proto3" json:"sha3,omitempty" yaml:"sha3"`
+	Sha3Word    uint64 `protobuf:"varint,29,opt,name=sha3_word,json=sha3Word,proto3" json:"sha3_word,omitempty" yaml:"sha3_word"`
+	Copy        uint64 `protobuf:"varint,30,opt,name=copy,proto3" json:"copy,omitempty" yaml:"copy"`
+	SstoreSet   uint64 `protobuf:"varint,31,opt,name=sstore_set,json=sstoreSet,proto3" json:"sstore_set,omitempty" yaml:"sstore_set"`
+	SstoreReset uint64 `protobuf:"varint,32,opt,name=sstore_reset,json=sstoreReset,proto3" json:"sstore_reset,omitempty" yaml:"sstore_reset"`
+	SstoreClear uint64 `protobuf:"varint,33,opt,name=sstore_clear,json=sstoreClear,proto3" json:"sstore_clear,omitempty" yaml:"sstore_clear"`
+	// sstore_refund is refunded when SSTORE clears a slot.
+	SstoreRefund uint64 `protobuf:"varint,34,opt,name=sstore_refund,json=sstoreRefund,proto3" json:"sstore_refund,omitempty" yaml:"sstore_refund"`
+	// sstore_noop is the cost of SSTORE when the value does not change.
+	SstoreNoop uint64 `protobuf:"varint,35,opt,name=sstore_noop,json=sstoreNoop,proto3" json:"sstore_noop,omitempty" yaml:"sstore_noop"`
+	Jumpdest   uint64 `protobuf:"varint,36,opt,name=jumpdest,proto3" json:"jumpdest,omitempty" yaml:"jumpdest"`
+	// sstore_sentry is the gas SSTORE requires to be left, from the Istanbul rules.
+	SstoreSentry uint64 `protobuf:"varint,37,opt,name=sstore_sentry,json=sstoreSentry,proto3" json:"sstore_sentry,omitempty" yaml:"sstore_sentry"`
+	// cold_sload is the cost of the first access of a storage slot, from the Berlin rules.
+	ColdSload uint64 `protobuf:"varint,38,opt,name=cold_sload,json=coldSload,proto3" json:"cold_sload,omitempty" yaml:"cold_sload"`
+	// cold_account_access is the cost of the first access of an account, from the Berlin rules.
+	ColdAccountAccess uint64 `protobuf:"varint,39,opt,name=cold_account_access,json=coldAccountAccess,proto3" json:"cold_account_access,omitempty" yaml:"cold_account_access"`
+	// warm_storage_read is the cost of the later accesses of accounts and storage slots, from the Berlin rules.
+	WarmStorageRead uint64 `protobuf:"varint,40,opt,name=warm_storage_read,json=warmStorageRead,proto3" json:"warm_storage_read,omitempty" yaml:"warm_storage_read"`
+}
+
+func (m *GasSchedule) Reset()         { *m = GasSchedule{} }
+func (m *GasSchedule) String() string { return proto.CompactTextString(m) }
+func (*GasSchedule) ProtoMessage()    {}
+func (*GasSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{3}
+}
+func (m *GasSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasSchedule.Merge(m, src)
+}
+func (m *GasSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasSchedule proto.InternalMessageInfo
+
+// Forks defines the block heights from which the CVM applies the protocol upgrades of the EVM.
+// A zero height leaves the upgrade disabled.
+type Forks struct {
+	// istanbul_height enables CHAINID, SELFBALANCE and the net gas metering of SSTORE (EIP-2200).
+	IstanbulHeight uint64 `protobuf:"varint,1,opt,name=istanbul_height,json=istanbulHeight,proto3" json:"istanbul_height,omitempty" yaml:"istanbul_height"`
+	// berlin_height enables the warm and cold access costs of accounts and storage (EIP-2929).
+	BerlinHeight uint64 `protobuf:"varint,2,opt,name=berlin_height,json=berlinHeight,proto3" json:"berlin_height,omitempty" yaml:"berlin_height"`
+	// gas_charge_height charges executions for the CVM gas they use and refunds only the successful ones.
+	GasChargeHeight uint64 `protobuf:"varint,3,opt,name=gas_charge_height,json=gasChargeHeight,proto3" json:"gas_charge_height,omitempty" yaml:"gas_charge_height"`
+}
+
+func (m *Forks) Reset()         { *m = Forks{} }
+func (m *Forks) String() string { return proto.CompactTextString(m) }
+func (*Forks) ProtoMessage()    {}
+func (*Forks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{4}
+}
+func (m *Forks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Forks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Forks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Forks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Forks.Merge(m, src)
+}
+func (m *Forks) XXX_Size() int {
+	return m.Size()
+}
+func (m *Forks) XXX_DiscardUnknown() {
+	xxx_messageInfo_Forks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Forks proto.InternalMessageInfo
+
+// ReceiptParams defines how the receipts of CVM messages are kept.
+type ReceiptParams struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// retention_blocks is the number of blocks receipts are kept for. Zero keeps them forever.
+	RetentionBlocks uint64 `protobuf:"varint,2,opt,name=retention_blocks,json=retentionBlocks,proto3" json:"retention_blocks,omitempty" yaml:"retention_blocks"`
+	// index_enabled enables the index of receipts by contract address and log topic.
+	IndexEnabled bool `protobuf:"varint,3,opt,name=index_enabled,json=indexEnabled,proto3" json:"index_enabled,omitempty" yaml:"index_enabled"`
+}
+
+func (m *ReceiptParams) Reset()         { *m = ReceiptParams{} }
+func (m *ReceiptParams) String() string { return proto.CompactTextString(m) }
+func (*ReceiptParams) ProtoMessage()    {}
+func (*ReceiptParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{5}
+}
+func (m *ReceiptParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiptParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiptParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiptParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptParams.Merge(m, src)
+}
+func (m *ReceiptParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiptParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptParams proto.InternalMessageInfo
+
+// OracleCheckParams defines how the calls into contracts are checked against the security oracle.
+type OracleCheckParams struct {
+	// enabled enables the rejection of the calls into contracts whose oracle score is below the
+	// threshold score. Only the callees of the messages are checked, not the contracts they call.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// threshold_score is the minimum oracle score of the callees. Zero follows the threshold score
+	// of the oracle task parameters.
+	ThresholdScore github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=threshold_score,json=thresholdScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"threshold_score" yaml:"threshold_score"`
+}
+
+func (m *OracleCheckParams) Reset()         { *m = OracleCheckParams{} }
+func (m *OracleCheckParams) String() string { return proto.CompactTextString(m) }
+func (*OracleCheckParams) ProtoMessage()    {}
+func (*OracleCheckParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{6}
+}
+func (m *OracleCheckParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleCheckParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleCheckParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleCheckParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleCheckParams.Merge(m, src)
+}
+func (m *OracleCheckParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleCheckParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleCheckParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleCheckParams proto.InternalMessageInfo
+
+// CoinsProto wraps coins to store them.
+type CoinsProto struct {
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins" yaml:"coins"`
+}
+
+func (m *CoinsProto) Reset()         { *m = CoinsProto{} }
+func (m *CoinsProto) String() string { return proto.CompactTextString(m) }
+func (*CoinsProto) ProtoMessage()    {}
+func (*CoinsProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{7}
+}
+func (m *CoinsProto) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoinsProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoinsProto.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CoinsProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoinsProto.Merge(m, src)
+}
+func (m *CoinsProto) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoinsProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoinsProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoinsProto proto.InternalMessageInfo
+
+// SourceFile is a source file of a verified contract.
+type SourceFile struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty" yaml:"content"`
+}
+
+func (m *SourceFile) Reset()         { *m = SourceFile{} }
+func (m *SourceFile) String() string { return proto.CompactTextString(m) }
+func (*SourceFile) ProtoMessage()    {}
+func (*SourceFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{8}
+}
+func (m *SourceFile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceFile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceFile.Merge(m, src)
+}
+func (m *SourceFile) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceFile proto.InternalMessageInfo
+
+// VerifiedContract binds the source code of a contract to the compilation certificate of its code.
+type VerifiedContract struct {
+	Address         string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	SourceFiles     []SourceFile `protobuf:"bytes,2,rep,name=source_files,json=sourceFiles,proto3" json:"source_files" yaml:"source_files"`
+	CompilerVersion string       `protobuf:"bytes,3,opt,name=compiler_version,json=compilerVersion,proto3" json:"compiler_version,omitempty" yaml:"compiler_version"`
+	// compiler_settings are the JSON encoded settings the source files were compiled with.
+	CompilerSettings string `protobuf:"bytes,4,opt,name=compiler_settings,json=compilerSettings,proto3" json:"compiler_settings,omitempty" yaml:"compiler_settings"`
+	// code_hash is the hex encoded Keccak-256 hash of the code of the contract.
+	CodeHash string `protobuf:"bytes,5,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty" yaml:"code_hash"`
+	// certificate_id is the ID of the compilation certificate of the code.
+	CertificateId uint64 `protobuf:"varint,6,opt,name=certificate_id,json=certificateId,proto3" json:"certificate_id,omitempty" yaml:"certificate_id"`
+	Submitter     string `protobuf:"bytes,7,opt,name=submitter,proto3" json:"submitter,omitempty" yaml:"submitter"`
+}
+
+func (m *VerifiedContract) Reset()         { *m = VerifiedContract{} }
+func (m *VerifiedContract) String() string { return proto.CompactTextString(m) }
+func (*VerifiedContract) ProtoMessage()    {}
+func (*VerifiedContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{9}
+}
+func (m *VerifiedContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifiedContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifiedContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifiedContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifiedContract.Merge(m, src)
+}
+func (m *VerifiedContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifiedContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifiedContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifiedContract proto.InternalMessageInfo
+
+// FrozenContract is a contract whose calls are rejected, e.g. while it is being exploited.
+type FrozenContract struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+	// certifier is the certifier who froze the contract. It is empty if the governance froze the contract.
+	Certifier string `protobuf:"bytes,3,opt,name=certifier,proto3" json:"certifier,omitempty" yaml:"certifier"`
+	// expiry is the time the freeze expires at. The zero time never expires.
+	Expiry time.Time `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry" yaml:"expiry"`
+}
+
+func (m *FrozenContract) Reset()         { *m = FrozenContract{} }
+func (m *FrozenContract) String() string { return proto.CompactTextString(m) }
+func (*FrozenContract) ProtoMessage()    {}
+func (*FrozenContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{10}
+}
+func (m *FrozenContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenContract.Merge(m, src)
+}
+func (m *FrozenContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenContract proto.InternalMessageInfo
+
+// Sponsorship is the policy of a sponsor paying the fees of the calls into a contract.
+type Sponsorship struct {
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	Sponsor  string `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty" yaml:"sponsor"`
+	// daily_cap is the maximum fees the sponsor pays for the calls of each user in a UTC day.
+	DailyCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=daily_cap,json=dailyCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"daily_cap" yaml:"daily_cap"`
+	// selectors are the hex encoded function selectors prefixed with 0x of the sponsored calls. All the calls are sponsored if empty.
+	Selectors []string `protobuf:"bytes,4,rep,name=selectors,proto3" json:"selectors,omitempty" yaml:"selectors"`
+	// expiry is the time the sponsorship expires at. The zero time never expires.
+	Expiry time.Time `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry" yaml:"expiry"`
+}
+
+func (m *Sponsorship) Reset()         { *m = Sponsorship{} }
+func (m *Sponsorship) String() string { return proto.CompactTextString(m) }
+func (*Sponsorship) ProtoMessage()    {}
+func (*Sponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{11}
+}
+func (m *Sponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Sponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Sponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsorship.Merge(m, src)
+}
+func (m *Sponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *Sponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sponsorship proto.InternalMessageInfo
+
+// SponsorUsage is the fees a sponsor paid for the calls of a user into a contract in a day.
+type SponsorUsage struct {
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	User     string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
+	// day is the number of UTC days since the Unix epoch.
+	Day   int64                                    `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty" yaml:"day"`
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent" yaml:"spent"`
+}
+
+func (m *SponsorUsage) Reset()         { *m = SponsorUsage{} }
+func (m *SponsorUsage) String() string { return proto.CompactTextString(m) }
+func (*SponsorUsage) ProtoMessage()    {}
+func (*SponsorUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{12}
+}
+func (m *SponsorUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorUsage.Merge(m, src)
+}
+func (m *SponsorUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorUsage proto.InternalMessageInfo
+
+// ScheduledCall is a contract call executed periodically at the beginning of blocks.
+type ScheduledCall struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Caller string `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty" yaml:"caller"`
+	Callee string `protobuf:"bytes,3,opt,name=callee,proto3" json:"callee,omitempty" yaml:"callee"`
+	Data   []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty" yaml:"data"`
+	// interval is the number of blocks between the runs of the call.
+	Interval uint64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty" yaml:"interval"`
+	// next_height is the height of the block the call runs next at the beginning of.
+	NextHeight int64 `protobuf:"varint,6,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty" yaml:"next_height"`
+	// max_runs is the maximum number of runs of the call. Zero runs the call until its prepaid gas runs out.
+	MaxRuns uint64 `protobuf:"varint,7,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty" yaml:"max_runs"`
+	Runs    uint64 `protobuf:"varint,8,opt,name=runs,proto3" json:"runs,omitempty" yaml:"runs"`
+	// gas_limit is the gas limit of each run.
+	GasLimit uint64 `protobuf:"varint,9,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+	// prepaid_gas is the gas left for the next runs.
+	PrepaidGas uint64 `protobuf:"varint,10,opt,name=prepaid_gas,json=prepaidGas,proto3" json:"prepaid_gas,omitempty" yaml:"prepaid_gas"`
+	// prepaid_fee is the fee held in escrow for the prepaid gas left.
+	PrepaidFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=prepaid_fee,json=prepaidFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"prepaid_fee" yaml:"prepaid_fee"`
+}
+
+func (m *ScheduledCall) Reset()         { *m = ScheduledCall{} }
+func (m *ScheduledCall) String() string { return proto.CompactTextString(m) }
+func (*ScheduledCall) ProtoMessage()    {}
+func (*ScheduledCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{13}
+}
+func (m *ScheduledCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledCall.Merge(m, src)
+}
+func (m *ScheduledCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledCall proto.InternalMessageInfo
+
+// ContractMigrationProposal replaces the code of a contract while keeping its storage and balance.
+type ContractMigrationProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Contract    string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// code is the new runtime code of the contract. It has the code type of the replaced code.
+	Code []byte                  `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty" yaml:"code"`
+	Abi  string                  `protobuf:"bytes,5,opt,name=abi,proto3" json:"abi,omitempty" yaml:"abi"`
+	Meta []*payload.ContractMeta `protobuf:"bytes,6,rep,name=meta,proto3" json:"meta,omitempty" yaml:"meta"`
+}
+
+func (m *ContractMigrationProposal) Reset()         { *m = ContractMigrationProposal{} }
+func (m *ContractMigrationProposal) String() string { return proto.CompactTextString(m) }
+func (*ContractMigrationProposal) ProtoMessage()    {}
+func (*ContractMigrationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{14}
+}
+func (m *ContractMigrationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractMigrationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractMigrationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractMigrationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractMigrationProposal.Merge(m, src)
+}
+func (m *ContractMigrationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractMigrationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractMigrationProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractMigrationProposal proto.InternalMessageInfo
+
+// ContractFreezeProposal freezes a contract, so that its calls are rejected.
+type ContractFreezeProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Contract    string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	Reason      string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+	// duration is the duration of the freeze from the execution of the proposal. Zero freezes the contract until it is unfrozen.
+	Duration time.Duration `protobuf:"bytes,5,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+}
+
+func (m *ContractFreezeProposal) Reset()         { *m = ContractFreezeProposal{} }
+func (m *ContractFreezeProposal) String() string { return proto.CompactTextString(m) }
+func (*ContractFreezeProposal) ProtoMessage()    {}
+func (*ContractFreezeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{15}
+}
+func (m *ContractFreezeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractFreezeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractFreezeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractFreezeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractFreezeProposal.Merge(m, src)
+}
+func (m *ContractFreezeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractFreezeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractFreezeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractFreezeProposal proto.InternalMessageInfo
+
+// ContractUnfreezeProposal unfreezes a frozen contract.
+type ContractUnfreezeProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Contract    string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+}
+
+func (m *ContractUnfreezeProposal) Reset()         { *m = ContractUnfreezeProposal{} }
+func (m *ContractUnfreezeProposal) String() string { return proto.CompactTextString(m) }
+func (*ContractUnfreezeProposal) ProtoMessage()    {}
+func (*ContractUnfreezeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34fd24b011f58cef, []int{16}
+}
+func (m *ContractUnfreezeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractUnfreezeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractUnfreezeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractUnfreezeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractUnfreezeProposal.Merge(m, src)
+}
+func (m *ContractUnfreezeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractUnfreezeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractUnfreezeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractUnfreezeProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Log)(nil), "shentu.cvm.v1alpha1.Log")
+	proto.RegisterType((*Receipt)(nil), "shentu.cvm.v1alpha1.Receipt")
+	proto.RegisterType((*CallReceipt)(nil), "shentu.cvm.v1alpha1.CallReceipt")
+	proto.RegisterType((*GasSchedule)(nil), "shentu.cvm.v1alpha1.GasSchedule")
+	proto.RegisterType((*Forks)(nil), "shentu.cvm.v1alpha1.Forks")
+	proto.RegisterType((*ReceiptParams)(nil), "shentu.cvm.v1alpha1.ReceiptParams")
+	proto.RegisterType((*OracleCheckParams)(nil), "shentu.cvm.v1alpha1.OracleCheckParams")
+	proto.RegisterType((*CoinsProto)(nil), "shentu.cvm.v1alpha1.CoinsProto")
+	proto.RegisterType((*SourceFile)(nil), "shentu.cvm.v1alpha1.SourceFile")
+	proto.RegisterType((*VerifiedContract)(nil), "shentu.cvm.v1alpha1.VerifiedContract")
+	proto.RegisterType((*FrozenContract)(nil), "shentu.cvm.v1alpha1.FrozenContract")
+	proto.RegisterType((*Sponsorship)(nil), "shentu.cvm.v1alpha1.Sponsorship")
+	proto.RegisterType((*SponsorUsage)(nil), "shentu.cvm.v1alpha1.SponsorUsage")
+	proto.RegisterType((*ScheduledCall)(nil), "shentu.cvm.v1alpha1.ScheduledCall")
+	proto.RegisterType((*ContractMigrationProposal)(nil), "shentu.cvm.v1alpha1.ContractMigrationProposal")
+	proto.RegisterType((*ContractFreezeProposal)(nil), "shentu.cvm.v1alpha1.ContractFreezeProposal")
+	proto.RegisterType((*ContractUnfreezeProposal)(nil), "shentu.cvm.v1alpha1.ContractUnfreezeProposal")
+}
+
 func init() { proto.RegisterFile("shentu/cvm/v1alpha1/cvm.proto", fileDescriptor_34fd24b011f58cef) }
 
 var fileDescriptor_34fd24b011f58cef = []byte{
-	// 174 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0xce, 0x48, 0xcd,
-	0x2b, 0x29, 0xd5, 0x4f, 0x2e, 0xcb, 0xd5, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34, 0x04,
-	0x71, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84, 0x21, 0xd2, 0x7a, 0x20, 0x11, 0x98, 0xb4,
-	0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x5e, 0x1f, 0xc4, 0x82, 0x28, 0x95, 0x92, 0x4c, 0xce,
-	0x2f, 0xce, 0xcd, 0x2f, 0x8e, 0x87, 0x48, 0x40, 0x38, 0x10, 0x29, 0xa7, 0x90, 0x13, 0x0f, 0xe5,
-	0x18, 0x6e, 0x3c, 0x94, 0x63, 0x68, 0x78, 0x24, 0xc7, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
-	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
-	0xc7, 0x72, 0x0c, 0x51, 0x7a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa,
-	0xc9, 0xa9, 0x45, 0x25, 0x99, 0xd9, 0x69, 0xf9, 0xa5, 0x79, 0x29, 0x89, 0x25, 0x99, 0xf9, 0x79,
-	0xfa, 0x50, 0x67, 0x56, 0x80, 0x1d, 0x5a, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x36, 0xdc,
-	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xe9, 0x37, 0x62, 0xc3, 0x00, 0x00, 0x00,
+	// 2631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x23, 0xc7,
+	0xb1, 0x17, 0x45, 0x4a, 0xa2, 0x9a, 0xd4, 0xd7, 0x48, 0xbb, 0x1e, 0xad, 0xbd, 0x1a, 0xb9, 0xfd,
+	0x9e, 0x9f, 0xfc, 0x9e, 0x4d, 0x61, 0xd7, 0x0f, 0x48, 0xb2, 0x81, 0x11, 0x2f, 0xb5, 0x96, 0x77,
+	0x81, 0xf5, 0x07, 0x5a, 0x6b, 0x1b, 0xc8, 0x65, 0xd0, 0x9c, 0x69, 0x0e, 0x27, 0x3b, 0x9c, 0x1e,
+	0x4f, 0x37, 0x25, 0x72, 0x91, 0x43, 0x10, 0x20, 0x77, 0x1f, 0x7d, 0xf4, 0x39, 0xe7, 0x9c, 0xf2,
+	0x17, 0x18, 0x39, 0x19, 0x3e, 0x19, 0x09, 0x42, 0xc7, 0xbb, 0x97, 0x04, 0xc8, 0x21, 0x61, 0x0e,
+	0x39, 0x26, 0xa8, 0xee, 0x1e, 0xb2, 0x35, 0xdc, 0xb5, 0xbc, 0x76, 0x0e, 0x3e, 0x71, 0xba, 0x7e,
+	0xbf, 0xea, 0xae, 0xae, 0xee, 0xaa, 0xae, 0x6e, 0xa2, 0xab, 0xa2, 0xc7, 0x52, 0x39, 0x38, 0x0c,
+	0x4e, 0xfb, 0x87, 0xa7, 0xd7, 0x68, 0x92, 0xf5, 0xe8, 0x35, 0x68, 0xb4, 0xb2, 0x9c, 0x4b, 0xee,
+	0x6c, 0x6b, 0xb8, 0x05, 0x92, 0x02, 0xbe, 0xb2, 0x13, 0xf1, 0x88, 0x2b, 0xfc, 0x10, 0xbe, 0x34,
+	0xf5, 0xca, 0x6e, 0xc0, 0x45, 0x9f, 0x0b, 0x5f, 0x03, 0xba, 0x61, 0xa0, 0x3d, 0xdd, 0x3a, 0xec,
+	0x50, 0xc1, 0x0e, 0x4f, 0xaf, 0x75, 0x98, 0x84, 0x41, 0x78, 0x9c, 0x1a, 0x7c, 0xa7, 0x33, 0xc8,
+	0x73, 0x7e, 0x76, 0x98, 0xd1, 0x51, 0xc2, 0x69, 0x58, 0x68, 0x45, 0x9c, 0x47, 0x09, 0x3b, 0x54,
+	0xad, 0xce, 0xa0, 0x7b, 0x18, 0x0e, 0x72, 0x2a, 0x63, 0x5e, 0x68, 0x79, 0x65, 0x5c, 0xc6, 0x7d,
+	0x26, 0x24, 0xed, 0x67, 0x9a, 0x80, 0xff, 0x59, 0x41, 0xd5, 0xbb, 0x3c, 0x72, 0x5e, 0x46, 0x2b,
+	0x34, 0x0c, 0x73, 0x26, 0x84, 0x5b, 0xd9, 0xaf, 0x1c, 0xac, 0xb6, 0x9d, 0xc9, 0xd8, 0x5b, 0x1f,
+	0xd1, 0x7e, 0x72, 0x03, 0x1b, 0x00, 0x93, 0x82, 0xe2, 0xbc, 0x84, 0x96, 0x25, 0xcf, 0xe2, 0x40,
+	0xb8, 0x8b, 0xfb, 0xd5, 0x83, 0x66, 0x7b, 0x6b, 0x32, 0xf6, 0xd6, 0x34, 0x59, 0xcb, 0x31, 0x31,
+	0x04, 0xe7, 0x05, 0x54, 0x0b, 0xa9, 0xa4, 0x6e, 0x75, 0xbf, 0x72, 0xd0, 0x6c, 0x6f, 0x4c, 0xc6,
+	0x5e, 0x43, 0x13, 0x41, 0x8a, 0x89, 0x02, 0x9d, 0x6b, 0x68, 0x35, 0xe1, 0x91, 0x1f, 0xa7, 0x21,
+	0x1b, 0xba, 0xb5, 0xfd, 0xca, 0x41, 0xad, 0xbd, 0x33, 0x19, 0x7b, 0x9b, 0x9a, 0x39, 0x85, 0x30,
+	0xa9, 0x27, 0x3c, 0xba, 0x03, 0x9f, 0xce, 0x8f, 0x50, 0x53, 0x0e, 0xfd, 0x99, 0xd6, 0x92, 0xd2,
+	0x7a, 0x66, 0x32, 0xf6, 0xb6, 0x8d, 0x21, 0x16, 0x8a, 0x09, 0x92, 0xc3, 0xbb, 0x46, 0xf5, 0x46,
+	0xed, 0xe3, 0x4f, 0xbc, 0x0a, 0xfe, 0x63, 0x0d, 0xad, 0x10, 0x16, 0xb0, 0x38, 0x93, 0xce, 0xff,
+	0xa1, 0x15, 0x39, 0xf4, 0x7b, 0x54, 0xf4, 0xd4, 0xec, 0x9b, 0xf6, 0xec, 0x0d, 0x00, 0x33, 0x1a,
+	0xde, 0xa6, 0xa2, 0x07, 0xc6, 0xf6, 0x45, 0x31, 0xec, 0xe2, 0x7e, 0xe5, 0x60, 0xcd, 0x36, 0x76,
+	0x0a, 0x61, 0x52, 0xef, 0x0b, 0x63, 0xec, 0x4b, 0x68, 0xb9, 0xc7, 0xe2, 0xa8, 0x27, 0x95, 0x1b,
+	0xaa, 0xb6, 0xbf, 0xb4, 0x1c, 0x13, 0x43, 0x00, 0xaa, 0x90, 0x54, 0x0e, 0x84, 0xf2, 0xc3, 0x9a,
+	0x4d, 0xd5, 0x72, 0x4c, 0x0c, 0x01, 0xa8, 0x01, 0x4d, 0x12, 0x96, 0xab, 0xc9, 0xaf, 0xda, 0x54,
+	0x2d, 0xc7, 0xc4, 0x10, 0xa6, 0x54, 0xe6, 0x2e, 0x3f, 0x96, 0xca, 0x0a, 0x2a, 0x73, 0x7e, 0x80,
+	0x1a, 0x39, 0x93, 0x83, 0x3c, 0xf5, 0xd5, 0xba, 0xad, 0x28, 0x7f, 0x5c, 0x9e, 0x8c, 0x3d, 0x47,
+	0xf3, 0x2d, 0x10, 0x13, 0xa4, 0x5b, 0xb7, 0x60, 0x11, 0x5b, 0xa8, 0x1e, 0x51, 0xe1, 0x0f, 0x04,
+	0x0b, 0xdd, 0xba, 0x5a, 0x8d, 0xed, 0xc9, 0xd8, 0xdb, 0xd0, 0x5a, 0x05, 0x82, 0xc9, 0x4a, 0x44,
+	0xc5, 0x7b, 0x82, 0x85, 0xce, 0x31, 0xda, 0x0c, 0x78, 0x2a, 0x73, 0x1a, 0x48, 0xbf, 0xd8, 0x7b,
+	0xab, 0xca, 0xba, 0x67, 0x27, 0x63, 0xef, 0x19, 0x63, 0x5d, 0x89, 0x81, 0xc9, 0x46, 0x21, 0xba,
+	0x69, 0x36, 0xe3, 0x4d, 0x54, 0x4b, 0x78, 0x24, 0x5c, 0xb4, 0x5f, 0x3d, 0x68, 0x5c, 0x77, 0x5b,
+	0x8f, 0x09, 0xc7, 0xd6, 0x5d, 0x1e, 0xb5, 0xb7, 0x3f, 0x1d, 0x7b, 0x0b, 0xb3, 0xfd, 0x07, 0x3a,
+	0x98, 0x28, 0x55, 0xe7, 0x2e, 0x5a, 0x82, 0xd9, 0x0b, 0xb7, 0xa1, 0xfa, 0xd8, 0x7f, 0x6c, 0x1f,
+	0x47, 0x34, 0x49, 0xcc, 0x86, 0x69, 0xef, 0x98, 0xbe, 0x9a, 0x33, 0x1f, 0x0a, 0x4c, 0x74, 0x27,
+	0x66, 0x7f, 0x7d, 0x5e, 0x41, 0x0d, 0x4b, 0xc5, 0x5a, 0x82, 0xca, 0x45, 0x4b, 0x30, 0xdb, 0x03,
+	0x8b, 0x17, 0xed, 0x81, 0xd2, 0x6a, 0x55, 0xbf, 0xd5, 0x6a, 0xd5, 0x2e, 0x5e, 0x2d, 0x33, 0xa9,
+	0xbf, 0x6f, 0xa2, 0xc6, 0x9b, 0x54, 0x9c, 0x04, 0x3d, 0x16, 0x0e, 0x12, 0x06, 0xd1, 0x0d, 0x09,
+	0x4b, 0x4d, 0xa9, 0x66, 0x47, 0x37, 0x48, 0x31, 0x51, 0x20, 0x0c, 0x75, 0xca, 0xf2, 0x91, 0x9f,
+	0xf0, 0x33, 0x77, 0xb1, 0x3c, 0x54, 0x81, 0x60, 0xb2, 0x02, 0x9f, 0x77, 0xf9, 0x99, 0xb3, 0x8f,
+	0xaa, 0x40, 0xad, 0x2a, 0xea, 0xfa, 0x64, 0xec, 0xa1, 0x62, 0xc5, 0xce, 0x30, 0xa9, 0x26, 0x9a,
+	0xd1, 0x8f, 0x0b, 0xbb, 0x2d, 0x46, 0x3f, 0x0e, 0x31, 0x01, 0x08, 0x0c, 0xeb, 0xc5, 0x51, 0xcf,
+	0x5d, 0x2a, 0x1b, 0x06, 0x52, 0x4c, 0x14, 0x08, 0x86, 0xb1, 0xa1, 0xf4, 0x85, 0x64, 0x99, 0xbb,
+	0x5c, 0x36, 0xac, 0x40, 0x30, 0x59, 0x61, 0x43, 0x79, 0x22, 0x59, 0xe6, 0xdc, 0x40, 0x4d, 0x36,
+	0x94, 0x01, 0x0f, 0x99, 0x2f, 0xe2, 0x07, 0xcc, 0x5d, 0x29, 0xe7, 0x1c, 0x1b, 0xc5, 0xa4, 0x61,
+	0x9a, 0x27, 0xf1, 0x03, 0x66, 0xeb, 0x06, 0x3c, 0x1b, 0xb9, 0xf5, 0x27, 0xe9, 0x02, 0x3a, 0xd3,
+	0x3d, 0xe2, 0xd9, 0xc8, 0xb9, 0x8d, 0xb6, 0x6c, 0xd4, 0x57, 0x2e, 0x5f, 0x55, 0x1d, 0x3c, 0x37,
+	0x19, 0x7b, 0xee, 0x7c, 0x07, 0xbe, 0xf6, 0xff, 0x86, 0xd5, 0x4b, 0x9b, 0x8a, 0x73, 0x56, 0xa8,
+	0x6c, 0x87, 0x9e, 0x64, 0x85, 0x4e, 0x79, 0x85, 0x15, 0x2a, 0xef, 0xbd, 0x8c, 0x56, 0x3a, 0x34,
+	0xa1, 0x69, 0xc0, 0xdc, 0x86, 0x52, 0xb3, 0x92, 0xa4, 0x01, 0x30, 0x29, 0x28, 0xce, 0x8b, 0x68,
+	0x49, 0xc0, 0x41, 0xe5, 0x36, 0x15, 0x77, 0x73, 0x16, 0x2c, 0x4a, 0x8c, 0x89, 0x86, 0x81, 0xa7,
+	0x43, 0x6f, 0xad, 0xcc, 0x3b, 0x17, 0x54, 0xb0, 0xa0, 0xf0, 0xe1, 0xae, 0x97, 0x17, 0x14, 0xa4,
+	0x98, 0x28, 0x50, 0xc5, 0x58, 0xce, 0xa8, 0x64, 0xee, 0x86, 0xa2, 0xd9, 0x31, 0xa6, 0xe4, 0x10,
+	0x63, 0xea, 0xc3, 0xf9, 0x31, 0x6a, 0x0a, 0x96, 0x74, 0x43, 0x26, 0x64, 0x3e, 0x08, 0xa4, 0xbb,
+	0x59, 0xf6, 0x84, 0x8d, 0x62, 0x72, 0x8e, 0xec, 0x7c, 0x80, 0x2e, 0xeb, 0x6e, 0xfc, 0xce, 0xc8,
+	0x3f, 0xd7, 0xcd, 0x96, 0xea, 0xe6, 0xf9, 0xc9, 0xd8, 0xbb, 0x6a, 0x8f, 0x5b, 0xe6, 0x61, 0xb2,
+	0xa3, 0x81, 0xf6, 0xe8, 0xc4, 0xee, 0xf8, 0x1d, 0xb4, 0x6d, 0xd3, 0xfc, 0x9c, 0x75, 0x07, 0x69,
+	0xe8, 0x3a, 0xaa, 0xd7, 0xbd, 0xc9, 0xd8, 0xbb, 0x32, 0x6f, 0x9c, 0x21, 0x61, 0xe2, 0xd8, 0x52,
+	0xa2, 0x84, 0x10, 0x29, 0x6c, 0x98, 0xb9, 0xdb, 0xe5, 0x48, 0x61, 0xc3, 0x0c, 0x13, 0x80, 0x74,
+	0x10, 0x64, 0x7e, 0x67, 0x24, 0x99, 0xbb, 0x33, 0x1f, 0x04, 0x1a, 0x51, 0x41, 0x90, 0xb5, 0x47,
+	0x92, 0x39, 0x6f, 0xa3, 0x6d, 0xf0, 0xb5, 0x7f, 0x4a, 0x93, 0x01, 0xf3, 0x65, 0x4e, 0x53, 0xd1,
+	0x65, 0xb9, 0x7b, 0xa9, 0x6c, 0xe2, 0x63, 0x48, 0x98, 0x6c, 0x81, 0xf4, 0x7d, 0x10, 0xde, 0x33,
+	0x32, 0xe7, 0x0d, 0xb4, 0xa9, 0xa8, 0x29, 0x3b, 0xf3, 0x69, 0x10, 0xf0, 0x41, 0x2a, 0xdd, 0xcb,
+	0xaa, 0x33, 0xfb, 0x18, 0x28, 0x31, 0x30, 0x59, 0x07, 0xd1, 0xdb, 0xec, 0xec, 0xa6, 0x16, 0xc0,
+	0xd2, 0xf7, 0x59, 0x9f, 0xe7, 0x23, 0xf7, 0x99, 0xf2, 0xd2, 0x6b, 0x39, 0x26, 0x86, 0xe0, 0xfc,
+	0x04, 0xad, 0x7f, 0x38, 0xa0, 0xa1, 0x1f, 0x70, 0xd6, 0xed, 0xfa, 0x61, 0x7c, 0xea, 0xba, 0x4a,
+	0x65, 0x77, 0x32, 0xf6, 0x2e, 0x69, 0x95, 0xf3, 0x38, 0x26, 0x4d, 0x10, 0x1c, 0x41, 0xfb, 0x56,
+	0x7c, 0xaa, 0x13, 0x54, 0xe4, 0xee, 0xce, 0x27, 0xa8, 0x48, 0x25, 0xa8, 0x08, 0x9c, 0x0a, 0xc5,
+	0x87, 0xca, 0xc9, 0x57, 0xca, 0x4e, 0x2d, 0x10, 0x4c, 0x56, 0x12, 0x1e, 0xdd, 0xb2, 0x0a, 0x20,
+	0x55, 0x33, 0xb9, 0xcf, 0x3e, 0xae, 0x00, 0x52, 0x90, 0x2e, 0x80, 0xee, 0xc1, 0x27, 0x04, 0x84,
+	0xe8, 0xd1, 0x57, 0xdd, 0xe7, 0xca, 0x01, 0x01, 0x52, 0x4c, 0x14, 0x08, 0xfd, 0xc2, 0xaf, 0x7f,
+	0xc6, 0xf3, 0xd0, 0xbd, 0x5a, 0xee, 0x77, 0x0a, 0x61, 0x52, 0x87, 0xef, 0x0f, 0x78, 0xae, 0x32,
+	0xa7, 0x4a, 0x50, 0x7b, 0x73, 0x81, 0xa6, 0x12, 0x93, 0x02, 0x9d, 0xff, 0x47, 0x48, 0x08, 0xc9,
+	0x73, 0xe6, 0x0b, 0x26, 0x5d, 0x4f, 0x51, 0x2f, 0x4d, 0xc6, 0xde, 0x96, 0xe9, 0x78, 0x8a, 0x61,
+	0xb2, 0xaa, 0x1b, 0x27, 0x4c, 0x42, 0xf6, 0x31, 0x48, 0xce, 0x40, 0x6f, 0x7f, 0x2e, 0xe6, 0x2c,
+	0x14, 0x93, 0x86, 0x6e, 0x12, 0x26, 0xce, 0xe9, 0x06, 0x09, 0xa3, 0xb9, 0xfb, 0xfc, 0x13, 0x74,
+	0x15, 0x3a, 0xd5, 0x3d, 0x82, 0x96, 0xf3, 0x1a, 0x5a, 0x9b, 0xf6, 0xac, 0xe2, 0x09, 0x2b, 0x65,
+	0x77, 0x32, 0xf6, 0x76, 0x4a, 0x03, 0xeb, 0x48, 0x6a, 0x16, 0x23, 0x43, 0x13, 0xce, 0x58, 0x83,
+	0xa7, 0x9c, 0x67, 0xee, 0x0b, 0x4a, 0xd9, 0x3a, 0x63, 0x2d, 0x10, 0x13, 0xe3, 0x97, 0xb7, 0x39,
+	0xcf, 0x9c, 0x43, 0x54, 0xff, 0xd9, 0xa0, 0x9f, 0x41, 0x48, 0xba, 0xff, 0x55, 0xde, 0x05, 0x05,
+	0x82, 0xc9, 0x94, 0x64, 0x19, 0x2a, 0x58, 0x2a, 0xf3, 0x91, 0xfb, 0xdf, 0x4f, 0x30, 0x54, 0xc3,
+	0x53, 0x43, 0x4f, 0x54, 0x13, 0x56, 0x25, 0xe0, 0x49, 0xe8, 0xeb, 0xc4, 0xfb, 0x62, 0x79, 0x55,
+	0x66, 0x18, 0x26, 0xab, 0xd0, 0x38, 0x81, 0x6f, 0x15, 0xd0, 0x80, 0x98, 0xd0, 0x82, 0x5f, 0x28,
+	0xc5, 0xfe, 0x67, 0x2e, 0xa0, 0xe7, 0x49, 0x10, 0xd0, 0x3c, 0x09, 0x4d, 0x0c, 0xde, 0x54, 0x32,
+	0x38, 0xad, 0xce, 0x68, 0xde, 0xf7, 0xc1, 0x32, 0x1a, 0x81, 0x53, 0x69, 0xe8, 0x1e, 0x94, 0x4f,
+	0xab, 0x39, 0x0a, 0x26, 0x1b, 0x20, 0x3b, 0xd1, 0x22, 0xc2, 0x68, 0x51, 0x73, 0xfc, 0xa1, 0x82,
+	0x96, 0x8e, 0x79, 0x7e, 0x5f, 0x38, 0x47, 0x68, 0x23, 0x16, 0x92, 0xa6, 0x9d, 0x41, 0xe2, 0x9b,
+	0x7a, 0x5a, 0x17, 0x1e, 0x57, 0x26, 0x63, 0xef, 0xb2, 0xee, 0xb7, 0x44, 0xc0, 0x64, 0xbd, 0x90,
+	0xdc, 0x56, 0x02, 0xf0, 0x71, 0x87, 0xe5, 0x49, 0x9c, 0x16, 0x5d, 0x2c, 0x96, 0x7d, 0x7c, 0x0e,
+	0xc6, 0xa4, 0xa9, 0xdb, 0x46, 0xfd, 0x36, 0xda, 0x82, 0xea, 0x28, 0xe8, 0xd1, 0x3c, 0x62, 0xbe,
+	0x55, 0xd5, 0x9f, 0x9b, 0xdd, 0x1c, 0x05, 0x93, 0x8d, 0x88, 0x8a, 0x23, 0x25, 0xd2, 0x3d, 0x99,
+	0xd9, 0xfd, 0xae, 0x82, 0xd6, 0x4c, 0x89, 0xf8, 0x2e, 0xcd, 0x69, 0x5f, 0xc0, 0x39, 0xcb, 0x52,
+	0xda, 0x49, 0x58, 0xa8, 0x66, 0x57, 0xb7, 0xcf, 0x59, 0x03, 0x40, 0x3a, 0xd6, 0x5f, 0x50, 0x45,
+	0xe7, 0x4c, 0xb2, 0x14, 0x2e, 0x7d, 0x7e, 0x27, 0xe1, 0xc1, 0x7d, 0xe1, 0x2e, 0x96, 0xd3, 0x67,
+	0x99, 0x81, 0xc9, 0xc6, 0x54, 0xd4, 0x56, 0x12, 0x70, 0x8b, 0xba, 0xb6, 0xf8, 0xc5, 0xd8, 0x55,
+	0x35, 0xb6, 0xe5, 0x96, 0x73, 0x30, 0x26, 0x4d, 0xd5, 0x7e, 0x43, 0x37, 0xcd, 0x64, 0x7e, 0x5b,
+	0x41, 0x5b, 0xef, 0xe4, 0x34, 0x48, 0xd8, 0x51, 0x8f, 0x05, 0xf7, 0xbf, 0xd5, 0x84, 0x3e, 0x44,
+	0x1b, 0xb2, 0x97, 0x33, 0xd1, 0x53, 0xbb, 0x35, 0xe0, 0x39, 0x53, 0xf3, 0x59, 0x6d, 0xdf, 0x86,
+	0x9a, 0xfb, 0xf7, 0x63, 0xef, 0xc5, 0x28, 0x96, 0xbd, 0x41, 0xa7, 0x15, 0xf0, 0xbe, 0xb9, 0x42,
+	0x9b, 0x9f, 0x57, 0x44, 0x78, 0xff, 0x50, 0x8e, 0x32, 0x26, 0x5a, 0x77, 0x52, 0x39, 0xdb, 0x12,
+	0xa5, 0xee, 0x30, 0x59, 0x9f, 0x4a, 0x4e, 0x40, 0x60, 0x8c, 0xff, 0x55, 0x05, 0xa1, 0x23, 0x1e,
+	0xa7, 0xe2, 0x5d, 0x75, 0xad, 0xff, 0x10, 0x2d, 0xc1, 0xf5, 0x1b, 0xee, 0xc3, 0x70, 0x27, 0xd8,
+	0x6d, 0x99, 0xeb, 0x3a, 0x54, 0x56, 0x2d, 0x73, 0x41, 0x6f, 0x01, 0xbf, 0xfd, 0x7a, 0xe9, 0x32,
+	0x00, 0x5a, 0xf8, 0xd7, 0x5f, 0x7a, 0x07, 0xdf, 0xc0, 0x50, 0x35, 0x20, 0xd1, 0x23, 0x19, 0x3b,
+	0x18, 0x42, 0x27, 0x7c, 0x90, 0x07, 0xec, 0x38, 0xd6, 0x15, 0x76, 0x4a, 0xfb, 0xc5, 0xa5, 0xc1,
+	0x4a, 0xc7, 0x20, 0xc5, 0x44, 0x81, 0xe0, 0x61, 0xb8, 0x15, 0xb1, 0x54, 0x1a, 0x5f, 0x59, 0x1e,
+	0x36, 0x00, 0x26, 0x05, 0xc5, 0x0c, 0xf3, 0xa8, 0x8a, 0x36, 0xdf, 0x67, 0x79, 0xdc, 0x8d, 0x59,
+	0x78, 0x64, 0xae, 0x54, 0x4f, 0xf9, 0x0c, 0xe0, 0xa3, 0xa6, 0x50, 0x96, 0xfa, 0xdd, 0x38, 0x61,
+	0xfa, 0x31, 0xa0, 0x71, 0xdd, 0x7b, 0xec, 0xed, 0x69, 0x36, 0xa5, 0xf6, 0xb3, 0xc6, 0x5f, 0x45,
+	0xe2, 0xb6, 0xba, 0x80, 0xc4, 0x3d, 0x25, 0x0a, 0x7d, 0x45, 0xec, 0x67, 0x71, 0xc2, 0x72, 0xff,
+	0x94, 0xe5, 0x22, 0xe6, 0xa9, 0x5b, 0x9d, 0xbf, 0x22, 0x9e, 0x67, 0xa8, 0x2b, 0xa2, 0x16, 0xbd,
+	0xaf, 0x25, 0xce, 0x1d, 0xb4, 0x35, 0x65, 0x09, 0x26, 0x65, 0x9c, 0x46, 0xfa, 0x7e, 0xbd, 0x6a,
+	0x07, 0xed, 0x1c, 0x05, 0x93, 0xe9, 0xf0, 0x27, 0x46, 0x04, 0x27, 0xea, 0xac, 0x7c, 0xd6, 0xf7,
+	0x6e, 0xeb, 0x44, 0xb5, 0x6a, 0xe7, 0xfa, 0xb4, 0x70, 0x7e, 0x1d, 0xad, 0x07, 0x2c, 0x97, 0x71,
+	0x37, 0x0e, 0xa0, 0x14, 0x8c, 0x43, 0x77, 0xb9, 0x5c, 0x6f, 0x9c, 0xc7, 0x31, 0x59, 0xb3, 0x04,
+	0x77, 0x42, 0xe7, 0x3a, 0x5a, 0x15, 0x83, 0x4e, 0x3f, 0x96, 0x92, 0xe5, 0xee, 0x4a, 0x79, 0xd0,
+	0x29, 0x04, 0x87, 0x6d, 0xf1, 0x6d, 0x56, 0x79, 0x52, 0x41, 0xeb, 0xc7, 0x39, 0x7f, 0xc0, 0xd2,
+	0x6f, 0xb9, 0xc6, 0x2f, 0xa1, 0xe5, 0x9c, 0x51, 0xc1, 0x53, 0xb3, 0xb3, 0xac, 0xba, 0x4a, 0xcb,
+	0x31, 0x31, 0x04, 0xb0, 0xd2, 0x98, 0xcd, 0x72, 0xb7, 0x5a, 0xb6, 0x72, 0x0a, 0xc1, 0xe1, 0x53,
+	0x7c, 0x3b, 0x6f, 0xa1, 0x65, 0x36, 0xcc, 0xe2, 0x7c, 0xa4, 0x96, 0xa3, 0x71, 0xfd, 0x4a, 0x4b,
+	0xbf, 0x58, 0xb5, 0x8a, 0x17, 0xab, 0xd6, 0xbd, 0xe2, 0xc5, 0xaa, 0xbd, 0x6b, 0xf6, 0xcd, 0xda,
+	0xb4, 0x36, 0x8d, 0xf3, 0x11, 0xfe, 0xe8, 0x4b, 0xaf, 0x42, 0x4c, 0x27, 0x66, 0xd2, 0xff, 0x58,
+	0x44, 0x8d, 0x93, 0x8c, 0xa7, 0x82, 0xe7, 0xa2, 0x17, 0xab, 0x73, 0xb8, 0x78, 0x34, 0x30, 0x53,
+	0xb6, 0xce, 0xe1, 0x02, 0x51, 0x2b, 0x36, 0x73, 0x91, 0xd0, 0xfa, 0xf3, 0xf1, 0x64, 0x00, 0x4c,
+	0x0a, 0x8a, 0xf3, 0x73, 0xb4, 0x1a, 0xd2, 0x38, 0x19, 0xf9, 0x01, 0xcd, 0xdc, 0xea, 0x45, 0xd9,
+	0xe2, 0x96, 0x99, 0xc5, 0x66, 0xf1, 0x0c, 0x66, 0x34, 0x9f, 0x2e, 0x63, 0xd4, 0x95, 0xde, 0x11,
+	0xcd, 0xd4, 0xde, 0x60, 0x09, 0x0b, 0x24, 0xcf, 0x61, 0x4f, 0x57, 0x4b, 0x7b, 0xa3, 0x80, 0x60,
+	0x6f, 0x14, 0xdf, 0x96, 0xd7, 0x97, 0xfe, 0x73, 0x5e, 0xff, 0x57, 0x05, 0x35, 0x8d, 0xd7, 0xdf,
+	0x13, 0x34, 0x62, 0x4f, 0xef, 0xf6, 0x17, 0x50, 0x6d, 0x20, 0x58, 0xe1, 0x73, 0x2b, 0xd7, 0x81,
+	0x14, 0x13, 0x05, 0x42, 0xf1, 0x1d, 0xd2, 0x91, 0x79, 0x48, 0xb3, 0x8a, 0xef, 0x90, 0x8e, 0x30,
+	0x01, 0x08, 0x32, 0xb7, 0xc8, 0x20, 0x17, 0xd6, 0x9e, 0x32, 0x73, 0x2b, 0xad, 0xa7, 0xcc, 0xdc,
+	0x4a, 0xc7, 0x78, 0xe0, 0xcb, 0x1a, 0x5a, 0x2b, 0x9e, 0x46, 0x42, 0x78, 0xfb, 0x71, 0xae, 0xa2,
+	0xc5, 0x38, 0x34, 0x45, 0xca, 0xda, 0x64, 0xec, 0xad, 0x9a, 0xa3, 0x34, 0xc4, 0x64, 0x31, 0x0e,
+	0xad, 0x17, 0xbc, 0xc5, 0x6f, 0xfe, 0x82, 0x57, 0xbd, 0xe8, 0xf9, 0xa8, 0x78, 0x72, 0xad, 0x7d,
+	0xdd, 0x93, 0xeb, 0x21, 0xaa, 0xc7, 0xa9, 0x64, 0xf9, 0x29, 0x4d, 0xcc, 0x23, 0x89, 0xb5, 0x38,
+	0x05, 0x82, 0xc9, 0x94, 0x04, 0x55, 0x70, 0x0a, 0x6f, 0x22, 0xa6, 0xe4, 0x59, 0x56, 0xfe, 0xb7,
+	0xaa, 0x60, 0x0b, 0xc4, 0x04, 0x41, 0xcb, 0x54, 0x4c, 0x2d, 0x54, 0xef, 0xd3, 0xa1, 0x9f, 0x0f,
+	0x52, 0xe1, 0xae, 0x94, 0x47, 0x2a, 0x10, 0x4c, 0x56, 0xfa, 0x74, 0x48, 0x06, 0xa9, 0xba, 0xe9,
+	0x2b, 0x6e, 0xbd, 0x7c, 0x01, 0xd1, 0x3c, 0x05, 0x42, 0x1a, 0x86, 0x1a, 0x2b, 0x89, 0xfb, 0xb1,
+	0x34, 0x4f, 0x21, 0xd6, 0xae, 0x9f, 0x42, 0x98, 0xc0, 0x2b, 0xd7, 0x5d, 0xf8, 0x84, 0x09, 0x64,
+	0x39, 0xcb, 0x68, 0x1c, 0xfa, 0x11, 0x15, 0xe6, 0xe9, 0xc3, 0x9a, 0x80, 0x05, 0x62, 0x82, 0x4c,
+	0xeb, 0x4d, 0x2a, 0x9c, 0x5f, 0x56, 0x66, 0x9a, 0x5d, 0xc6, 0xdc, 0xc6, 0x45, 0xdb, 0xea, 0xd8,
+	0x6c, 0xab, 0x52, 0xc7, 0x5d, 0xc6, 0x9e, 0x6e, 0x73, 0x15, 0x46, 0x1c, 0xb3, 0xa2, 0x46, 0xf9,
+	0xdb, 0x22, 0xda, 0x2d, 0x12, 0xf9, 0x5b, 0x71, 0xa4, 0xdf, 0xfa, 0xdf, 0xcd, 0x79, 0xc6, 0x05,
+	0x4d, 0xe0, 0x2d, 0x45, 0xc6, 0x32, 0x29, 0x8a, 0x05, 0xeb, 0x2d, 0x45, 0x89, 0x31, 0xd1, 0xb0,
+	0xf3, 0x43, 0xd4, 0x08, 0x99, 0x08, 0xf2, 0x38, 0x03, 0x75, 0xb3, 0xf7, 0x2c, 0x4f, 0x58, 0x20,
+	0x26, 0x36, 0xf5, 0x5c, 0x48, 0x57, 0xbf, 0x61, 0x48, 0xc3, 0x39, 0x38, 0xbf, 0x17, 0x41, 0xaa,
+	0x6e, 0x93, 0x21, 0x83, 0x90, 0xa6, 0x9d, 0xd8, 0x9c, 0xa6, 0x56, 0x48, 0xd3, 0x4e, 0x8c, 0x09,
+	0x40, 0xce, 0x0d, 0x54, 0xeb, 0x33, 0x49, 0xdd, 0x65, 0xe5, 0xfa, 0x4b, 0xad, 0xe2, 0x5f, 0x90,
+	0xa9, 0x2f, 0x98, 0xa4, 0x76, 0xef, 0x40, 0xc6, 0x44, 0xe9, 0xdc, 0x78, 0x0d, 0x3c, 0xf7, 0xe7,
+	0x4f, 0xbc, 0x85, 0xcf, 0x7f, 0xf3, 0xca, 0xb5, 0xff, 0xfd, 0x5a, 0xe7, 0x0f, 0x0f, 0x23, 0x7e,
+	0x3a, 0x5d, 0x02, 0x5d, 0x37, 0xfd, 0x65, 0x11, 0x5d, 0x2e, 0x86, 0x39, 0xce, 0x19, 0x7b, 0xc0,
+	0xbe, 0xcf, 0xfe, 0x9e, 0x1d, 0xd7, 0xb5, 0x8b, 0x8e, 0x6b, 0x82, 0xea, 0xc5, 0xbf, 0x45, 0xe6,
+	0x18, 0xd8, 0x9d, 0x3b, 0x06, 0x6e, 0x19, 0xc2, 0xb4, 0x66, 0x33, 0x43, 0x17, 0x8a, 0xf8, 0x63,
+	0x38, 0x07, 0xa6, 0xfd, 0x7c, 0x57, 0x5f, 0xff, 0xb5, 0x82, 0xdc, 0xc2, 0xd7, 0xef, 0xa5, 0xdd,
+	0xef, 0xbb, 0xb7, 0xbf, 0xe3, 0x74, 0xdb, 0xf7, 0x3e, 0xfd, 0x6a, 0x6f, 0xe1, 0x8b, 0xaf, 0xf6,
+	0x16, 0x7e, 0xf1, 0x70, 0x6f, 0xe1, 0xd3, 0x87, 0x7b, 0x95, 0xcf, 0x1e, 0xee, 0x55, 0xfe, 0xf4,
+	0x70, 0xaf, 0xf2, 0xd1, 0xa3, 0xbd, 0x85, 0xcf, 0x1e, 0xed, 0x2d, 0x7c, 0xf1, 0x68, 0x6f, 0xe1,
+	0xa7, 0x2d, 0xbb, 0x5f, 0x28, 0x9c, 0xee, 0x77, 0xf9, 0x20, 0x0d, 0x95, 0xc3, 0x0f, 0xcd, 0x5f,
+	0x93, 0x43, 0xf5, 0xe7, 0xa4, 0xea, 0xbd, 0xb3, 0xac, 0x56, 0xef, 0xd5, 0x7f, 0x0f, 0x00, 0x82,
+	0x7f, 0xab, 0x34, 0xb7, 0x1c, 0x00, 0x00,
 }
+
+func (m *Log) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Log) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxLogIndex != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.TxLogIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LogIndex != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintCvm(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Receipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ReturnData) > 0 {
+		i -= len(m.ReturnData)
+		copy(dAtA[i:], m.ReturnData)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.ReturnData)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Callee) > 0 {
+		i -= len(m.Callee)
+		copy(dAtA[i:], m.Callee)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Callee)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ReturnData) > 0 {
+		i -= len(m.ReturnData)
+		copy(dAtA[i:], m.ReturnData)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.ReturnData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Callee) > 0 {
+		i -= len(m.Callee)
+		copy(dAtA[i:], m.Callee)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Callee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WarmStorageRead != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.WarmStorageRead))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.ColdAccountAccess != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.ColdAccountAccess))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.ColdSload != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.ColdSload))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.SstoreSentry != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.SstoreSentry))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Jumpdest != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Jumpdest))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.SstoreNoop != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.SstoreNoop))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.SstoreRefund != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.SstoreRefund))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.SstoreClear != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.SstoreClear))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.SstoreReset != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.SstoreReset))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SstoreSet != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.SstoreSet))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.Copy != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Copy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.Sha3Word != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Sha3Word))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.Sha3 != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Sha3))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.LogTopic != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.LogTopic))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.LogData != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.LogData))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.Log != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Log))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.QuadCoeffDiv != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.QuadCoeffDiv))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.Memory != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Memory))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.CallNewAccount != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.CallNewAccount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.CallValueTransfer != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.CallValueTransfer))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.ExpByte != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.ExpByte))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.Exp != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Exp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.SelfdestructRefund != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.SelfdestructRefund))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.CreateBySelfdestruct != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.CreateBySelfdestruct))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Selfdestruct != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Selfdestruct))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Create != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Create))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Call != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Call))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Calls != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Calls))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Sload != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Sload))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Balance != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ExtcodeHash != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.ExtcodeHash))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ExtcodeCopyBase != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.ExtcodeCopyBase))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExtcodeCopy != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.ExtcodeCopy))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExtcodeSize != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.ExtcodeSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExtStep != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.ExtStep))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.High != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.High))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Mid != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Mid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Low != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Low))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VeryLow != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.VeryLow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Base != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Base))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Forks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Forks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Forks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasChargeHeight != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.GasChargeHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BerlinHeight != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.BerlinHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IstanbulHeight != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.IstanbulHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiptParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiptParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiptParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IndexEnabled {
+		i--
+		if m.IndexEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RetentionBlocks != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.RetentionBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleCheckParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleCheckParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleCheckParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ThresholdScore.Size()
+		i -= size
+		if _, err := m.ThresholdScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoinsProto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SourceFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifiedContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifiedContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifiedContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CertificateId != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.CertificateId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CompilerSettings) > 0 {
+		i -= len(m.CompilerSettings)
+		copy(dAtA[i:], m.CompilerSettings)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.CompilerSettings)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CompilerVersion) > 0 {
+		i -= len(m.CompilerVersion)
+		copy(dAtA[i:], m.CompilerVersion)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.CompilerVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceFiles) > 0 {
+		for iNdEx := len(m.SourceFiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceFiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrozenContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrozenContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCvm(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Certifier) > 0 {
+		i -= len(m.Certifier)
+		copy(dAtA[i:], m.Certifier)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Certifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCvm(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Selectors[iNdEx])
+			copy(dAtA[i:], m.Selectors[iNdEx])
+			i = encodeVarintCvm(dAtA, i, uint64(len(m.Selectors[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DailyCap) > 0 {
+		for iNdEx := len(m.DailyCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsorUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsorUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Day != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PrepaidFee) > 0 {
+		for iNdEx := len(m.PrepaidFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrepaidFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.PrepaidGas != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.PrepaidGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Runs != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Runs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxRuns != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.MaxRuns))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Callee) > 0 {
+		i -= len(m.Callee)
+		copy(dAtA[i:], m.Callee)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Callee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintCvm(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractMigrationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMigrationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMigrationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Meta) > 0 {
+		for iNdEx := len(m.Meta) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Meta[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractFreezeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractFreezeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractFreezeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintCvm(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractUnfreezeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractUnfreezeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractUnfreezeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintCvm(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCvm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCvm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Log) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, b := range m.Topics {
+			l = len(b)
+			n += 1 + l + sovCvm(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovCvm(uint64(m.LogIndex))
+	}
+	if m.TxLogIndex != 0 {
+		n += 1 + sovCvm(uint64(m.TxLogIndex))
+	}
+	return n
+}
+
+func (m *Receipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovCvm(uint64(m.MsgIndex))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCvm(uint64(m.Height))
+	}
+	if m.Status != 0 {
+		n += 1 + sovCvm(uint64(m.Status))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Callee)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.ReturnData)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovCvm(uint64(m.GasUsed))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovCvm(uint64(l))
+		}
+	}
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovCvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CallReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Callee)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovCvm(uint64(m.Status))
+	}
+	l = len(m.ReturnData)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovCvm(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *GasSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Base != 0 {
+		n += 1 + sovCvm(uint64(m.Base))
+	}
+	if m.VeryLow != 0 {
+		n += 1 + sovCvm(uint64(m.VeryLow))
+	}
+	if m.Low != 0 {
+		n += 1 + sovCvm(uint64(m.Low))
+	}
+	if m.Mid != 0 {
+		n += 1 + sovCvm(uint64(m.Mid))
+	}
+	if m.High != 0 {
+		n += 1 + sovCvm(uint64(m.High))
+	}
+	if m.ExtStep != 0 {
+		n += 1 + sovCvm(uint64(m.ExtStep))
+	}
+	if m.ExtcodeSize != 0 {
+		n += 1 + sovCvm(uint64(m.ExtcodeSize))
+	}
+	if m.ExtcodeCopy != 0 {
+		n += 1 + sovCvm(uint64(m.ExtcodeCopy))
+	}
+	if m.ExtcodeCopyBase != 0 {
+		n += 1 + sovCvm(uint64(m.ExtcodeCopyBase))
+	}
+	if m.ExtcodeHash != 0 {
+		n += 1 + sovCvm(uint64(m.ExtcodeHash))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovCvm(uint64(m.Balance))
+	}
+	if m.Sload != 0 {
+		n += 1 + sovCvm(uint64(m.Sload))
+	}
+	if m.Calls != 0 {
+		n += 1 + sovCvm(uint64(m.Calls))
+	}
+	if m.Call != 0 {
+		n += 1 + sovCvm(uint64(m.Call))
+	}
+	if m.Create != 0 {
+		n += 1 + sovCvm(uint64(m.Create))
+	}
+	if m.Selfdestruct != 0 {
+		n += 2 + sovCvm(uint64(m.Selfdestruct))
+	}
+	if m.CreateBySelfdestruct != 0 {
+		n += 2 + sovCvm(uint64(m.CreateBySelfdestruct))
+	}
+	if m.SelfdestructRefund != 0 {
+		n += 2 + sovCvm(uint64(m.SelfdestructRefund))
+	}
+	if m.Exp != 0 {
+		n += 2 + sovCvm(uint64(m.Exp))
+	}
+	if m.ExpByte != 0 {
+		n += 2 + sovCvm(uint64(m.ExpByte))
+	}
+	if m.CallValueTransfer != 0 {
+		n += 2 + sovCvm(uint64(m.CallValueTransfer))
+	}
+	if m.CallNewAccount != 0 {
+		n += 2 + sovCvm(uint64(m.CallNewAccount))
+	}
+	if m.Memory != 0 {
+		n += 2 + sovCvm(uint64(m.Memory))
+	}
+	if m.QuadCoeffDiv != 0 {
+		n += 2 + sovCvm(uint64(m.QuadCoeffDiv))
+	}
+	if m.Log != 0 {
+		n += 2 + sovCvm(uint64(m.Log))
+	}
+	if m.LogData != 0 {
+		n += 2 + sovCvm(uint64(m.LogData))
+	}
+	if m.LogTopic != 0 {
+		n += 2 + sovCvm(uint64(m.LogTopic))
+	}
+	if m.Sha3 != 0 {
+		n += 2 + sovCvm(uint64(m.Sha3))
+	}
+	if m.Sha3Word != 0 {
+		n += 2 + sovCvm(uint64(m.Sha3Word))
+	}
+	if m.Copy != 0 {
+		n += 2 + sovCvm(uint64(m.Copy))
+	}
+	if m.SstoreSet != 0 {
+		n += 2 + sovCvm(uint64(m.SstoreSet))
+	}
+	if m.SstoreReset != 0 {
+		n += 2 + sovCvm(uint64(m.SstoreReset))
+	}
+	if m.SstoreClear != 0 {
+		n += 2 + sovCvm(uint64(m.SstoreClear))
+	}
+	if m.SstoreRefund != 0 {
+		n += 2 + sovCvm(uint64(m.SstoreRefund))
+	}
+	if m.SstoreNoop != 0 {
+		n += 2 + sovCvm(uint64(m.SstoreNoop))
+	}
+	if m.Jumpdest != 0 {
+		n += 2 + sovCvm(uint64(m.Jumpdest))
+	}
+	if m.SstoreSentry != 0 {
+		n += 2 + sovCvm(uint64(m.SstoreSentry))
+	}
+	if m.ColdSload != 0 {
+		n += 2 + sovCvm(uint64(m.ColdSload))
+	}
+	if m.ColdAccountAccess != 0 {
+		n += 2 + sovCvm(uint64(m.ColdAccountAccess))
+	}
+	if m.WarmStorageRead != 0 {
+		n += 2 + sovCvm(uint64(m.WarmStorageRead))
+	}
+	return n
+}
+
+func (m *Forks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IstanbulHeight != 0 {
+		n += 1 + sovCvm(uint64(m.IstanbulHeight))
+	}
+	if m.BerlinHeight != 0 {
+		n += 1 + sovCvm(uint64(m.BerlinHeight))
+	}
+	if m.GasChargeHeight != 0 {
+		n += 1 + sovCvm(uint64(m.GasChargeHeight))
+	}
+	return n
+}
+
+func (m *ReceiptParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.RetentionBlocks != 0 {
+		n += 1 + sovCvm(uint64(m.RetentionBlocks))
+	}
+	if m.IndexEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *OracleCheckParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.ThresholdScore.Size()
+	n += 1 + l + sovCvm(uint64(l))
+	return n
+}
+
+func (m *CoinsProto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovCvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SourceFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	return n
+}
+
+func (m *VerifiedContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	if len(m.SourceFiles) > 0 {
+		for _, e := range m.SourceFiles {
+			l = e.Size()
+			n += 1 + l + sovCvm(uint64(l))
+		}
+	}
+	l = len(m.CompilerVersion)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.CompilerSettings)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	if m.CertificateId != 0 {
+		n += 1 + sovCvm(uint64(m.CertificateId))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	return n
+}
+
+func (m *FrozenContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Certifier)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovCvm(uint64(l))
+	return n
+}
+
+func (m *Sponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	if len(m.DailyCap) > 0 {
+		for _, e := range m.DailyCap {
+			l = e.Size()
+			n += 1 + l + sovCvm(uint64(l))
+		}
+	}
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovCvm(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovCvm(uint64(l))
+	return n
+}
+
+func (m *SponsorUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovCvm(uint64(m.Day))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovCvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduledCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCvm(uint64(m.Id))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Callee)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovCvm(uint64(m.Interval))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovCvm(uint64(m.NextHeight))
+	}
+	if m.MaxRuns != 0 {
+		n += 1 + sovCvm(uint64(m.MaxRuns))
+	}
+	if m.Runs != 0 {
+		n += 1 + sovCvm(uint64(m.Runs))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovCvm(uint64(m.GasLimit))
+	}
+	if m.PrepaidGas != 0 {
+		n += 1 + sovCvm(uint64(m.PrepaidGas))
+	}
+	if len(m.PrepaidFee) > 0 {
+		for _, e := range m.PrepaidFee {
+			l = e.Size()
+			n += 1 + l + sovCvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractMigrationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	if len(m.Meta) > 0 {
+		for _, e := range m.Meta {
+			l = e.Size()
+			n += 1 + l + sovCvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractFreezeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovCvm(uint64(l))
+	return n
+}
+
+func (m *ContractUnfreezeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovCvm(uint64(l))
+	}
+	return n
+}
+
+func sovCvm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCvm(x uint64) (n int) {
+	return sovCvm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Log) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Log: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, make([]byte, postIndex-iNdEx))
+			copy(m.Topics[len(m.Topics)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxLogIndex", wireType)
+			}
+			m.TxLogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxLogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Receipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Receipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Receipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnData == nil {
+				m.ReturnData = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = append(m.Calls, CallReceipt{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnData == nil {
+				m.ReturnData = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			m.Base = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Base |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeryLow", wireType)
+			}
+			m.VeryLow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VeryLow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			m.Low = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Low |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mid", wireType)
+			}
+			m.Mid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			m.High = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.High |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtStep", wireType)
+			}
+			m.ExtStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtStep |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtcodeSize", wireType)
+			}
+			m.ExtcodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtcodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtcodeCopy", wireType)
+			}
+			m.ExtcodeCopy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtcodeCopy |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtcodeCopyBase", wireType)
+			}
+			m.ExtcodeCopyBase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtcodeCopyBase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtcodeHash", wireType)
+			}
+			m.ExtcodeHash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtcodeHash |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sload", wireType)
+			}
+			m.Sload = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sload |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			m.Calls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Calls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			m.Call = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Call |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			m.Create = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Create |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selfdestruct", wireType)
+			}
+			m.Selfdestruct = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Selfdestruct |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBySelfdestruct", wireType)
+			}
+			m.CreateBySelfdestruct = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateBySelfdestruct |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfdestructRefund", wireType)
+			}
+			m.SelfdestructRefund = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfdestructRefund |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exp", wireType)
+			}
+			m.Exp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpByte", wireType)
+			}
+			m.ExpByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallValueTransfer", wireType)
+			}
+			m.CallValueTransfer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallValueTransfer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallNewAccount", wireType)
+			}
+			m.CallNewAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallNewAccount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			m.Memory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Memory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuadCoeffDiv", wireType)
+			}
+			m.QuadCoeffDiv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuadCoeffDiv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			m.Log = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Log |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogData", wireType)
+			}
+			m.LogData = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogData |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogTopic", wireType)
+			}
+			m.LogTopic = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogTopic |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha3", wireType)
+			}
+			m.Sha3 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sha3 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha3Word", wireType)
+			}
+			m.Sha3Word = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sha3Word |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Copy", wireType)
+			}
+			m.Copy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Copy |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SstoreSet", wireType)
+			}
+			m.SstoreSet = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SstoreSet |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SstoreReset", wireType)
+			}
+			m.SstoreReset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SstoreReset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SstoreClear", wireType)
+			}
+			m.SstoreClear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SstoreClear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SstoreRefund", wireType)
+			}
+			m.SstoreRefund = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SstoreRefund |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SstoreNoop", wireType)
+			}
+			m.SstoreNoop = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SstoreNoop |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jumpdest", wireType)
+			}
+			m.Jumpdest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Jumpdest |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SstoreSentry", wireType)
+			}
+			m.SstoreSentry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SstoreSentry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColdSload", wireType)
+			}
+			m.ColdSload = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ColdSload |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColdAccountAccess", wireType)
+			}
+			m.ColdAccountAccess = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ColdAccountAccess |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarmStorageRead", wireType)
+			}
+			m.WarmStorageRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarmStorageRead |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Forks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Forks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Forks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IstanbulHeight", wireType)
+			}
+			m.IstanbulHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IstanbulHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BerlinHeight", wireType)
+			}
+			m.BerlinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BerlinHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasChargeHeight", wireType)
+			}
+			m.GasChargeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasChargeHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiptParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiptParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiptParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBlocks", wireType)
+			}
+			m.RetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IndexEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleCheckParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleCheckParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleCheckParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ThresholdScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoinsProto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoinsProto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoinsProto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifiedContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifiedContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifiedContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceFiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceFiles = append(m.SourceFiles, SourceFile{})
+			if err := m.SourceFiles[len(m.SourceFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompilerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompilerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompilerSettings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompilerSettings = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateId", wireType)
+			}
+			m.CertificateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CertificateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrozenContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyCap = append(m.DailyCap, types.Coin{})
+			if err := m.DailyCap[len(m.DailyCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SponsorUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsorUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsorUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
+			}
+			m.MaxRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRuns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			m.Runs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidGas", wireType)
+			}
+			m.PrepaidGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrepaidGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrepaidFee = append(m.PrepaidFee, types.Coin{})
+			if err := m.PrepaidFee[len(m.PrepaidFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractMigrationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMigrationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMigrationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = append(m.Meta, &payload.ContractMeta{})
+			if err := m.Meta[len(m.Meta)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractFreezeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractFreezeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractFreezeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractUnfreezeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractUnfreezeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractUnfreezeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCvm(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCvm
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCvm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCvm
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCvm
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCvm
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCvm        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCvm          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCvm = fmt.Errorf("proto: unexpected end of group")
+)
@@ -9,6 +9,29 @@ import (
 // BurrowErrorCodeStart is the default sdk code type.
 const BurrowErrorCodeStart = 200
 
+var (
+	ErrNotContract  = sdkerrors.Register(ModuleName, 101, "address is not a contract")
+	ErrUnauthorized = sdkerrors.Register(ModuleName, 102, "sender is not the admin of the contract")
+
+	ErrUnauthorizedVerifier = sdkerrors.Register(ModuleName, 103, "sender is neither the admin of the contract nor a certifier")
+	ErrCertificateMismatch  = sdkerrors.Register(ModuleName, 104, "certificate does not certify the code of the contract")
+
+	ErrContractFrozen     = sdkerrors.Register(ModuleName, 105, "contract is frozen")
+	ErrNotFrozen          = sdkerrors.Register(ModuleName, 106, "contract is not frozen")
+	ErrNotCertifier       = sdkerrors.Register(ModuleName, 107, "sender is not a certifier")
+	ErrFrozenByGovernance = sdkerrors.Register(ModuleName, 108, "contract is frozen by the governance")
+
+	ErrOracleScoreTooLow = sdkerrors.Register(ModuleName, 109, "oracle score of the contract is below the threshold score")
+
+	ErrInvalidIBCHook = sdkerrors.Register(ModuleName, 110, "invalid contract call of the transfer receiver")
+
+	ErrNotSponsored = sdkerrors.Register(ModuleName, 111, "contract is not sponsored")
+
+	ErrScheduledCallNotFound = sdkerrors.Register(ModuleName, 112, "scheduled call not found")
+
+	ErrAddressCollision = sdkerrors.Register(ModuleName, 113, "contract address is already in use")
+)
+
 // ErrCodedError wraps execution CodedError into sdk Error.
 func ErrCodedError(error errors.CodedError) *sdkerrors.Error {
 	return sdkerrors.New(ModuleName, BurrowErrorCodeStart+error.ErrorCode().Number, error.ErrorCode().Name)
@@ -1,11 +1,115 @@
 package types
 
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	EventTypeCVMEvent              = "cvm-event"
 	EventTypeCall                  = "call"
 	EventTypeDeploy                = "deploy"
+	EventTypeMigrate               = "migrate"
 	EventTypeInternalCall          = "internal-call"
+	EventTypeSweep                 = "sweep"
+	EventTypeVerifyContract        = "verify-contract"
+	EventTypeFreeze                = "freeze"
+	EventTypeUnfreeze              = "unfreeze"
+	EventTypeDispatch              = "dispatch"
+	EventTypeIBCHook               = "ibc-hook"
+	EventTypeSetSponsorship        = "set-sponsorship"
+	EventTypeRevokeSponsorship     = "revoke-sponsorship"
+	EventTypeSponsor               = "sponsor"
+	EventTypeScheduleCall          = "schedule-call"
+	EventTypeScheduledCall         = "scheduled-call"
+	EventTypeEndScheduledCall      = "end-scheduled-call"
 	AttributeKeyNewContractAddress = "new-contract-address"
 	AttributeKeyRecipient          = "recipient"
 	AttributeKeyValue              = "value"
+	AttributeKeyAddress            = "address"
+	AttributeKeyData               = "data"
+	AttributeKeyLogIndex           = "log-index"
+	AttributeKeyTxLogIndex         = "tx-log-index"
+	AttributeKeyContract           = "contract"
+	AttributeKeyAdmin              = "admin"
+	AttributeKeyCodeHash           = "code-hash"
+	AttributeKeyCertificateID      = "certificate-id"
+	AttributeKeySubmitter          = "submitter"
+	AttributeKeyReason             = "reason"
+	AttributeKeyCertifier          = "certifier"
+	AttributeKeyExpiry             = "expiry"
+	AttributeKeyMessage            = "message"
+	AttributeKeyCaller             = "caller"
+	AttributeKeySuccess            = "success"
+	AttributeKeySponsor            = "sponsor"
+	AttributeKeyDailyCap           = "daily-cap"
+	AttributeKeyUser               = "user"
+	AttributeKeyFee                = "fee"
+	AttributeKeyScheduledCallID    = "scheduled-call-id"
+	AttributeKeyGasUsed            = "gas-used"
+
+	// attributeKeyTopicPrefix is the prefix of the topic attribute keys, which are suffixed
+	// with the position of the topic.
+	attributeKeyTopicPrefix = "topic-"
 )
+
+// AttributeKeyTopic returns the attribute key of the i-th topic of a log.
+func AttributeKeyTopic(i int) string {
+	return attributeKeyTopicPrefix + strconv.Itoa(i)
+}
+
+// NewLogEvent returns the CVM event of a log. Topics and data are hex encoded.
+func NewLogEvent(log Log) sdk.Event {
+	attrs := []sdk.Attribute{sdk.NewAttribute(AttributeKeyAddress, log.Address)}
+	for i, topic := range log.Topics {
+		attrs = append(attrs, sdk.NewAttribute(AttributeKeyTopic(i), strings.ToUpper(hex.EncodeToString(topic))))
+	}
+	attrs = append(attrs,
+		sdk.NewAttribute(AttributeKeyData, strings.ToUpper(hex.EncodeToString(log.Data))),
+		sdk.NewAttribute(AttributeKeyLogIndex, strconv.FormatUint(log.LogIndex, 10)),
+		sdk.NewAttribute(AttributeKeyTxLogIndex, strconv.FormatUint(log.TxLogIndex, 10)),
+	)
+	return sdk.NewEvent(EventTypeCVMEvent, attrs...)
+}
+
+// ParseLogEvents parses logs from the attributes of CVM events. The attributes of several events
+// may be concatenated, as in the stringified events of transaction logs, in which case each log
+// starts at its address attribute.
+func ParseLogEvents(attrs []sdk.Attribute) ([]Log, error) {
+	var logs []Log
+	for _, attr := range attrs {
+		if attr.Key == AttributeKeyAddress {
+			logs = append(logs, Log{Address: attr.Value})
+			continue
+		}
+		if len(logs) == 0 {
+			return nil, fmt.Errorf("CVM event attribute %s precedes the address", attr.Key)
+		}
+		log := &logs[len(logs)-1]
+		var err error
+		switch {
+		case attr.Key == AttributeKeyData:
+			log.Data, err = hex.DecodeString(attr.Value)
+		case attr.Key == AttributeKeyLogIndex:
+			log.LogIndex, err = strconv.ParseUint(attr.Value, 10, 64)
+		case attr.Key == AttributeKeyTxLogIndex:
+			log.TxLogIndex, err = strconv.ParseUint(attr.Value, 10, 64)
+		case strings.HasPrefix(attr.Key, attributeKeyTopicPrefix):
+			var topic []byte
+			if attr.Key != AttributeKeyTopic(len(log.Topics)) {
+				err = errors.New("topics are out of order")
+			} else if topic, err = hex.DecodeString(attr.Value); err == nil {
+				log.Topics = append(log.Topics, topic)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CVM event attribute %s: %v", attr.Key, err)
+		}
+	}
+	return logs, nil
+}
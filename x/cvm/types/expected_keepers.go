@@ -1,13 +1,23 @@
 package types
 
 import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	certtypes "github.com/certikfoundation/shentu/x/cert/types"
+	oracletypes "github.com/certikfoundation/shentu/x/oracle/types"
+	shieldtypes "github.com/certikfoundation/shentu/x/shield/types"
 )
 
 // ParamSubspace defines the expected Subspace interface for parameters (noalias)
 type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
+	Has(ctx sdk.Context, key []byte) bool
 	Set(ctx sdk.Context, key []byte, param interface{})
 }
 
@@ -25,17 +35,24 @@ type BankKeeper interface {
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SetBalances(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error
+	SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance sdk.Coin) error
+	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
+	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
 	LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
 // DistributionKeeper defines the expected distribution keeper (noalias)
 type DistributionKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
+	GetDelegatorWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress) sdk.AccAddress
 }
 
 // CertKeeper defines the expected cert keeper (noalias)
@@ -43,9 +60,35 @@ type CertKeeper interface {
 	IsCertified(ctx sdk.Context, content string, certType string) bool
 	IsContentCertified(ctx sdk.Context, content string) bool
 	IsCertifier(ctx sdk.Context, addr sdk.AccAddress) bool
+	GetCertificateByID(ctx sdk.Context, id uint64) (certtypes.Certificate, error)
+}
+
+// OracleKeeper defines the expected oracle keeper (noalias)
+type OracleKeeper interface {
+	GetTask(ctx sdk.Context, contract, function string) (oracletypes.Task, error)
+	GetTaskParams(ctx sdk.Context) oracletypes.TaskParams
+}
+
+// ShieldKeeper defines the expected shield keeper (noalias)
+type ShieldKeeper interface {
+	GetPool(ctx sdk.Context, id uint64) (shieldtypes.Pool, bool)
+	GetPurchaseList(ctx sdk.Context, poolID uint64, purchaser sdk.AccAddress) (shieldtypes.PurchaseList, bool)
 }
 
 // StakingKeeper defines the expected staking keeper
 type StakingKeeper interface {
 	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation stakingtypes.Delegation, found bool)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc stakingtypes.BondStatus,
+		validator stakingtypes.Validator, subtractAccount bool) (newShares sdk.Dec, err error)
+	ValidateUnbondAmount(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt sdk.Int) (shares sdk.Dec, err error)
+	Undelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec) (time.Time, error)
+	BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress,
+		sharesAmount sdk.Dec) (completionTime time.Time, errSdk error)
+}
+
+// MsgServiceRouter defines the expected router of the Msg service messages (noalias)
+type MsgServiceRouter interface {
+	Handler(methodName string) baseapp.MsgServiceHandler
 }
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+)
+
+var (
+	// errorSelector is the selector of the Solidity Error(string) revert reason.
+	errorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	// panicSelector is the selector of the Solidity Panic(uint256) revert reason.
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// revertDataPrefix precedes the hex encoded revert data in the error message of a failure.
+const revertDataPrefix = " (revert data 0x"
+
+// panicReasons describe the Solidity panic codes.
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler inserted panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized function",
+}
+
+// NewCallFailure returns the failure of a CVM call with the error code of the execution and
+// its output, which is the revert data of reverted executions.
+func NewCallFailure(err errors.CodedError, output []byte) *CallFailure {
+	failure := &CallFailure{
+		Code: err.ErrorCode().Number,
+	}
+	if err.ErrorCode().Equal(errors.Codes.ExecutionReverted) && len(output) > 0 {
+		failure.RevertData = output
+		failure.Reason = DecodeRevertReason(output)
+	}
+	return failure
+}
+
+// DecodeRevertReason decodes the Error(string) or Panic(uint256) reason from the revert data.
+// It returns an empty string if the data does not hold either of them.
+func DecodeRevertReason(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, errorSelector):
+		reason, err := abi.UnpackRevert(data)
+		if err != nil || reason == nil {
+			return ""
+		}
+		return *reason
+	case bytes.HasPrefix(data, panicSelector) && len(data) == len(panicSelector)+32:
+		code := new(big.Int).SetBytes(data[len(panicSelector):])
+		if !code.IsUint64() {
+			return fmt.Sprintf("panic: 0x%x", code)
+		}
+		description, ok := panicReasons[code.Uint64()]
+		if !ok {
+			return fmt.Sprintf("panic: 0x%x", code)
+		}
+		return fmt.Sprintf("panic: 0x%x (%s)", code, description)
+	default:
+		return ""
+	}
+}
+
+// ParseCallFailure returns the failure of a CVM call from the ABCI error of its failed transaction,
+// which is made of the codespace, the code and the log of the error, as failed transactions have no
+// message responses. It returns nil if the error is not a CVM execution error.
+func ParseCallFailure(codespace string, code uint32, log string) *CallFailure {
+	if codespace != ModuleName || code < BurrowErrorCodeStart || errors.Codes.Get(code-BurrowErrorCodeStart) == nil {
+		return nil
+	}
+	failure := &CallFailure{
+		Code: code - BurrowErrorCodeStart,
+	}
+	i := strings.LastIndex(log, revertDataPrefix)
+	if i < 0 {
+		return failure
+	}
+	data := log[i+len(revertDataPrefix):]
+	if j := strings.IndexByte(data, ')'); j >= 0 {
+		if bz, err := hex.DecodeString(data[:j]); err == nil {
+			failure.RevertData = bz
+			failure.Reason = DecodeRevertReason(bz)
+		}
+	}
+	return failure
+}
+
+// Error implements the error interface, so that a failed call fails its transaction.
+func (f *CallFailure) Error() string {
+	msg := "unknown error"
+	if code := errors.Codes.Get(f.Code); code != nil {
+		msg = code.Name
+	}
+	if f.Reason != "" {
+		msg = fmt.Sprintf("%s: %s", msg, f.Reason)
+	}
+	if len(f.RevertData) > 0 {
+		msg = fmt.Sprintf("%s%s%x)", msg, revertDataPrefix, f.RevertData)
+	}
+	return msg
+}
+
+// ABCICode returns the ABCI error code of the failure, which matches ErrCodedError.
+func (f *CallFailure) ABCICode() uint32 {
+	return BurrowErrorCodeStart + f.Code
+}
+
+// Codespace returns the codespace of the failure.
+func (f *CallFailure) Codespace() string {
+	return ModuleName
+}
@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type Contracts = []Contract
@@ -9,16 +12,35 @@ type Contracts = []Contract
 type Metadatas = []Metadata
 
 // NewGenesisState creates a new GenesisState object.
-func NewGenesisState(rate uint64) GenesisState {
+func NewGenesisState(rate uint64, receiptParams ReceiptParams, transactionGasLimit uint64, gasSchedule GasSchedule,
+	forks Forks, certifierFreezeDuration time.Duration, oracleCheckParams OracleCheckParams,
+	dispatchAllowlist []string, scheduleBlockGasLimit uint64) GenesisState {
 	return GenesisState{
-		GasRate: rate,
+		GasRate:                 rate,
+		ReceiptParams:           receiptParams,
+		TransactionGasLimit:     transactionGasLimit,
+		GasSchedule:             gasSchedule,
+		Forks:                   forks,
+		CertifierFreezeDuration: certifierFreezeDuration,
+		OracleCheckParams:       oracleCheckParams,
+		DispatchAllowlist:       dispatchAllowlist,
+		ScheduleBlockGasLimit:   scheduleBlockGasLimit,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		GasRate: DefaultGasRate,
+		GasRate:                 DefaultGasRate,
+		ReceiptParams:           DefaultReceiptParams(),
+		TransactionGasLimit:     DefaultTransactionGasLimit,
+		GasSchedule:             DefaultGasSchedule(),
+		Forks:                   DefaultForks(),
+		CertifierFreezeDuration: DefaultCertifierFreezeDuration,
+		OracleCheckParams:       DefaultOracleCheckParams(),
+		DispatchAllowlist:       DefaultDispatchAllowlist(),
+		ScheduleBlockGasLimit:   DefaultScheduleBlockGasLimit,
+		NextScheduledCallId:     1,
 	}
 }
 
@@ -28,6 +50,91 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("failed to validate %s genesis state: GasRate is too low", ModuleName)
 	}
 
+	if err := validateReceiptParams(gs.ReceiptParams); err != nil {
+		return fmt.Errorf("failed to validate %s genesis state: %w", ModuleName, err)
+	}
+
+	if err := validateTransactionGasLimit(gs.TransactionGasLimit); err != nil {
+		return fmt.Errorf("failed to validate %s genesis state: %w", ModuleName, err)
+	}
+
+	if err := validateGasSchedule(gs.GasSchedule); err != nil {
+		return fmt.Errorf("failed to validate %s genesis state: %w", ModuleName, err)
+	}
+
+	if err := validateForks(gs.Forks); err != nil {
+		return fmt.Errorf("failed to validate %s genesis state: %w", ModuleName, err)
+	}
+
+	if err := validateCertifierFreezeDuration(gs.CertifierFreezeDuration); err != nil {
+		return fmt.Errorf("failed to validate %s genesis state: %w", ModuleName, err)
+	}
+
+	if err := validateOracleCheckParams(gs.OracleCheckParams); err != nil {
+		return fmt.Errorf("failed to validate %s genesis state: %w", ModuleName, err)
+	}
+
+	if err := validateDispatchAllowlist(gs.DispatchAllowlist); err != nil {
+		return fmt.Errorf("failed to validate %s genesis state: %w", ModuleName, err)
+	}
+
+	if err := validateScheduleBlockGasLimit(gs.ScheduleBlockGasLimit); err != nil {
+		return fmt.Errorf("failed to validate %s genesis state: %w", ModuleName, err)
+	}
+
+	if !gs.SweptFunds.IsValid() {
+		return fmt.Errorf("failed to validate %s genesis state: invalid swept funds %s", ModuleName, gs.SweptFunds)
+	}
+
+	for _, contract := range gs.Contracts {
+		if contract.Admin == "" {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(contract.Admin); err != nil {
+			return fmt.Errorf("failed to validate %s genesis state: invalid admin of contract %s: %w", ModuleName, contract.Address, err)
+		}
+	}
+
+	for _, verified := range gs.VerifiedContracts {
+		if err := verified.Validate(); err != nil {
+			return fmt.Errorf("failed to validate %s genesis state: invalid verified contract: %w", ModuleName, err)
+		}
+	}
+
+	for _, frozen := range gs.FrozenContracts {
+		if _, err := sdk.AccAddressFromBech32(frozen.Address); err != nil {
+			return fmt.Errorf("failed to validate %s genesis state: invalid frozen contract: %w", ModuleName, err)
+		}
+		if frozen.Certifier == "" {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(frozen.Certifier); err != nil {
+			return fmt.Errorf("failed to validate %s genesis state: invalid certifier of frozen contract %s: %w", ModuleName, frozen.Address, err)
+		}
+	}
+
+	for _, sponsorship := range gs.Sponsorships {
+		if err := sponsorship.Validate(); err != nil {
+			return fmt.Errorf("failed to validate %s genesis state: invalid sponsorship: %w", ModuleName, err)
+		}
+	}
+
+	for _, usage := range gs.SponsorUsages {
+		if err := usage.Validate(); err != nil {
+			return fmt.Errorf("failed to validate %s genesis state: invalid sponsor usage: %w", ModuleName, err)
+		}
+	}
+
+	for _, call := range gs.ScheduledCalls {
+		if err := call.Validate(); err != nil {
+			return fmt.Errorf("failed to validate %s genesis state: invalid scheduled call %d: %w", ModuleName, call.Id, err)
+		}
+		if call.Id == 0 || call.Id >= gs.NextScheduledCallId {
+			return fmt.Errorf("failed to validate %s genesis state: scheduled call ID %d is not below the next ID %d",
+				ModuleName, call.Id, gs.NextScheduledCallId)
+		}
+	}
+
 	for _, metadata := range gs.Metadatas {
 		if len(metadata.Hash) != 32 {
 			return fmt.Errorf("failed to validate %s genesis state: A metadata hash is not 256 bits", ModuleName)
@@ -5,9 +5,13 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	acm "github.com/hyperledger/burrow/acm"
 	github_com_hyperledger_burrow_acm "github.com/hyperledger/burrow/acm"
 	github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
@@ -15,6 +19,7 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -22,6 +27,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,9 +37,27 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the gov module's genesis state.
 type GenesisState struct {
-	GasRate   uint64    `protobuf:"varint,1,opt,name=gas_rate,json=gasRate,proto3" json:"gas_rate,omitempty" yaml:"gas_rate"`
-	Contracts Contracts `protobuf:"bytes,2,rep,name=contracts,proto3,castrepeated=Contracts" json:"contracts"`
-	Metadatas Metadatas `protobuf:"bytes,3,rep,name=metadatas,proto3,castrepeated=Metadatas" json:"metadatas"`
+	GasRate             uint64        `protobuf:"varint,1,opt,name=gas_rate,json=gasRate,proto3" json:"gas_rate,omitempty" yaml:"gas_rate"`
+	Contracts           Contracts     `protobuf:"bytes,2,rep,name=contracts,proto3,castrepeated=Contracts" json:"contracts"`
+	Metadatas           Metadatas     `protobuf:"bytes,3,rep,name=metadatas,proto3,castrepeated=Metadatas" json:"metadatas"`
+	ReceiptParams       ReceiptParams `protobuf:"bytes,4,opt,name=receipt_params,json=receiptParams,proto3" json:"receipt_params" yaml:"receipt_params"`
+	TransactionGasLimit uint64        `protobuf:"varint,5,opt,name=transaction_gas_limit,json=transactionGasLimit,proto3" json:"transaction_gas_limit,omitempty" yaml:"transaction_gas_limit"`
+	GasSchedule         GasSchedule   `protobuf:"bytes,6,opt,name=gas_schedule,json=gasSchedule,proto3" json:"gas_schedule" yaml:"gas_schedule"`
+	Forks               Forks         `protobuf:"bytes,7,opt,name=forks,proto3" json:"forks" yaml:"forks"`
+	// swept_funds is the total amount of coins swept from the zero address to the community pool.
+	SweptFunds              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=swept_funds,json=sweptFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swept_funds" yaml:"swept_funds"`
+	VerifiedContracts       []VerifiedContract                       `protobuf:"bytes,9,rep,name=verified_contracts,json=verifiedContracts,proto3" json:"verified_contracts" yaml:"verified_contracts"`
+	FrozenContracts         []FrozenContract                         `protobuf:"bytes,10,rep,name=frozen_contracts,json=frozenContracts,proto3" json:"frozen_contracts" yaml:"frozen_contracts"`
+	CertifierFreezeDuration time.Duration                            `protobuf:"bytes,11,opt,name=certifier_freeze_duration,json=certifierFreezeDuration,proto3,stdduration" json:"certifier_freeze_duration" yaml:"certifier_freeze_duration"`
+	OracleCheckParams       OracleCheckParams                        `protobuf:"bytes,12,opt,name=oracle_check_params,json=oracleCheckParams,proto3" json:"oracle_check_params" yaml:"oracle_check_params"`
+	// dispatch_allowlist is the Msg service methods of the messages contracts can dispatch.
+	DispatchAllowlist []string       `protobuf:"bytes,13,rep,name=dispatch_allowlist,json=dispatchAllowlist,proto3" json:"dispatch_allowlist,omitempty" yaml:"dispatch_allowlist"`
+	Sponsorships      []Sponsorship  `protobuf:"bytes,14,rep,name=sponsorships,proto3" json:"sponsorships" yaml:"sponsorships"`
+	SponsorUsages     []SponsorUsage `protobuf:"bytes,15,rep,name=sponsor_usages,json=sponsorUsages,proto3" json:"sponsor_usages" yaml:"sponsor_usages"`
+	// schedule_block_gas_limit is the gas the scheduled calls can use at the beginning of each block.
+	ScheduleBlockGasLimit uint64          `protobuf:"varint,16,opt,name=schedule_block_gas_limit,json=scheduleBlockGasLimit,proto3" json:"schedule_block_gas_limit,omitempty" yaml:"schedule_block_gas_limit"`
+	ScheduledCalls        []ScheduledCall `protobuf:"bytes,17,rep,name=scheduled_calls,json=scheduledCalls,proto3" json:"scheduled_calls" yaml:"scheduled_calls"`
+	NextScheduledCallId   uint64          `protobuf:"varint,18,opt,name=next_scheduled_call_id,json=nextScheduledCallId,proto3" json:"next_scheduled_call_id,omitempty" yaml:"next_scheduled_call_id"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,16 +110,123 @@ func (m *GenesisState) GetMetadatas() Metadatas {
 	return nil
 }
 
+func (m *GenesisState) GetReceiptParams() ReceiptParams {
+	if m != nil {
+		return m.ReceiptParams
+	}
+	return ReceiptParams{}
+}
+
+func (m *GenesisState) GetTransactionGasLimit() uint64 {
+	if m != nil {
+		return m.TransactionGasLimit
+	}
+	return 0
+}
+
+func (m *GenesisState) GetGasSchedule() GasSchedule {
+	if m != nil {
+		return m.GasSchedule
+	}
+	return GasSchedule{}
+}
+
+func (m *GenesisState) GetForks() Forks {
+	if m != nil {
+		return m.Forks
+	}
+	return Forks{}
+}
+
+func (m *GenesisState) GetSweptFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SweptFunds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVerifiedContracts() []VerifiedContract {
+	if m != nil {
+		return m.VerifiedContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenContracts() []FrozenContract {
+	if m != nil {
+		return m.FrozenContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCertifierFreezeDuration() time.Duration {
+	if m != nil {
+		return m.CertifierFreezeDuration
+	}
+	return 0
+}
+
+func (m *GenesisState) GetOracleCheckParams() OracleCheckParams {
+	if m != nil {
+		return m.OracleCheckParams
+	}
+	return OracleCheckParams{}
+}
+
+func (m *GenesisState) GetDispatchAllowlist() []string {
+	if m != nil {
+		return m.DispatchAllowlist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsorships() []Sponsorship {
+	if m != nil {
+		return m.Sponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsorUsages() []SponsorUsage {
+	if m != nil {
+		return m.SponsorUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduleBlockGasLimit() uint64 {
+	if m != nil {
+		return m.ScheduleBlockGasLimit
+	}
+	return 0
+}
+
+func (m *GenesisState) GetScheduledCalls() []ScheduledCall {
+	if m != nil {
+		return m.ScheduledCalls
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduledCallId() uint64 {
+	if m != nil {
+		return m.NextScheduledCallId
+	}
+	return 0
+}
+
 func (*GenesisState) XXX_MessageName() string {
 	return "shentu.cvm.v1alpha1.GenesisState"
 }
 
 type Contract struct {
-	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address" yaml:"address"`
-	Code    CVMCode                                      `protobuf:"bytes,2,opt,name=code,proto3" json:"code" yaml:"code"`
-	Storage []Storage                                    `protobuf:"bytes,3,rep,name=storage,proto3" json:"storage" yaml:"storage"`
-	Abi     []byte                                       `protobuf:"bytes,4,opt,name=abi,proto3" json:"abi,omitempty" yaml:"abi"`
-	Meta    []ContractMeta                               `protobuf:"bytes,5,rep,name=meta,proto3" json:"meta" yaml:"contract_meta"`
+	Address  github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address" yaml:"address"`
+	Code     CVMCode                                      `protobuf:"bytes,2,opt,name=code,proto3" json:"code" yaml:"code"`
+	Storage  []Storage                                    `protobuf:"bytes,3,rep,name=storage,proto3" json:"storage" yaml:"storage"`
+	Abi      []byte                                       `protobuf:"bytes,4,opt,name=abi,proto3" json:"abi,omitempty" yaml:"abi"`
+	Meta     []ContractMeta                               `protobuf:"bytes,5,rep,name=meta,proto3" json:"meta" yaml:"contract_meta"`
+	Admin    string                                       `protobuf:"bytes,6,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	Deployer string                                       `protobuf:"bytes,7,opt,name=deployer,proto3" json:"deployer,omitempty" yaml:"deployer"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -155,6 +286,20 @@ func (m *Contract) GetMeta() []ContractMeta {
 	return nil
 }
 
+func (m *Contract) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *Contract) GetDeployer() string {
+	if m != nil {
+		return m.Deployer
+	}
+	return ""
+}
+
 func (*Contract) XXX_MessageName() string {
 	return "shentu.cvm.v1alpha1.Contract"
 }
@@ -419,52 +564,92 @@ func init() {
 }
 
 var fileDescriptor_d5bb28341b6a9214 = []byte{
-	// 708 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xd3, 0x4a,
-	0x14, 0x8d, 0x9b, 0xf4, 0x25, 0x9d, 0xa6, 0x5f, 0xd3, 0xea, 0x29, 0xaa, 0x5a, 0x3b, 0x9d, 0x27,
-	0x3d, 0x2a, 0x84, 0x6c, 0xb5, 0x08, 0x84, 0x90, 0x58, 0xd4, 0x15, 0x1f, 0x9b, 0x76, 0x31, 0x2d,
-	0x20, 0xb1, 0x69, 0x27, 0xf6, 0xe0, 0x44, 0x4d, 0x32, 0x91, 0x67, 0x12, 0xf0, 0x8e, 0x1d, 0x5b,
-	0x24, 0xfe, 0x05, 0xbf, 0x82, 0x65, 0x97, 0x5d, 0x56, 0x2c, 0x0c, 0x4a, 0xfe, 0x41, 0x96, 0xac,
-	0xd0, 0x78, 0x66, 0x6a, 0x83, 0xa2, 0x8a, 0x5d, 0x72, 0xcf, 0xb9, 0xe7, 0xde, 0x7b, 0xee, 0xf5,
-	0x80, 0x1d, 0xde, 0xa6, 0x7d, 0x31, 0xf4, 0x82, 0x51, 0xcf, 0x1b, 0xed, 0x91, 0xee, 0xa0, 0x4d,
-	0xf6, 0xbc, 0x88, 0xf6, 0x29, 0xef, 0x70, 0x77, 0x10, 0x33, 0xc1, 0xe0, 0xba, 0xa2, 0xb8, 0xc1,
-	0xa8, 0xe7, 0x1a, 0xca, 0xe6, 0x46, 0xc4, 0x22, 0x96, 0xe1, 0x9e, 0xfc, 0xa5, 0xa8, 0x9b, 0xdb,
-	0xb3, 0xd4, 0x64, 0x9e, 0x82, 0x57, 0x5b, 0xc3, 0x38, 0x66, 0xef, 0x3c, 0x12, 0xe8, 0x08, 0x4a,
-	0x2d, 0x50, 0x7f, 0xae, 0xaa, 0x9d, 0x08, 0x22, 0x28, 0x74, 0x41, 0x2d, 0x22, 0xfc, 0x2c, 0x26,
-	0x82, 0x36, 0xac, 0xa6, 0xb5, 0x5b, 0xf1, 0xd7, 0xa7, 0xa9, 0xb3, 0x92, 0x90, 0x5e, 0xf7, 0x31,
-	0x32, 0x08, 0xc2, 0xd5, 0x88, 0x70, 0x2c, 0xf9, 0xc7, 0x60, 0x21, 0x60, 0x7d, 0x11, 0x93, 0x40,
-	0xf0, 0xc6, 0x5c, 0xb3, 0xbc, 0xbb, 0xb8, 0xbf, 0xed, 0xce, 0x68, 0xd8, 0x3d, 0xd4, 0x2c, 0x7f,
-	0xed, 0x32, 0x75, 0x4a, 0x5f, 0xbe, 0x3b, 0x0b, 0x26, 0xc2, 0x71, 0x2e, 0x21, 0xf5, 0x7a, 0x54,
-	0x90, 0x90, 0x08, 0xc2, 0x1b, 0xe5, 0x5b, 0xf4, 0x8e, 0x34, 0x2b, 0xd7, 0x33, 0x11, 0x8e, 0x73,
-	0x09, 0xf4, 0x73, 0x0e, 0xd4, 0x4c, 0x21, 0x78, 0x0e, 0xaa, 0x07, 0x61, 0x18, 0x53, 0xce, 0xb3,
-	0xd9, 0xea, 0xfe, 0x33, 0x99, 0xfb, 0x2d, 0x75, 0xee, 0x45, 0x1d, 0xd1, 0x1e, 0xb6, 0xdc, 0x80,
-	0xf5, 0xbc, 0x76, 0x32, 0xa0, 0x71, 0x97, 0x86, 0x11, 0x8d, 0x3d, 0xed, 0x57, 0x10, 0x27, 0x03,
-	0xc1, 0x5c, 0x9d, 0x3b, 0x4d, 0x9d, 0x65, 0xe5, 0x07, 0x51, 0x01, 0x84, 0x8d, 0x2c, 0x7c, 0x0a,
-	0x2a, 0x01, 0x0b, 0x69, 0x63, 0xae, 0x69, 0xed, 0x2e, 0xee, 0x6f, 0xcd, 0x76, 0xe2, 0xd5, 0xd1,
-	0x21, 0x0b, 0xa9, 0xbf, 0x2e, 0x8b, 0x4f, 0x53, 0x67, 0x51, 0x89, 0xc9, 0x3c, 0x84, 0xb3, 0x74,
-	0x78, 0x0c, 0xaa, 0x5c, 0xb0, 0x98, 0x44, 0x54, 0x7b, 0x30, 0x5b, 0xe9, 0x44, 0x71, 0xfc, 0x7f,
-	0xb5, 0x92, 0x6e, 0x4b, 0xa7, 0x22, 0x6c, 0x44, 0x60, 0x13, 0x94, 0x49, 0xab, 0xd3, 0xa8, 0x64,
-	0x43, 0x2f, 0x4f, 0x53, 0x07, 0xe8, 0x01, 0x5a, 0x1d, 0x84, 0x25, 0x04, 0x4f, 0x40, 0x45, 0x9a,
-	0xd6, 0x98, 0xcf, 0xca, 0xed, 0xdc, 0xba, 0x42, 0x69, 0xb4, 0xbf, 0xa5, 0x6b, 0x6e, 0x98, 0xee,
-	0x15, 0x76, 0x26, 0x55, 0x10, 0xce, 0xc4, 0xd0, 0x67, 0x0b, 0x54, 0xf5, 0xb4, 0x70, 0x4f, 0x1e,
-	0x4a, 0x48, 0xcf, 0x44, 0x32, 0x50, 0x97, 0x55, 0xf6, 0x37, 0xa6, 0xa9, 0xb3, 0x9a, 0x0f, 0x9f,
-	0x41, 0x08, 0xd7, 0xe4, 0xef, 0xd3, 0x64, 0x40, 0xe1, 0xcb, 0x82, 0x99, 0x75, 0xff, 0x40, 0xef,
-	0xea, 0xee, 0xed, 0xbb, 0x92, 0xb7, 0xed, 0x27, 0x82, 0xca, 0xcc, 0x99, 0xe6, 0xa2, 0x8f, 0x16,
-	0xa8, 0x6a, 0xe7, 0xe0, 0x29, 0x28, 0x5f, 0xd0, 0x44, 0x5f, 0x83, 0xff, 0x77, 0xd7, 0xd0, 0xea,
-	0xf4, 0x49, 0x9c, 0xb8, 0xaf, 0x59, 0x1c, 0xee, 0x3f, 0x78, 0x98, 0x9b, 0x79, 0x41, 0x13, 0x84,
-	0xa5, 0x1c, 0xfc, 0x1f, 0xcc, 0x8f, 0x48, 0x77, 0x68, 0x3a, 0x5f, 0x9d, 0xa6, 0x4e, 0x5d, 0x71,
-	0xb2, 0x30, 0xc2, 0x0a, 0x46, 0x1f, 0x2c, 0x50, 0x2f, 0x9a, 0x7a, 0x63, 0x52, 0x9b, 0xf0, 0xb6,
-	0x6e, 0xea, 0x4f, 0x93, 0x24, 0xa4, 0x4d, 0x7a, 0x41, 0x78, 0x1b, 0x3e, 0x01, 0x4b, 0xe6, 0xda,
-	0x55, 0x9a, 0xaa, 0xd9, 0xc8, 0x57, 0xf3, 0x1b, 0x8c, 0x70, 0xdd, 0xfc, 0x97, 0xe9, 0xe8, 0x11,
-	0x58, 0x2a, 0x76, 0xc0, 0xe1, 0x1d, 0x30, 0x2f, 0x09, 0xf2, 0x0b, 0x91, 0x97, 0xb0, 0xe6, 0x4a,
-	0x43, 0x8b, 0x14, 0xac, 0x70, 0x74, 0x0e, 0x6a, 0xe6, 0x8b, 0x83, 0xff, 0x81, 0x4a, 0xa1, 0xe5,
-	0x95, 0xdc, 0x77, 0x55, 0x32, 0x03, 0xa1, 0x07, 0x6a, 0xa6, 0x74, 0xd6, 0xe4, 0x42, 0xf1, 0x69,
-	0x31, 0x08, 0xc2, 0x37, 0x24, 0xff, 0xf4, 0x72, 0x6c, 0x5b, 0x57, 0x63, 0xdb, 0xba, 0x1e, 0xdb,
-	0xd6, 0x8f, 0xb1, 0x6d, 0x7d, 0x9a, 0xd8, 0xa5, 0xaf, 0x13, 0xdb, 0xba, 0x9c, 0xd8, 0xd6, 0xd5,
-	0xc4, 0x2e, 0x5d, 0x4f, 0xec, 0xd2, 0x1b, 0xb7, 0xb0, 0xad, 0x80, 0xc6, 0xa2, 0x73, 0xf1, 0x96,
-	0x0d, 0xfb, 0x21, 0x11, 0x1d, 0xd6, 0xf7, 0xf4, 0x7b, 0xf8, 0x3e, 0x7b, 0x11, 0xe5, 0x7d, 0xf1,
-	0xd6, 0x3f, 0xd9, 0xcb, 0x77, 0xff, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x87, 0x6e, 0xa5, 0x49,
-	0x7a, 0x05, 0x00, 0x00,
+	// 1354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x23, 0x3b, 0xb6, 0xd7, 0xf2, 0xd7, 0xda, 0x49, 0x68, 0xbf, 0xb1, 0x28, 0x6f, 0xf0,
+	0xe6, 0x35, 0x5e, 0xa4, 0x24, 0x9c, 0xa2, 0x45, 0x51, 0xa0, 0x87, 0xd0, 0xad, 0xd3, 0x02, 0x49,
+	0x5a, 0xac, 0x9d, 0x14, 0x28, 0x0a, 0x30, 0x2b, 0x72, 0x25, 0x11, 0xa2, 0xb4, 0x02, 0x97, 0x92,
+	0xa3, 0x9c, 0x8a, 0xf6, 0xd0, 0x6b, 0x81, 0x5e, 0xfa, 0x1b, 0xf2, 0x2b, 0x7a, 0xcc, 0x31, 0x40,
+	0x2f, 0x41, 0x0f, 0x4a, 0x61, 0xff, 0x03, 0x1d, 0x7a, 0x2e, 0xf6, 0x4b, 0xa2, 0x14, 0xda, 0xe8,
+	0x49, 0xe2, 0x3e, 0xcf, 0x3c, 0x33, 0x3b, 0x3b, 0xb3, 0xb3, 0x60, 0x9f, 0x37, 0x69, 0x27, 0xeb,
+	0x79, 0x61, 0xbf, 0xed, 0xf5, 0x0f, 0x49, 0xd2, 0x6d, 0x92, 0x43, 0xaf, 0x41, 0x3b, 0x94, 0xc7,
+	0xdc, 0xed, 0xa6, 0x2c, 0x63, 0x70, 0x4b, 0x51, 0xdc, 0xb0, 0xdf, 0x76, 0x0d, 0x65, 0x77, 0xbb,
+	0xc1, 0x1a, 0x4c, 0xe2, 0x9e, 0xf8, 0xa7, 0xa8, 0xbb, 0x95, 0x90, 0xf1, 0x36, 0xe3, 0x5e, 0x8d,
+	0x70, 0xea, 0xf5, 0x0f, 0x6b, 0x34, 0x23, 0x87, 0x5e, 0xc8, 0xe2, 0x8e, 0xc6, 0xf7, 0x8a, 0xbc,
+	0x09, 0x5d, 0x05, 0x6f, 0xd4, 0x7a, 0x69, 0xca, 0xce, 0x3c, 0x12, 0x9a, 0x95, 0x4a, 0x83, 0xb1,
+	0x46, 0x42, 0x3d, 0xf9, 0x55, 0xeb, 0xd5, 0xbd, 0xa8, 0x97, 0x92, 0x2c, 0x66, 0x5a, 0x10, 0xfd,
+	0xbd, 0x0a, 0xca, 0x0f, 0x55, 0xb4, 0x27, 0x19, 0xc9, 0x28, 0x74, 0xc1, 0x52, 0x83, 0xf0, 0x20,
+	0x25, 0x19, 0xb5, 0xad, 0xaa, 0x75, 0x30, 0xef, 0x6f, 0x8d, 0x86, 0xce, 0xfa, 0x80, 0xb4, 0x93,
+	0x4f, 0x91, 0x41, 0x10, 0x5e, 0x6c, 0x10, 0x8e, 0x05, 0xff, 0x09, 0x58, 0x0e, 0x59, 0x27, 0x4b,
+	0x49, 0x98, 0x71, 0xfb, 0x5a, 0xb5, 0x74, 0xb0, 0x72, 0x7f, 0xcf, 0x2d, 0xd8, 0xb0, 0x7b, 0xa4,
+	0x59, 0xfe, 0xe6, 0xeb, 0xa1, 0x33, 0xf7, 0xea, 0x9d, 0xb3, 0x6c, 0x56, 0x38, 0x9e, 0x48, 0x08,
+	0xbd, 0x36, 0xcd, 0x48, 0x44, 0x32, 0xc2, 0xed, 0xd2, 0x15, 0x7a, 0x8f, 0x35, 0x6b, 0xa2, 0x67,
+	0x56, 0x38, 0x9e, 0x48, 0xc0, 0x26, 0x58, 0x4b, 0x69, 0x48, 0xe3, 0x6e, 0x16, 0x74, 0x49, 0x4a,
+	0xda, 0xdc, 0x9e, 0xaf, 0x5a, 0x07, 0x2b, 0xf7, 0x51, 0xa1, 0x28, 0x56, 0xd4, 0x6f, 0x24, 0xd3,
+	0xdf, 0x13, 0xca, 0xa3, 0xa1, 0x73, 0x43, 0xed, 0x7e, 0x5a, 0x07, 0xe1, 0xd5, 0x34, 0xcf, 0x86,
+	0xa7, 0xe0, 0x46, 0x96, 0x92, 0x0e, 0x27, 0xa1, 0xc8, 0x6f, 0x20, 0x72, 0x95, 0xc4, 0xed, 0x38,
+	0xb3, 0x17, 0x64, 0x1a, 0xab, 0xa3, 0xa1, 0x73, 0x5b, 0x09, 0x15, 0xd2, 0x10, 0xde, 0xca, 0xad,
+	0x3f, 0x24, 0xfc, 0x91, 0x58, 0x85, 0xcf, 0x41, 0x59, 0x50, 0x78, 0xd8, 0xa4, 0x51, 0x2f, 0xa1,
+	0xf6, 0x75, 0x19, 0x7d, 0xb5, 0x30, 0xfa, 0x87, 0x84, 0x9f, 0x68, 0x9e, 0xff, 0x1f, 0x1d, 0xfb,
+	0xd6, 0xe4, 0xe4, 0x8c, 0x06, 0xc2, 0x2b, 0x8d, 0x09, 0x13, 0x1e, 0x83, 0x85, 0x3a, 0x4b, 0x5b,
+	0xdc, 0x5e, 0x94, 0xd2, 0xbb, 0x85, 0xd2, 0xc7, 0x82, 0xe1, 0x6f, 0x6b, 0xd1, 0xb2, 0x12, 0x95,
+	0x66, 0x08, 0x2b, 0x73, 0xf8, 0xa3, 0x05, 0x56, 0xf8, 0x19, 0xed, 0x66, 0x41, 0xbd, 0xd7, 0x89,
+	0xb8, 0xbd, 0x24, 0x0f, 0x6f, 0xc7, 0x55, 0x25, 0xed, 0x8a, 0x92, 0x76, 0x75, 0x49, 0xbb, 0x47,
+	0x2c, 0xee, 0xf8, 0xc7, 0x5a, 0x0d, 0x2a, 0xb5, 0x9c, 0x2d, 0x7a, 0xf5, 0xce, 0x39, 0x68, 0xc4,
+	0x59, 0xb3, 0x57, 0x73, 0x43, 0xd6, 0xf6, 0x74, 0x57, 0xa8, 0x9f, 0x0f, 0x78, 0xd4, 0xf2, 0xb2,
+	0x41, 0x97, 0x72, 0x29, 0xc3, 0x31, 0x90, 0x96, 0xc7, 0xc2, 0x10, 0x9e, 0x01, 0xd8, 0xa7, 0x69,
+	0x5c, 0x8f, 0x69, 0x14, 0x4c, 0xea, 0x72, 0x59, 0x86, 0xf2, 0xdf, 0xc2, 0x9d, 0x3d, 0xd3, 0xf4,
+	0x71, 0x7d, 0xee, 0xeb, 0xb0, 0x76, 0x54, 0x58, 0xef, 0xcb, 0x21, 0xbc, 0xd9, 0x9f, 0x31, 0xe2,
+	0x90, 0x81, 0x8d, 0x7a, 0xca, 0x5e, 0xd2, 0x4e, 0xce, 0x2d, 0x90, 0x6e, 0xef, 0x14, 0x27, 0x54,
+	0x92, 0xc7, 0x4e, 0x1d, 0xed, 0xf4, 0x96, 0xce, 0xec, 0x8c, 0x14, 0xc2, 0xeb, 0xf5, 0x29, 0x03,
+	0x0e, 0x7f, 0xb2, 0xc0, 0x4e, 0x48, 0xd3, 0x4c, 0xc4, 0x91, 0x06, 0xf5, 0x94, 0xd2, 0x97, 0x34,
+	0x30, 0xdd, 0x6d, 0xaf, 0xc8, 0xb3, 0xdc, 0x71, 0x55, 0xfb, 0xbb, 0xa6, 0xfd, 0xdd, 0xcf, 0x35,
+	0xc1, 0xbf, 0xa7, 0x1d, 0x56, 0x95, 0xc3, 0x4b, 0x95, 0xd0, 0x6f, 0xef, 0x1c, 0x0b, 0xdf, 0x1a,
+	0xe3, 0xc7, 0x12, 0x36, 0x32, 0xf0, 0x25, 0xd8, 0x62, 0x29, 0x09, 0x13, 0x1a, 0x84, 0x4d, 0x1a,
+	0xb6, 0x4c, 0x8f, 0x95, 0xa5, 0xfb, 0xbb, 0x85, 0x3b, 0xff, 0x5a, 0xf2, 0x8f, 0x04, 0x5d, 0xf7,
+	0x19, 0xd2, 0xb1, 0xec, 0xaa, 0x58, 0x0a, 0x04, 0x11, 0xde, 0x64, 0xb3, 0x66, 0xf0, 0x11, 0x80,
+	0x51, 0xcc, 0xbb, 0x24, 0x0b, 0x9b, 0x01, 0x49, 0x12, 0x76, 0x96, 0xc4, 0x3c, 0xb3, 0x57, 0xab,
+	0xa5, 0x83, 0x65, 0x7f, 0x6f, 0x72, 0x80, 0xef, 0x73, 0x10, 0xde, 0x34, 0x8b, 0x0f, 0xcc, 0x1a,
+	0x24, 0xa0, 0xcc, 0xbb, 0xac, 0xc3, 0x59, 0xca, 0x9b, 0x71, 0x97, 0xdb, 0x6b, 0xd5, 0xd2, 0xa5,
+	0x8d, 0x76, 0x32, 0x21, 0xce, 0x36, 0x5a, 0x5e, 0x03, 0xe1, 0x29, 0x49, 0xd8, 0x00, 0x6b, 0xfa,
+	0x3b, 0xe8, 0x71, 0xd2, 0xa0, 0xdc, 0x5e, 0x97, 0x4e, 0xf6, 0xaf, 0x72, 0xf2, 0x54, 0x30, 0x67,
+	0xaf, 0xa2, 0x69, 0x19, 0x84, 0x57, 0x79, 0x8e, 0xcc, 0xe1, 0xf7, 0xc0, 0x36, 0xcd, 0x1e, 0xd4,
+	0x12, 0x16, 0xb6, 0x72, 0xb7, 0xd1, 0x86, 0xbc, 0x8d, 0xee, 0x8c, 0x86, 0x8e, 0xa3, 0xb5, 0x2e,
+	0x61, 0x22, 0x7c, 0xc3, 0x40, 0xbe, 0x40, 0xc6, 0x57, 0x52, 0x0b, 0xac, 0x1b, 0x20, 0x0a, 0x42,
+	0x92, 0x24, 0xdc, 0xde, 0xac, 0x96, 0x2e, 0xbd, 0x53, 0xcd, 0x45, 0x13, 0x1d, 0x91, 0x24, 0xf1,
+	0x2b, 0x7a, 0x23, 0x37, 0xa7, 0x9d, 0x6b, 0x21, 0x84, 0xd7, 0x78, 0x9e, 0xce, 0xe1, 0x33, 0x70,
+	0xb3, 0x43, 0x5f, 0x64, 0xc1, 0x34, 0x31, 0x88, 0x23, 0x1b, 0xca, 0x8d, 0xec, 0x8f, 0x86, 0xce,
+	0x9e, 0xd2, 0x2a, 0xe6, 0x21, 0xbc, 0x25, 0x80, 0xa9, 0x28, 0xbe, 0x8a, 0xd0, 0x1f, 0x25, 0xb0,
+	0x64, 0x9a, 0x09, 0x3e, 0x07, 0x8b, 0x0f, 0xa2, 0x28, 0xa5, 0x9c, 0xcb, 0x99, 0x57, 0x56, 0x57,
+	0xd3, 0x9f, 0x43, 0xe7, 0x5e, 0xee, 0x12, 0x6a, 0x0e, 0xba, 0x34, 0x4d, 0x68, 0xd4, 0xa0, 0xa9,
+	0xa7, 0xe7, 0x6c, 0x98, 0x0e, 0xba, 0x19, 0x73, 0xb5, 0xed, 0x68, 0xe8, 0xac, 0xa9, 0x48, 0x88,
+	0x5a, 0x40, 0xd8, 0xc8, 0xc2, 0x2f, 0xc0, 0x7c, 0xc8, 0x22, 0x6a, 0x5f, 0x93, 0x8d, 0x71, 0xbb,
+	0x78, 0x42, 0x3e, 0x7b, 0x7c, 0xc4, 0x22, 0xea, 0x6f, 0xe9, 0x14, 0xad, 0xe8, 0xd6, 0x64, 0x11,
+	0x45, 0x58, 0x9a, 0xc3, 0x27, 0x60, 0x91, 0x67, 0x2c, 0x25, 0x0d, 0xaa, 0x67, 0x63, 0xb1, 0xd2,
+	0x89, 0xe2, 0xf8, 0x37, 0xb5, 0x92, 0x0e, 0x4b, 0x9b, 0x22, 0x6c, 0x44, 0x60, 0x15, 0x94, 0x48,
+	0x2d, 0x96, 0x23, 0xb1, 0xec, 0xaf, 0x8d, 0x86, 0x0e, 0xd0, 0x1b, 0xa8, 0xc5, 0x08, 0x0b, 0x08,
+	0x9e, 0x80, 0x79, 0x31, 0x4c, 0xed, 0x85, 0x2b, 0x2a, 0xd5, 0xe4, 0x51, 0x0c, 0x60, 0xff, 0xb6,
+	0xf6, 0xb9, 0x6d, 0xa2, 0x57, 0x58, 0x20, 0x54, 0x10, 0x96, 0x62, 0xf0, 0x2e, 0x58, 0x20, 0x51,
+	0x3b, 0xee, 0xc8, 0x69, 0xb6, 0xec, 0x6f, 0x4c, 0x46, 0x8a, 0x5c, 0x46, 0x58, 0xc1, 0xd0, 0x03,
+	0x4b, 0x11, 0xed, 0x26, 0x6c, 0x40, 0x53, 0x39, 0x9d, 0x96, 0xf3, 0x8f, 0x11, 0x83, 0x20, 0x3c,
+	0x26, 0xa1, 0x5f, 0x2d, 0xb0, 0xa8, 0xd3, 0x08, 0x0f, 0xc5, 0xcb, 0x24, 0xa2, 0x81, 0x18, 0x15,
+	0xf2, 0x58, 0x4b, 0xfe, 0xf6, 0x68, 0xe8, 0x6c, 0x4c, 0xb2, 0x2a, 0x21, 0x84, 0x97, 0xc4, 0xff,
+	0xd3, 0x41, 0x97, 0xc2, 0xa7, 0xb9, 0x53, 0x2a, 0xfb, 0x0f, 0x74, 0x11, 0xfc, 0xff, 0xea, 0x22,
+	0x10, 0x8f, 0x2d, 0x7f, 0x90, 0x51, 0x61, 0x59, 0x78, 0x6a, 0xe8, 0x67, 0x0b, 0x2c, 0xea, 0x23,
+	0x81, 0xa7, 0xa0, 0xd4, 0xa2, 0x03, 0x5d, 0x66, 0xfe, 0xbf, 0x2b, 0xb3, 0x5a, 0xdc, 0x21, 0xe9,
+	0xc0, 0xfd, 0x96, 0xa5, 0xd1, 0xfd, 0x8f, 0x3e, 0x9e, 0x9c, 0x52, 0x8b, 0x0e, 0x10, 0x16, 0x72,
+	0x22, 0xa1, 0x7d, 0x92, 0xf4, 0x4c, 0xe4, 0xb9, 0x84, 0xca, 0x65, 0x84, 0x15, 0x8c, 0x7e, 0xb0,
+	0x40, 0x39, 0x7f, 0x5a, 0xe3, 0x24, 0x35, 0x09, 0x6f, 0xea, 0xa0, 0x66, 0x93, 0x24, 0x20, 0x9d,
+	0xa4, 0x2f, 0x09, 0x6f, 0xc2, 0xcf, 0xc0, 0xaa, 0x79, 0x5e, 0x29, 0x33, 0xe5, 0xd3, 0x9e, 0x9c,
+	0xf9, 0x14, 0x8c, 0x70, 0xd9, 0x7c, 0x0b, 0x73, 0xf4, 0x09, 0x58, 0xcd, 0x47, 0xc0, 0xe1, 0xff,
+	0xc0, 0x82, 0x20, 0x88, 0xd6, 0x13, 0x25, 0xb6, 0xe9, 0x8a, 0x84, 0xe6, 0x29, 0x58, 0xe1, 0xe8,
+	0x39, 0x58, 0x32, 0x4f, 0x3c, 0x78, 0x07, 0xcc, 0xe7, 0x42, 0x5e, 0x9f, 0xe4, 0x5d, 0xb9, 0x94,
+	0xa0, 0x28, 0x1f, 0xe3, 0xda, 0xbe, 0x36, 0x5b, 0x3e, 0x06, 0x41, 0x78, 0x4c, 0xf2, 0x4f, 0x5f,
+	0x9f, 0x57, 0xac, 0x37, 0xe7, 0x15, 0xeb, 0xed, 0x79, 0xc5, 0xfa, 0xeb, 0xbc, 0x62, 0xfd, 0x72,
+	0x51, 0x99, 0xfb, 0xfd, 0xa2, 0x62, 0xbd, 0xbe, 0xa8, 0x58, 0x6f, 0x2e, 0x2a, 0x73, 0x6f, 0x2f,
+	0x2a, 0x73, 0xdf, 0xb9, 0xf9, 0x97, 0x89, 0x98, 0x8b, 0xad, 0x3a, 0xeb, 0x75, 0x22, 0x39, 0x10,
+	0x3d, 0xfd, 0x40, 0x7f, 0x21, 0x9f, 0xe8, 0xf2, 0x95, 0x52, 0xbb, 0x2e, 0xa7, 0xef, 0x87, 0xff,
+	0x0c, 0x00, 0x43, 0xab, 0x9a, 0xe7, 0x2b, 0x0c, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -487,6 +672,168 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextScheduledCallId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledCallId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.ScheduledCalls) > 0 {
+		for iNdEx := len(m.ScheduledCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.ScheduleBlockGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ScheduleBlockGasLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.SponsorUsages) > 0 {
+		for iNdEx := len(m.SponsorUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SponsorUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Sponsorships) > 0 {
+		for iNdEx := len(m.Sponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.DispatchAllowlist) > 0 {
+		for iNdEx := len(m.DispatchAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DispatchAllowlist[iNdEx])
+			copy(dAtA[i:], m.DispatchAllowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DispatchAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size, err := m.OracleCheckParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CertifierFreezeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CertifierFreezeDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.FrozenContracts) > 0 {
+		for iNdEx := len(m.FrozenContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.VerifiedContracts) > 0 {
+		for iNdEx := len(m.VerifiedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerifiedContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SweptFunds) > 0 {
+		for iNdEx := len(m.SweptFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SweptFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.Forks.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.GasSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TransactionGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TransactionGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.ReceiptParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Metadatas) > 0 {
 		for iNdEx := len(m.Metadatas) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -543,6 +890,20 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Deployer) > 0 {
+		i -= len(m.Deployer)
+		copy(dAtA[i:], m.Deployer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Deployer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Meta) > 0 {
 		for iNdEx := len(m.Meta) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -822,6 +1183,67 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.ReceiptParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.TransactionGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.TransactionGasLimit))
+	}
+	l = m.GasSchedule.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Forks.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SweptFunds) > 0 {
+		for _, e := range m.SweptFunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VerifiedContracts) > 0 {
+		for _, e := range m.VerifiedContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenContracts) > 0 {
+		for _, e := range m.FrozenContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CertifierFreezeDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.OracleCheckParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DispatchAllowlist) > 0 {
+		for _, s := range m.DispatchAllowlist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Sponsorships) > 0 {
+		for _, e := range m.Sponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SponsorUsages) > 0 {
+		for _, e := range m.SponsorUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ScheduleBlockGasLimit != 0 {
+		n += 2 + sovGenesis(uint64(m.ScheduleBlockGasLimit))
+	}
+	if len(m.ScheduledCalls) > 0 {
+		for _, e := range m.ScheduledCalls {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledCallId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextScheduledCallId))
+	}
 	return n
 }
 
@@ -851,6 +1273,14 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Deployer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1054,16 +1484,474 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptParams", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenesis
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceiptParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionGasLimit", wireType)
+			}
+			m.TransactionGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Forks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweptFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SweptFunds = append(m.SweptFunds, types.Coin{})
+			if err := m.SweptFunds[len(m.SweptFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedContracts = append(m.VerifiedContracts, VerifiedContract{})
+			if err := m.VerifiedContracts[len(m.VerifiedContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenContracts = append(m.FrozenContracts, FrozenContract{})
+			if err := m.FrozenContracts[len(m.FrozenContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertifierFreezeDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CertifierFreezeDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleCheckParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleCheckParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DispatchAllowlist = append(m.DispatchAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsorships = append(m.Sponsorships, Sponsorship{})
+			if err := m.Sponsorships[len(m.Sponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsorUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsorUsages = append(m.SponsorUsages, SponsorUsage{})
+			if err := m.SponsorUsages[len(m.SponsorUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleBlockGasLimit", wireType)
+			}
+			m.ScheduleBlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleBlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledCalls = append(m.ScheduledCalls, ScheduledCall{})
+			if err := m.ScheduledCalls[len(m.ScheduledCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledCallId", wireType)
+			}
+			m.NextScheduledCallId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledCallId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if (iNdEx + skippy) > l {
@@ -1275,6 +2163,70 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deployer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
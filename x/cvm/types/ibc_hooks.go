@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBCHook is a contract call requested by the receiver of an ICS-20 transfer, which is the JSON
+// object {"cvm":{"contract":"<bech32 address>","data":"<hex call data>"}} instead of an address.
+type IBCHook struct {
+	Contract sdk.AccAddress
+	Data     []byte
+}
+
+// ParseIBCHook parses the contract call requested by the receiver of a transfer. It returns false
+// if the receiver does not request a call, and an error if it requests an invalid one.
+func ParseIBCHook(receiver string) (IBCHook, bool, error) {
+	if !strings.HasPrefix(strings.TrimSpace(receiver), "{") {
+		return IBCHook{}, false, nil
+	}
+	var memo struct {
+		CVM *struct {
+			Contract string `json:"contract"`
+			Data     string `json:"data"`
+		} `json:"cvm"`
+	}
+	if err := json.Unmarshal([]byte(receiver), &memo); err != nil || memo.CVM == nil {
+		return IBCHook{}, false, nil
+	}
+
+	contract, err := sdk.AccAddressFromBech32(memo.CVM.Contract)
+	if err != nil {
+		return IBCHook{}, true, sdkerrors.Wrapf(ErrInvalidIBCHook, "invalid contract: %v", err)
+	}
+	data, err := hex.DecodeString(strings.TrimPrefix(memo.CVM.Data, "0x"))
+	if err != nil {
+		return IBCHook{}, true, sdkerrors.Wrapf(ErrInvalidIBCHook, "invalid data: %v", err)
+	}
+	return IBCHook{Contract: contract, Data: data}, true, nil
+}
+
+// IBCHookCaller returns the account calling contracts on behalf of the sender of a transfer on the
+// counterparty of a channel. The account is derived from both, so that the senders of other
+// channels cannot impersonate it, and it receives the transferred coins before the call.
+func IBCHookCaller(channel, sender string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(ModuleName + "/ibc-hook/" + channel + "/" + sender)))
+}
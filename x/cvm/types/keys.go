@@ -1,6 +1,8 @@
 package types
 
 import (
+	gobin "encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/hyperledger/burrow/acm/acmstate"
@@ -15,6 +17,9 @@ const (
 	// StoreKey is the string store representation.
 	StoreKey = ModuleName
 
+	// TStoreKey is the string transient store representation.
+	TStoreKey = "transient_" + ModuleName
+
 	// QuerierRoute is the querier route for the staking module.
 	QuerierRoute = ModuleName
 
@@ -43,6 +48,57 @@ var (
 
 	// AddressMetaHashStoreKeyPrefix is the prefix of contract metadata hash kv-store keys.
 	AddressMetaHashStoreKeyPrefix = []byte{0x5}
+
+	// ReceiptStoreKeyPrefix is the prefix of receipt kv-store keys.
+	ReceiptStoreKeyPrefix = []byte{0x06}
+
+	// ReceiptHeightIndexKeyPrefix is the prefix of the kv-store index of receipts by height.
+	ReceiptHeightIndexKeyPrefix = []byte{0x07}
+
+	// ReceiptAddressIndexKeyPrefix is the prefix of the kv-store index of receipts by contract address.
+	ReceiptAddressIndexKeyPrefix = []byte{0x08}
+
+	// ReceiptTopicIndexKeyPrefix is the prefix of the kv-store index of receipts by log topic.
+	ReceiptTopicIndexKeyPrefix = []byte{0x09}
+
+	// AdminStoreKeyPrefix is the prefix of contract admin kv-store keys.
+	AdminStoreKeyPrefix = []byte{0x0A}
+
+	// SweptFundsKey is the kv-store key of the total coins swept from the zero address.
+	SweptFundsKey = []byte{0x0B}
+
+	// VerifiedContractStoreKeyPrefix is the prefix of verified contract kv-store keys.
+	VerifiedContractStoreKeyPrefix = []byte{0x0C}
+
+	// FrozenContractStoreKeyPrefix is the prefix of frozen contract kv-store keys.
+	FrozenContractStoreKeyPrefix = []byte{0x0D}
+
+	// SponsorshipStoreKeyPrefix is the prefix of contract sponsorship kv-store keys.
+	SponsorshipStoreKeyPrefix = []byte{0x0E}
+
+	// SponsorUsageStoreKeyPrefix is the prefix of sponsor usage kv-store keys.
+	SponsorUsageStoreKeyPrefix = []byte{0x0F}
+
+	// ScheduledCallStoreKeyPrefix is the prefix of scheduled call kv-store keys.
+	ScheduledCallStoreKeyPrefix = []byte{0x10}
+
+	// ScheduledCallQueueKeyPrefix is the prefix of the kv-store queue of scheduled calls by the height of their next run.
+	ScheduledCallQueueKeyPrefix = []byte{0x11}
+
+	// NextScheduledCallIDKey is the kv-store key of the ID of the next scheduled call.
+	NextScheduledCallIDKey = []byte{0x12}
+
+	// DeployerStoreKeyPrefix is the prefix of contract deployer kv-store keys.
+	DeployerStoreKeyPrefix = []byte{0x13}
+
+	// BlockLogCountKey is the transient store key of the number of logs emitted in the block.
+	BlockLogCountKey = []byte{0x00}
+
+	// TxLogCountKeyPrefix is the prefix of transient store keys of the number of logs emitted in a transaction.
+	TxLogCountKeyPrefix = []byte{0x01}
+
+	// TxReceiptCountKeyPrefix is the prefix of transient store keys of the number of receipts written for a transaction.
+	TxReceiptCountKeyPrefix = []byte{0x02}
 )
 
 // StorageStoreKey returns the kv-store key for the contract's storage key.
@@ -50,6 +106,11 @@ func StorageStoreKey(addr crypto.Address, key binary.Word256) []byte {
 	return append(append(StorageStoreKeyPrefix, addr.Bytes()...), key.Bytes()...)
 }
 
+// ContractStorageStoreKeyPrefix returns the prefix of the kv-store keys for the contract's storage.
+func ContractStorageStoreKeyPrefix(addr crypto.Address) []byte {
+	return append(append([]byte{}, StorageStoreKeyPrefix...), addr.Bytes()...)
+}
+
 // BlockHashStoreKey returns the kv-store key for the chain's block hashes.
 func BlockHashStoreKey(height int64) []byte {
 	return append(BlockHashStoreKeyPrefix, sdk.NewInt(height).BigInt().Bytes()...)
@@ -74,3 +135,105 @@ func MetaHashStoreKey(metahash acmstate.MetadataHash) []byte {
 func AddressMetaStoreKey(addr crypto.Address) []byte {
 	return append(AddressMetaHashStoreKeyPrefix, addr.Bytes()...)
 }
+
+// AdminStoreKey returns the kv-store key for the contract's admin.
+func AdminStoreKey(addr crypto.Address) []byte {
+	return append(AdminStoreKeyPrefix, addr.Bytes()...)
+}
+
+// DeployerStoreKey returns the kv-store key for the contract's deployer.
+func DeployerStoreKey(addr crypto.Address) []byte {
+	return append(DeployerStoreKeyPrefix, addr.Bytes()...)
+}
+
+// VerifiedContractStoreKey returns the kv-store key for the verified source code of a contract.
+func VerifiedContractStoreKey(addr crypto.Address) []byte {
+	return append(VerifiedContractStoreKeyPrefix, addr.Bytes()...)
+}
+
+// FrozenContractStoreKey returns the kv-store key for the freeze of a contract.
+func FrozenContractStoreKey(addr crypto.Address) []byte {
+	return append(FrozenContractStoreKeyPrefix, addr.Bytes()...)
+}
+
+// SponsorshipStoreKey returns the kv-store key for the contract's sponsorship.
+func SponsorshipStoreKey(addr crypto.Address) []byte {
+	return append(SponsorshipStoreKeyPrefix, addr.Bytes()...)
+}
+
+// SponsorUsagesStoreKeyPrefix returns the prefix of the kv-store keys for the usages of the contract's sponsorship.
+func SponsorUsagesStoreKeyPrefix(addr crypto.Address) []byte {
+	return append(append([]byte{}, SponsorUsageStoreKeyPrefix...), addr.Bytes()...)
+}
+
+// SponsorUsageStoreKey returns the kv-store key for the usage of the contract's sponsorship by the user.
+func SponsorUsageStoreKey(addr crypto.Address, user sdk.AccAddress) []byte {
+	return append(SponsorUsagesStoreKeyPrefix(addr), user...)
+}
+
+// ScheduledCallStoreKey returns the kv-store key for a scheduled call.
+func ScheduledCallStoreKey(id uint64) []byte {
+	return append(append([]byte{}, ScheduledCallStoreKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ScheduledCallQueueHeightPrefix returns the kv-store prefix of the queue of the scheduled calls running at a height.
+func ScheduledCallQueueHeightPrefix(height int64) []byte {
+	return append(append([]byte{}, ScheduledCallQueueKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// ScheduledCallQueueKey returns the kv-store key for a scheduled call in the queue of the height of its next run.
+func ScheduledCallQueueKey(height int64, id uint64) []byte {
+	return append(ScheduledCallQueueHeightPrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// SplitScheduledCallQueueKey returns the ID of the scheduled call of a queue key.
+func SplitScheduledCallQueueKey(key []byte) uint64 {
+	return sdk.BigEndianToUint64(key[len(ScheduledCallQueueKeyPrefix)+8:])
+}
+
+// TxLogCountKey returns the transient store key for the number of logs emitted in a transaction.
+func TxLogCountKey(txHash []byte) []byte {
+	return append(TxLogCountKeyPrefix, txHash...)
+}
+
+// TxReceiptCountKey returns the transient store key for the number of receipts written for a transaction.
+func TxReceiptCountKey(txHash []byte) []byte {
+	return append(TxReceiptCountKeyPrefix, txHash...)
+}
+
+// ReceiptKey returns the key of a receipt below the receipt store prefix.
+func ReceiptKey(txHash []byte, msgIndex uint32) []byte {
+	bz := make([]byte, 4)
+	gobin.BigEndian.PutUint32(bz, msgIndex)
+	return append(append([]byte{}, txHash...), bz...)
+}
+
+// ReceiptStoreKey returns the kv-store key for a receipt.
+func ReceiptStoreKey(txHash []byte, msgIndex uint32) []byte {
+	return append(ReceiptStoreKeyPrefix, ReceiptKey(txHash, msgIndex)...)
+}
+
+// ReceiptIndexKey returns the key of a receipt below an index prefix. Index keys are ordered by height.
+func ReceiptIndexKey(height int64, txHash []byte, msgIndex uint32) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), ReceiptKey(txHash, msgIndex)...)
+}
+
+// SplitReceiptIndexKey returns the height and the receipt key of an index key below an index prefix.
+func SplitReceiptIndexKey(key []byte) (int64, []byte) {
+	return int64(sdk.BigEndianToUint64(key[:8])), key[8:]
+}
+
+// ReceiptHeightIndexKey returns the kv-store key for the height index of a receipt.
+func ReceiptHeightIndexKey(height int64, txHash []byte, msgIndex uint32) []byte {
+	return append(ReceiptHeightIndexKeyPrefix, ReceiptIndexKey(height, txHash, msgIndex)...)
+}
+
+// ReceiptAddressIndexPrefix returns the kv-store prefix of the receipt index of an address.
+func ReceiptAddressIndexPrefix(addr sdk.AccAddress) []byte {
+	return append(ReceiptAddressIndexKeyPrefix, addr.Bytes()...)
+}
+
+// ReceiptTopicIndexPrefix returns the kv-store prefix of the receipt index of a log topic.
+func ReceiptTopicIndexPrefix(topic []byte) []byte {
+	return append(ReceiptTopicIndexKeyPrefix, topic...)
+}
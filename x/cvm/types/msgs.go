@@ -2,22 +2,46 @@ package types
 
 import (
 	"encoding/json"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/txs/payload"
 )
 
 // Governance message types and routes
 const (
-	TypeMsgDeploy = "deploy"
-	TypeMsgCall   = "call"
+	TypeMsgDeploy  = "deploy"
+	TypeMsgCall    = "call"
+	TypeMsgMigrate = "migrate"
+
+	TypeMsgVerifyContract   = "verify_contract"
+	TypeMsgFreezeContract   = "freeze_contract"
+	TypeMsgUnfreezeContract = "unfreeze_contract"
+
+	TypeMsgSetSponsorship    = "set_sponsorship"
+	TypeMsgRevokeSponsorship = "revoke_sponsorship"
+
+	TypeMsgScheduleCall        = "schedule_call"
+	TypeMsgCancelScheduledCall = "cancel_scheduled_call"
+
+	TypeMsgMultiCall = "multi_call"
 )
 
 var _ sdk.Msg = &MsgCall{}
 var _ sdk.Msg = &MsgDeploy{}
+var _ sdk.Msg = &MsgMigrate{}
+var _ sdk.Msg = &MsgVerifyContract{}
+var _ sdk.Msg = &MsgFreezeContract{}
+var _ sdk.Msg = &MsgUnfreezeContract{}
+var _ sdk.Msg = &MsgSetSponsorship{}
+var _ sdk.Msg = &MsgRevokeSponsorship{}
+var _ sdk.Msg = &MsgScheduleCall{}
+var _ sdk.Msg = &MsgCancelScheduledCall{}
+var _ sdk.Msg = &MsgMultiCall{}
 
 // NewMsgCall returns a new CVM call message.
 func NewMsgCall(caller, callee string, value uint64, data []byte) MsgCall {
@@ -91,6 +115,14 @@ func (m MsgDeploy) ValidateBasic() error {
 	if m.Caller == "" || err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Caller)
 	}
+	if m.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Admin)
+		}
+	}
+	if len(m.Salt) != 0 && len(m.Salt) != binary.Word256Bytes {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "salt must be %d bytes", binary.Word256Bytes)
+	}
 	return nil
 }
 
@@ -108,3 +140,395 @@ func (m MsgDeploy) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Caller)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgMigrate returns a new CVM migrate message.
+func NewMsgMigrate(sender, contract string, code acm.Bytecode, abi string, meta []*payload.ContractMeta) MsgMigrate {
+	return MsgMigrate{
+		Sender:   sender,
+		Contract: contract,
+		Code:     code,
+		Abi:      abi,
+		Meta:     meta,
+	}
+}
+
+// Route returns the module name.
+func (m MsgMigrate) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgMigrate) Type() string { return TypeMsgMigrate }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgMigrate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Sender)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Contract)
+	}
+	if len(m.Code) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty code")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgMigrate) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgMigrate) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgVerifyContract returns a new CVM contract verification message.
+func NewMsgVerifyContract(sender, contract string, sourceFiles []SourceFile, compilerVersion, compilerSettings string,
+	certificateID uint64) MsgVerifyContract {
+	return MsgVerifyContract{
+		Sender:           sender,
+		Contract:         contract,
+		SourceFiles:      sourceFiles,
+		CompilerVersion:  compilerVersion,
+		CompilerSettings: compilerSettings,
+		CertificateId:    certificateID,
+	}
+}
+
+// Route returns the module name.
+func (m MsgVerifyContract) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgVerifyContract) Type() string { return TypeMsgVerifyContract }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgVerifyContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Sender)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Contract)
+	}
+	return ValidateSource(m.SourceFiles, m.CompilerVersion, m.CompilerSettings)
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgVerifyContract) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgVerifyContract) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgFreezeContract returns a new CVM contract freeze message.
+func NewMsgFreezeContract(certifier, contract, reason string) MsgFreezeContract {
+	return MsgFreezeContract{
+		Certifier: certifier,
+		Contract:  contract,
+		Reason:    reason,
+	}
+}
+
+// Route returns the module name.
+func (m MsgFreezeContract) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgFreezeContract) Type() string { return TypeMsgFreezeContract }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgFreezeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Certifier); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Certifier)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Contract)
+	}
+	if m.Reason == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty reason")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgFreezeContract) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgFreezeContract) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Certifier)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUnfreezeContract returns a new CVM contract unfreeze message.
+func NewMsgUnfreezeContract(certifier, contract string) MsgUnfreezeContract {
+	return MsgUnfreezeContract{
+		Certifier: certifier,
+		Contract:  contract,
+	}
+}
+
+// Route returns the module name.
+func (m MsgUnfreezeContract) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgUnfreezeContract) Type() string { return TypeMsgUnfreezeContract }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgUnfreezeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Certifier); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Certifier)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Contract)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgUnfreezeContract) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgUnfreezeContract) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Certifier)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetSponsorship returns a new CVM contract sponsorship message.
+func NewMsgSetSponsorship(admin, contract, sponsor string, dailyCap sdk.Coins, selectors []string,
+	expiry time.Time) MsgSetSponsorship {
+	return MsgSetSponsorship{
+		Admin:     admin,
+		Contract:  contract,
+		Sponsor:   sponsor,
+		DailyCap:  dailyCap,
+		Selectors: selectors,
+		Expiry:    expiry,
+	}
+}
+
+// Route returns the module name.
+func (m MsgSetSponsorship) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgSetSponsorship) Type() string { return TypeMsgSetSponsorship }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgSetSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Admin)
+	}
+	return NewSponsorship(m.Contract, m.Sponsor, m.DailyCap, m.Selectors, m.Expiry).Validate()
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgSetSponsorship) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required. The sponsor signs the message along with the
+// admin, as it consents to pay the fees.
+func (m MsgSetSponsorship) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(m.Admin)
+	sponsor, _ := sdk.AccAddressFromBech32(m.Sponsor)
+	if admin.Equals(sponsor) {
+		return []sdk.AccAddress{admin}
+	}
+	return []sdk.AccAddress{admin, sponsor}
+}
+
+// NewMsgRevokeSponsorship returns a new CVM contract sponsorship revocation message.
+func NewMsgRevokeSponsorship(sender, contract string) MsgRevokeSponsorship {
+	return MsgRevokeSponsorship{
+		Sender:   sender,
+		Contract: contract,
+	}
+}
+
+// Route returns the module name.
+func (m MsgRevokeSponsorship) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgRevokeSponsorship) Type() string { return TypeMsgRevokeSponsorship }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgRevokeSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Sender)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Contract)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgRevokeSponsorship) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgRevokeSponsorship) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgScheduleCall returns a new CVM scheduled call message.
+func NewMsgScheduleCall(caller, callee string, data []byte, interval, maxRuns, gasLimit, prepaidGas uint64,
+	prepaidFee sdk.Coins) MsgScheduleCall {
+	return MsgScheduleCall{
+		Caller:     caller,
+		Callee:     callee,
+		Data:       data,
+		Interval:   interval,
+		MaxRuns:    maxRuns,
+		GasLimit:   gasLimit,
+		PrepaidGas: prepaidGas,
+		PrepaidFee: prepaidFee,
+	}
+}
+
+// Route returns the module name.
+func (m MsgScheduleCall) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgScheduleCall) Type() string { return TypeMsgScheduleCall }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgScheduleCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Caller); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Caller)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Callee); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Callee)
+	}
+	return validateSchedule(m.Interval, m.GasLimit, m.PrepaidGas, m.PrepaidFee)
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgScheduleCall) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgScheduleCall) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Caller)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgCancelScheduledCall returns a new CVM scheduled call cancellation message.
+func NewMsgCancelScheduledCall(caller string, id uint64) MsgCancelScheduledCall {
+	return MsgCancelScheduledCall{
+		Caller: caller,
+		Id:     id,
+	}
+}
+
+// Route returns the module name.
+func (m MsgCancelScheduledCall) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgCancelScheduledCall) Type() string { return TypeMsgCancelScheduledCall }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgCancelScheduledCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Caller); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Caller)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgCancelScheduledCall) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgCancelScheduledCall) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Caller)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgMultiCall returns a new CVM multi-call message.
+func NewMsgMultiCall(caller string, calls []MultiCallEntry, atomic bool) MsgMultiCall {
+	return MsgMultiCall{
+		Caller: caller,
+		Calls:  calls,
+		Atomic: atomic,
+	}
+}
+
+// Route returns the module name.
+func (m MsgMultiCall) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgMultiCall) Type() string { return TypeMsgMultiCall }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgMultiCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Caller); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Caller)
+	}
+	if len(m.Calls) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no calls")
+	}
+	for _, call := range m.Calls {
+		if _, err := sdk.AccAddressFromBech32(call.Callee); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, call.Callee)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgMultiCall) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgMultiCall) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Caller)
+	return []sdk.AccAddress{addr}
+}
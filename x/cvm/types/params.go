@@ -2,38 +2,158 @@ package types
 
 import (
 	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/certikfoundation/shentu/vm"
+	oracletypes "github.com/certikfoundation/shentu/x/oracle/types"
 )
 
 // Default parameter values
 const (
 	DefaultGasRate uint64 = 1
+
+	DefaultReceiptRetentionBlocks uint64 = 100000
+
+	DefaultTransactionGasLimit uint64 = 5000000
+
+	// DefaultCertifierFreezeDuration is the default duration of the freezes of contracts by certifiers,
+	// which leaves time for a governance proposal to take over.
+	DefaultCertifierFreezeDuration = 3 * 24 * time.Hour
+
+	// DefaultScheduleBlockGasLimit is the default gas the scheduled calls can use at the beginning of
+	// each block.
+	DefaultScheduleBlockGasLimit uint64 = 10000000
+
+	// MaxGasCost bounds the costs of the gas schedule, so that dynamic gas costs cannot overflow.
+	MaxGasCost uint64 = 1 << 24
 )
 
 // Parameter keys
 var (
-	ParamStoreKeyGasRate = []byte("GasRate")
+	ParamStoreKeyGasRate             = []byte("GasRate")
+	ParamStoreKeyReceiptParams       = []byte("ReceiptParams")
+	ParamStoreKeyTransactionGasLimit = []byte("TransactionGasLimit")
+	ParamStoreKeyGasSchedule         = []byte("GasSchedule")
+	ParamStoreKeyForks               = []byte("Forks")
+
+	ParamStoreKeyCertifierFreezeDuration = []byte("CertifierFreezeDuration")
+	ParamStoreKeyOracleCheckParams       = []byte("OracleCheckParams")
+	ParamStoreKeyDispatchAllowlist       = []byte("DispatchAllowlist")
+	ParamStoreKeyScheduleBlockGasLimit   = []byte("ScheduleBlockGasLimit")
 )
 
 var _ paramtypes.ParamSet = &Params{}
 
 // Params defines the parameters for the cvm module.
 type Params struct {
-	GasRate uint64 `json:"gas_rate"`
+	GasRate             uint64        `json:"gas_rate"`
+	ReceiptParams       ReceiptParams `json:"receipt_params"`
+	TransactionGasLimit uint64        `json:"transaction_gas_limit"`
+	GasSchedule         GasSchedule   `json:"gas_schedule"`
+	Forks               Forks         `json:"forks"`
+
+	CertifierFreezeDuration time.Duration     `json:"certifier_freeze_duration"`
+	OracleCheckParams       OracleCheckParams `json:"oracle_check_params"`
+	DispatchAllowlist       []string          `json:"dispatch_allowlist"`
+	ScheduleBlockGasLimit   uint64            `json:"schedule_block_gas_limit"`
 }
 
 // NewParams creates a new Params object.
-func NewParams(gasRate uint64) Params {
+func NewParams(gasRate uint64, receiptParams ReceiptParams, transactionGasLimit uint64, gasSchedule GasSchedule, forks Forks,
+	certifierFreezeDuration time.Duration, oracleCheckParams OracleCheckParams, dispatchAllowlist []string,
+	scheduleBlockGasLimit uint64) Params {
 	return Params{
-		GasRate: gasRate,
+		GasRate:                 gasRate,
+		ReceiptParams:           receiptParams,
+		TransactionGasLimit:     transactionGasLimit,
+		GasSchedule:             gasSchedule,
+		Forks:                   forks,
+		CertifierFreezeDuration: certifierFreezeDuration,
+		OracleCheckParams:       oracleCheckParams,
+		DispatchAllowlist:       dispatchAllowlist,
+		ScheduleBlockGasLimit:   scheduleBlockGasLimit,
+	}
+}
+
+// DefaultReceiptParams returns the default ReceiptParams, which keep and index receipts for
+// DefaultReceiptRetentionBlocks blocks.
+func DefaultReceiptParams() ReceiptParams {
+	return ReceiptParams{
+		Enabled:         true,
+		RetentionBlocks: DefaultReceiptRetentionBlocks,
+		IndexEnabled:    true,
+	}
+}
+
+// DefaultOracleCheckParams returns the default OracleCheckParams, which reject the calls into
+// contracts below the threshold score of the oracle task parameters.
+func DefaultOracleCheckParams() OracleCheckParams {
+	return OracleCheckParams{
+		Enabled:        true,
+		ThresholdScore: sdk.ZeroInt(),
 	}
 }
 
+// DefaultDispatchAllowlist returns the default Msg service methods of the messages contracts can
+// dispatch.
+func DefaultDispatchAllowlist() []string {
+	return []string{
+		"/cosmos.bank.v1beta1.Msg/Send",
+		"/shentu.gov.v1alpha1.Msg/Vote",
+		"/shentu.oracle.v1alpha1.Msg/CreateTask",
+		"/shentu.shield.v1alpha1.Msg/PurchaseShield",
+	}
+}
+
+// DefaultGasSchedule returns the default GasSchedule of the CVM.
+func DefaultGasSchedule() GasSchedule {
+	return GasSchedule(vm.DefaultGasSchedule())
+}
+
+// DefaultForks returns the default Forks, which apply all the upgrades from the first block.
+func DefaultForks() Forks {
+	return Forks{
+		IstanbulHeight:  1,
+		BerlinHeight:    1,
+		GasChargeHeight: 1,
+	}
+}
+
+// Rules returns the upgrade rules of the CVM at the block height.
+func (f Forks) Rules(height int64) vm.Rules {
+	return vm.Rules{
+		IsIstanbul: isForked(f.IstanbulHeight, height),
+		IsBerlin:   isForked(f.BerlinHeight, height),
+	}
+}
+
+// IsGasCharged returns whether executions at the block height are charged for the CVM gas they use.
+func (f Forks) IsGasCharged(height int64) bool {
+	return isForked(f.GasChargeHeight, height)
+}
+
+func isForked(forkHeight uint64, height int64) bool {
+	return forkHeight != 0 && height >= 0 && uint64(height) >= forkHeight
+}
+
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs pairs of cvm module's parameters.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyGasRate, &p.GasRate, validateGasRate),
+		paramtypes.NewParamSetPair(ParamStoreKeyReceiptParams, &p.ReceiptParams, validateReceiptParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyTransactionGasLimit, &p.TransactionGasLimit, validateTransactionGasLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyGasSchedule, &p.GasSchedule, validateGasSchedule),
+		paramtypes.NewParamSetPair(ParamStoreKeyForks, &p.Forks, validateForks),
+		paramtypes.NewParamSetPair(ParamStoreKeyCertifierFreezeDuration, &p.CertifierFreezeDuration, validateCertifierFreezeDuration),
+		paramtypes.NewParamSetPair(ParamStoreKeyOracleCheckParams, &p.OracleCheckParams, validateOracleCheckParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyDispatchAllowlist, &p.DispatchAllowlist, validateDispatchAllowlist),
+		paramtypes.NewParamSetPair(ParamStoreKeyScheduleBlockGasLimit, &p.ScheduleBlockGasLimit, validateScheduleBlockGasLimit),
 	}
 }
 
@@ -42,7 +162,28 @@ func (p Params) Validate() error {
 	if err := validateGasRate(p.GasRate); err != nil {
 		return err
 	}
-	return nil
+	if err := validateReceiptParams(p.ReceiptParams); err != nil {
+		return err
+	}
+	if err := validateTransactionGasLimit(p.TransactionGasLimit); err != nil {
+		return err
+	}
+	if err := validateGasSchedule(p.GasSchedule); err != nil {
+		return err
+	}
+	if err := validateForks(p.Forks); err != nil {
+		return err
+	}
+	if err := validateCertifierFreezeDuration(p.CertifierFreezeDuration); err != nil {
+		return err
+	}
+	if err := validateOracleCheckParams(p.OracleCheckParams); err != nil {
+		return err
+	}
+	if err := validateDispatchAllowlist(p.DispatchAllowlist); err != nil {
+		return err
+	}
+	return validateScheduleBlockGasLimit(p.ScheduleBlockGasLimit)
 }
 
 func validateGasRate(i interface{}) error {
@@ -56,6 +197,123 @@ func validateGasRate(i interface{}) error {
 	return nil
 }
 
+func validateReceiptParams(i interface{}) error {
+	v, ok := i.(ReceiptParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IndexEnabled && !v.Enabled {
+		return fmt.Errorf("receipt index is enabled without receipts")
+	}
+	return nil
+}
+
+func validateTransactionGasLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 || v > math.MaxInt64 {
+		return fmt.Errorf("invalid transaction gas limit: %d", v)
+	}
+	return nil
+}
+
+// gasScheduleRefunds are the fields of the gas schedule that are refunds rather than costs, which
+// may be zero.
+var gasScheduleRefunds = map[string]bool{
+	"SelfdestructRefund": true,
+	"SstoreRefund":       true,
+}
+
+func validateGasSchedule(i interface{}) error {
+	v, ok := i.(GasSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.QuadCoeffDiv == 0 {
+		return fmt.Errorf("invalid gas schedule: zero quad coeff div")
+	}
+	costs := reflect.ValueOf(v)
+	for i := 0; i < costs.NumField(); i++ {
+		name, cost := costs.Type().Field(i).Name, costs.Field(i).Uint()
+		if cost > MaxGasCost {
+			return fmt.Errorf("invalid gas schedule: %s cost %d is greater than %d", name, cost, MaxGasCost)
+		}
+		// Free opcodes would allow loops that run without ever running out of gas.
+		if cost == 0 && !gasScheduleRefunds[name] {
+			return fmt.Errorf("invalid gas schedule: zero %s cost", name)
+		}
+	}
+	return nil
+}
+
+func validateForks(i interface{}) error {
+	v, ok := i.(Forks)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.BerlinHeight != 0 && (v.IstanbulHeight == 0 || v.IstanbulHeight > v.BerlinHeight) {
+		return fmt.Errorf("invalid forks: berlin height %d is before istanbul height %d", v.BerlinHeight, v.IstanbulHeight)
+	}
+	return nil
+}
+
+func validateCertifierFreezeDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("invalid certifier freeze duration: %s", v)
+	}
+	return nil
+}
+
+func validateOracleCheckParams(i interface{}) error {
+	v, ok := i.(OracleCheckParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.ThresholdScore.IsNil() || v.ThresholdScore.IsNegative() || v.ThresholdScore.GT(oracletypes.MaxScore) {
+		return fmt.Errorf("invalid oracle threshold score: %s", v.ThresholdScore)
+	}
+	return nil
+}
+
+func validateDispatchAllowlist(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	methods := make(map[string]bool)
+	for _, method := range v {
+		if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 {
+			return fmt.Errorf("invalid dispatch allowlist: %s is not a Msg service method", method)
+		}
+		// Messages of the CVM would run nested executions, which could overwrite each other's state.
+		if strings.HasPrefix(method, "/shentu.cvm.") {
+			return fmt.Errorf("invalid dispatch allowlist: %s is a CVM message", method)
+		}
+		if methods[method] {
+			return fmt.Errorf("invalid dispatch allowlist: duplicate method %s", method)
+		}
+		methods[method] = true
+	}
+	return nil
+}
+
+func validateScheduleBlockGasLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 || v > math.MaxInt64 {
+		return fmt.Errorf("invalid schedule block gas limit: %d", v)
+	}
+	return nil
+}
+
 // ParamKeyTable for auth module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
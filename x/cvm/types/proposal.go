@@ -0,0 +1,148 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+const (
+	// ProposalTypeContractMigration defines the type for a ContractMigrationProposal.
+	ProposalTypeContractMigration = "ContractMigration"
+	// ProposalTypeContractFreeze defines the type for a ContractFreezeProposal.
+	ProposalTypeContractFreeze = "ContractFreeze"
+	// ProposalTypeContractUnfreeze defines the type for a ContractUnfreezeProposal.
+	ProposalTypeContractUnfreeze = "ContractUnfreeze"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &ContractMigrationProposal{}
+	_ govtypes.Content = &ContractFreezeProposal{}
+	_ govtypes.Content = &ContractUnfreezeProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeContractMigration)
+	govtypes.RegisterProposalTypeCodec(ContractMigrationProposal{}, "cvm/ContractMigrationProposal")
+	govtypes.RegisterProposalType(ProposalTypeContractFreeze)
+	govtypes.RegisterProposalTypeCodec(ContractFreezeProposal{}, "cvm/ContractFreezeProposal")
+	govtypes.RegisterProposalType(ProposalTypeContractUnfreeze)
+	govtypes.RegisterProposalTypeCodec(ContractUnfreezeProposal{}, "cvm/ContractUnfreezeProposal")
+}
+
+// NewContractMigrationProposal creates a new contract migration proposal.
+func NewContractMigrationProposal(title, description, contract string, code acm.Bytecode, abi string,
+	meta []*payload.ContractMeta) *ContractMigrationProposal {
+	return &ContractMigrationProposal{
+		Title:       title,
+		Description: description,
+		Contract:    contract,
+		Code:        code,
+		Abi:         abi,
+		Meta:        meta,
+	}
+}
+
+// GetTitle returns the title of a contract migration proposal.
+func (p ContractMigrationProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a contract migration proposal.
+func (p ContractMigrationProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a contract migration proposal.
+func (p ContractMigrationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a contract migration proposal.
+func (p ContractMigrationProposal) ProposalType() string { return ProposalTypeContractMigration }
+
+// ValidateBasic runs basic stateless validity checks.
+func (p ContractMigrationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(&p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, p.Contract)
+	}
+	if len(p.Code) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty code")
+	}
+	return nil
+}
+
+// NewContractFreezeProposal creates a new contract freeze proposal.
+func NewContractFreezeProposal(title, description, contract, reason string, duration time.Duration) *ContractFreezeProposal {
+	return &ContractFreezeProposal{
+		Title:       title,
+		Description: description,
+		Contract:    contract,
+		Reason:      reason,
+		Duration:    duration,
+	}
+}
+
+// GetTitle returns the title of a contract freeze proposal.
+func (p ContractFreezeProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a contract freeze proposal.
+func (p ContractFreezeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a contract freeze proposal.
+func (p ContractFreezeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a contract freeze proposal.
+func (p ContractFreezeProposal) ProposalType() string { return ProposalTypeContractFreeze }
+
+// ValidateBasic runs basic stateless validity checks.
+func (p ContractFreezeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(&p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, p.Contract)
+	}
+	if p.Reason == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty reason")
+	}
+	if p.Duration < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "negative duration %s", p.Duration)
+	}
+	return nil
+}
+
+// NewContractUnfreezeProposal creates a new contract unfreeze proposal.
+func NewContractUnfreezeProposal(title, description, contract string) *ContractUnfreezeProposal {
+	return &ContractUnfreezeProposal{
+		Title:       title,
+		Description: description,
+		Contract:    contract,
+	}
+}
+
+// GetTitle returns the title of a contract unfreeze proposal.
+func (p ContractUnfreezeProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a contract unfreeze proposal.
+func (p ContractUnfreezeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a contract unfreeze proposal.
+func (p ContractUnfreezeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a contract unfreeze proposal.
+func (p ContractUnfreezeProposal) ProposalType() string { return ProposalTypeContractUnfreeze }
+
+// ValidateBasic runs basic stateless validity checks.
+func (p ContractUnfreezeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(&p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, p.Contract)
+	}
+	return nil
+}
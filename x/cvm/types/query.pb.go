@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -303,6 +306,112 @@ func (m *QueryStorageResponse) GetValue() []byte {
 	return nil
 }
 
+type QueryContractStorageRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractStorageRequest) Reset()         { *m = QueryContractStorageRequest{} }
+func (m *QueryContractStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractStorageRequest) ProtoMessage()    {}
+func (*QueryContractStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{6}
+}
+func (m *QueryContractStorageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractStorageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractStorageRequest.Merge(m, src)
+}
+func (m *QueryContractStorageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractStorageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractStorageRequest proto.InternalMessageInfo
+
+func (m *QueryContractStorageRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryContractStorageRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryContractStorageResponse struct {
+	Storage []Storage `protobuf:"bytes,1,rep,name=storage,proto3" json:"storage" yaml:"storage"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractStorageResponse) Reset()         { *m = QueryContractStorageResponse{} }
+func (m *QueryContractStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractStorageResponse) ProtoMessage()    {}
+func (*QueryContractStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{7}
+}
+func (m *QueryContractStorageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractStorageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractStorageResponse.Merge(m, src)
+}
+func (m *QueryContractStorageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractStorageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractStorageResponse proto.InternalMessageInfo
+
+func (m *QueryContractStorageResponse) GetStorage() []Storage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *QueryContractStorageResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryAddressMetaRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 }
@@ -311,7 +420,7 @@ func (m *QueryAddressMetaRequest) Reset()         { *m = QueryAddressMetaRequest
 func (m *QueryAddressMetaRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAddressMetaRequest) ProtoMessage()    {}
 func (*QueryAddressMetaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca105b05fbd41d30, []int{6}
+	return fileDescriptor_ca105b05fbd41d30, []int{8}
 }
 func (m *QueryAddressMetaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,7 +464,7 @@ func (m *QueryAddressMetaResponse) Reset()         { *m = QueryAddressMetaRespon
 func (m *QueryAddressMetaResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAddressMetaResponse) ProtoMessage()    {}
 func (*QueryAddressMetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca105b05fbd41d30, []int{7}
+	return fileDescriptor_ca105b05fbd41d30, []int{9}
 }
 func (m *QueryAddressMetaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,7 +508,7 @@ func (m *QueryMetaRequest) Reset()         { *m = QueryMetaRequest{} }
 func (m *QueryMetaRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMetaRequest) ProtoMessage()    {}
 func (*QueryMetaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca105b05fbd41d30, []int{8}
+	return fileDescriptor_ca105b05fbd41d30, []int{10}
 }
 func (m *QueryMetaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +552,7 @@ func (m *QueryMetaResponse) Reset()         { *m = QueryMetaResponse{} }
 func (m *QueryMetaResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMetaResponse) ProtoMessage()    {}
 func (*QueryMetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca105b05fbd41d30, []int{9}
+	return fileDescriptor_ca105b05fbd41d30, []int{11}
 }
 func (m *QueryMetaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +596,7 @@ func (m *QueryAccountRequest) Reset()         { *m = QueryAccountRequest{} }
 func (m *QueryAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountRequest) ProtoMessage()    {}
 func (*QueryAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca105b05fbd41d30, []int{10}
+	return fileDescriptor_ca105b05fbd41d30, []int{12}
 }
 func (m *QueryAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -533,7 +642,7 @@ func (m *CVMAccount) Reset()         { *m = CVMAccount{} }
 func (m *CVMAccount) String() string { return proto.CompactTextString(m) }
 func (*CVMAccount) ProtoMessage()    {}
 func (*CVMAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca105b05fbd41d30, []int{11}
+	return fileDescriptor_ca105b05fbd41d30, []int{13}
 }
 func (m *CVMAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -588,7 +697,7 @@ func (m *QueryViewRequest) Reset()         { *m = QueryViewRequest{} }
 func (m *QueryViewRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryViewRequest) ProtoMessage()    {}
 func (*QueryViewRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca105b05fbd41d30, []int{12}
+	return fileDescriptor_ca105b05fbd41d30, []int{14}
 }
 func (m *QueryViewRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -654,13 +763,14 @@ func (m *QueryViewRequest) GetData() []byte {
 
 type QueryViewResponse struct {
 	ReturnVars []*ReturnVars `protobuf:"bytes,1,rep,name=return_vars,json=returnVars,proto3" json:"return_vars,omitempty" yaml:"return_vars"`
+	ReturnData []byte        `protobuf:"bytes,2,opt,name=return_data,json=returnData,proto3" json:"return_data,omitempty" yaml:"return_data"`
 }
 
 func (m *QueryViewResponse) Reset()         { *m = QueryViewResponse{} }
 func (m *QueryViewResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryViewResponse) ProtoMessage()    {}
 func (*QueryViewResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca105b05fbd41d30, []int{13}
+	return fileDescriptor_ca105b05fbd41d30, []int{15}
 }
 func (m *QueryViewResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,23 +806,35 @@ func (m *QueryViewResponse) GetReturnVars() []*ReturnVars {
 	return nil
 }
 
-type ReturnVars struct {
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty" yaml:"value"`
+func (m *QueryViewResponse) GetReturnData() []byte {
+	if m != nil {
+		return m.ReturnData
+	}
+	return nil
 }
 
-func (m *ReturnVars) Reset()         { *m = ReturnVars{} }
-func (m *ReturnVars) String() string { return proto.CompactTextString(m) }
-func (*ReturnVars) ProtoMessage()    {}
-func (*ReturnVars) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca105b05fbd41d30, []int{14}
+type QuerySimulateRequest struct {
+	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty" yaml:"caller"`
+	// callee is empty for contract deployments.
+	Callee    string `protobuf:"bytes,2,opt,name=callee,proto3" json:"callee,omitempty" yaml:"callee"`
+	Value     uint64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty" yaml:"value"`
+	Data      []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty" yaml:"data"`
+	IsEwasm   bool   `protobuf:"varint,5,opt,name=is_ewasm,json=isEwasm,proto3" json:"is_ewasm,omitempty" yaml:"is_ewasm"`
+	IsRuntime bool   `protobuf:"varint,6,opt,name=is_runtime,json=isRuntime,proto3" json:"is_runtime,omitempty" yaml:"is_runtime"`
 }
-func (m *ReturnVars) XXX_Unmarshal(b []byte) error {
+
+func (m *QuerySimulateRequest) Reset()         { *m = QuerySimulateRequest{} }
+func (m *QuerySimulateRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateRequest) ProtoMessage()    {}
+func (*QuerySimulateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{16}
+}
+func (m *QuerySimulateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReturnVars) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySimulateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReturnVars.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySimulateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -722,1179 +844,9703 @@ func (m *ReturnVars) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ReturnVars) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReturnVars.Merge(m, src)
+func (m *QuerySimulateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateRequest.Merge(m, src)
 }
-func (m *ReturnVars) XXX_Size() int {
+func (m *QuerySimulateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReturnVars) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReturnVars.DiscardUnknown(m)
+func (m *QuerySimulateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReturnVars proto.InternalMessageInfo
+var xxx_messageInfo_QuerySimulateRequest proto.InternalMessageInfo
 
-func (m *ReturnVars) GetName() string {
+func (m *QuerySimulateRequest) GetCaller() string {
 	if m != nil {
-		return m.Name
+		return m.Caller
 	}
 	return ""
 }
 
-func (m *ReturnVars) GetValue() string {
+func (m *QuerySimulateRequest) GetCallee() string {
 	if m != nil {
-		return m.Value
+		return m.Callee
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryCodeRequest)(nil), "shentu.cvm.v1alpha1.QueryCodeRequest")
-	proto.RegisterType((*QueryCodeResponse)(nil), "shentu.cvm.v1alpha1.QueryCodeResponse")
-	proto.RegisterType((*QueryAbiRequest)(nil), "shentu.cvm.v1alpha1.QueryAbiRequest")
-	proto.RegisterType((*QueryAbiResponse)(nil), "shentu.cvm.v1alpha1.QueryAbiResponse")
-	proto.RegisterType((*QueryStorageRequest)(nil), "shentu.cvm.v1alpha1.QueryStorageRequest")
-	proto.RegisterType((*QueryStorageResponse)(nil), "shentu.cvm.v1alpha1.QueryStorageResponse")
-	proto.RegisterType((*QueryAddressMetaRequest)(nil), "shentu.cvm.v1alpha1.QueryAddressMetaRequest")
-	proto.RegisterType((*QueryAddressMetaResponse)(nil), "shentu.cvm.v1alpha1.QueryAddressMetaResponse")
-	proto.RegisterType((*QueryMetaRequest)(nil), "shentu.cvm.v1alpha1.QueryMetaRequest")
-	proto.RegisterType((*QueryMetaResponse)(nil), "shentu.cvm.v1alpha1.QueryMetaResponse")
-	proto.RegisterType((*QueryAccountRequest)(nil), "shentu.cvm.v1alpha1.QueryAccountRequest")
-	proto.RegisterType((*CVMAccount)(nil), "shentu.cvm.v1alpha1.CVMAccount")
-	proto.RegisterType((*QueryViewRequest)(nil), "shentu.cvm.v1alpha1.QueryViewRequest")
-	proto.RegisterType((*QueryViewResponse)(nil), "shentu.cvm.v1alpha1.QueryViewResponse")
-	proto.RegisterType((*ReturnVars)(nil), "shentu.cvm.v1alpha1.ReturnVars")
+func (m *QuerySimulateRequest) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("shentu/cvm/v1alpha1/query.proto", fileDescriptor_ca105b05fbd41d30) }
-
-var fileDescriptor_ca105b05fbd41d30 = []byte{
-	// 985 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0xdb, 0x74, 0xbb, 0x3b, 0xc9, 0x6e, 0xbb, 0xd3, 0x0a, 0xa2, 0x00, 0x71, 0x35, 0x65,
-	0xab, 0xac, 0x68, 0xed, 0x6d, 0x77, 0x11, 0x2b, 0x24, 0x7e, 0x34, 0x3d, 0xb0, 0x07, 0x16, 0x09,
-	0xaf, 0x54, 0x01, 0x97, 0x30, 0x76, 0xa6, 0x89, 0xd5, 0xd8, 0x93, 0x7a, 0xc6, 0x29, 0x51, 0x55,
-	0x21, 0x71, 0x42, 0x88, 0x03, 0x12, 0x17, 0xae, 0xfc, 0x11, 0xfc, 0x0f, 0x1c, 0x2b, 0x71, 0xe1,
-	0x64, 0x41, 0xcb, 0x5f, 0xe0, 0x33, 0x87, 0xd5, 0xfc, 0x70, 0xe2, 0xb4, 0x69, 0x1a, 0xe5, 0x66,
-	0xcf, 0xfb, 0xde, 0xfb, 0xbe, 0xf7, 0x66, 0xe6, 0xb3, 0x81, 0xc9, 0xda, 0x24, 0xe4, 0xb1, 0xed,
-	0xf5, 0x02, 0xbb, 0xb7, 0x83, 0x3b, 0xdd, 0x36, 0xde, 0xb1, 0x8f, 0x63, 0x12, 0xf5, 0xad, 0x6e,
-	0x44, 0x39, 0x85, 0xab, 0x0a, 0x60, 0x79, 0xbd, 0xc0, 0xca, 0x00, 0x95, 0xb5, 0x16, 0x6d, 0x51,
-	0x19, 0xb7, 0xc5, 0x93, 0x82, 0x56, 0xde, 0x6e, 0x51, 0xda, 0xea, 0x10, 0x1b, 0x77, 0x7d, 0x1b,
-	0x87, 0x21, 0xe5, 0x98, 0xfb, 0x34, 0x64, 0x3a, 0xfa, 0xce, 0x38, 0x26, 0x51, 0x55, 0x85, 0xab,
-	0x1e, 0x65, 0x01, 0x65, 0x36, 0x8e, 0x79, 0xdb, 0xee, 0xed, 0xb8, 0x84, 0xe3, 0x1d, 0xf9, 0xa2,
-	0xe3, 0x2b, 0x6e, 0x1c, 0x45, 0xf4, 0xc4, 0xc6, 0x9e, 0xce, 0x40, 0x9f, 0x82, 0x95, 0x2f, 0x85,
-	0xd0, 0x7d, 0xda, 0x24, 0x0e, 0x39, 0x8e, 0x09, 0xe3, 0x70, 0x0b, 0x2c, 0xe1, 0x66, 0x33, 0x22,
-	0x8c, 0x95, 0x8d, 0x75, 0xa3, 0x76, 0xaf, 0x0e, 0xd3, 0xc4, 0x7c, 0xd0, 0xc7, 0x41, 0xe7, 0x43,
-	0xa4, 0x03, 0xc8, 0xc9, 0x20, 0xe8, 0x39, 0x78, 0x98, 0xab, 0xc0, 0xba, 0x34, 0x64, 0x04, 0x6e,
-	0x80, 0x82, 0x47, 0x9b, 0x44, 0xe7, 0x2f, 0xa7, 0x89, 0x59, 0x54, 0xf9, 0x62, 0x15, 0x39, 0x32,
-	0x88, 0x3e, 0x01, 0xcb, 0x32, 0x73, 0xcf, 0xf5, 0x67, 0xa3, 0x7e, 0xa6, 0xc5, 0xcb, 0x02, 0x9a,
-	0x79, 0x1d, 0x2c, 0x60, 0xd7, 0xd7, 0xd9, 0x0f, 0xd2, 0xc4, 0x04, 0x3a, 0xdb, 0xf5, 0x91, 0x23,
-	0x42, 0x88, 0x80, 0x55, 0x99, 0xf5, 0x8a, 0xd3, 0x08, 0xb7, 0x66, 0xeb, 0x5a, 0xd0, 0x1c, 0x91,
-	0x7e, 0x79, 0xfe, 0x2a, 0xcd, 0x11, 0xe9, 0x23, 0x47, 0x84, 0xd0, 0xc7, 0x60, 0x6d, 0x94, 0x46,
-	0x0b, 0xdc, 0x04, 0x8b, 0x3d, 0xdc, 0x89, 0xd5, 0x6c, 0x4a, 0xf5, 0x95, 0x34, 0x31, 0x4b, 0x2a,
-	0x57, 0x2e, 0x23, 0x47, 0x85, 0xd1, 0x67, 0xe0, 0x4d, 0xd5, 0x9c, 0x62, 0x7c, 0x49, 0x38, 0x9e,
-	0x6d, 0x4a, 0x9f, 0x83, 0xf2, 0xf5, 0x42, 0x5a, 0xcc, 0x13, 0x70, 0x2f, 0x20, 0x1c, 0x37, 0xda,
-	0x98, 0xb5, 0x75, 0xad, 0xd5, 0x34, 0x31, 0x97, 0x55, 0x2d, 0x11, 0x7a, 0x81, 0x59, 0x1b, 0x39,
-	0x77, 0x07, 0x8f, 0x1f, 0xe8, 0x99, 0xe7, 0xf5, 0x6c, 0x80, 0x42, 0xae, 0x40, 0x6e, 0xb7, 0xdb,
-	0x32, 0x59, 0x06, 0x07, 0xe7, 0x64, 0x84, 0x7f, 0x03, 0x14, 0x44, 0xe5, 0xeb, 0x99, 0x62, 0x15,
-	0x39, 0x32, 0x88, 0xf6, 0xf5, 0x86, 0xed, 0x79, 0x1e, 0x8d, 0x43, 0x3e, 0xdb, 0x14, 0xfe, 0x30,
-	0x00, 0xd8, 0x3f, 0x78, 0xa9, 0x6b, 0xc0, 0x6f, 0x41, 0xc9, 0xc5, 0x8c, 0x34, 0xb0, 0x7a, 0x97,
-	0x15, 0x8a, 0xbb, 0xeb, 0x96, 0xba, 0x40, 0x96, 0xbc, 0x33, 0xfa, 0x02, 0x59, 0x75, 0xcc, 0x88,
-	0xce, 0xab, 0xbf, 0x75, 0x9e, 0x98, 0x46, 0x9a, 0x98, 0xab, 0x8a, 0x27, 0x5f, 0x03, 0x39, 0x45,
-	0x77, 0x88, 0x1c, 0x5c, 0x81, 0xf9, 0x09, 0x57, 0x20, 0x3b, 0xad, 0x0b, 0x37, 0x9f, 0xd6, 0xff,
-	0x0d, 0x3d, 0xf0, 0x03, 0x9f, 0x9c, 0x64, 0xad, 0x3f, 0x06, 0x77, 0x3c, 0xdc, 0xe9, 0x90, 0x48,
-	0x77, 0xfe, 0x30, 0x4d, 0xcc, 0xfb, 0xba, 0xba, 0x5c, 0x47, 0x8e, 0x06, 0x0c, 0xa0, 0x99, 0x90,
-	0xab, 0x50, 0x92, 0x41, 0x09, 0xb4, 0xc0, 0x5d, 0xec, 0xfa, 0x0d, 0xd6, 0x25, 0x9e, 0x54, 0x54,
-	0xca, 0x9f, 0x85, 0x2c, 0x22, 0x46, 0xea, 0xfa, 0xaf, 0xba, 0xc4, 0x83, 0x1f, 0x81, 0xfb, 0x87,
-	0x71, 0xe8, 0x09, 0x7f, 0x6a, 0x84, 0x38, 0x20, 0xe5, 0x82, 0x64, 0x28, 0xa7, 0x89, 0xb9, 0xa6,
-	0x92, 0x46, 0xc2, 0xc8, 0x29, 0x65, 0xef, 0x5f, 0xe0, 0x40, 0xee, 0x7d, 0x13, 0x73, 0x5c, 0x5e,
-	0x94, 0x54, 0xb9, 0x01, 0x89, 0x55, 0xe4, 0xc8, 0x20, 0x0a, 0xf4, 0xa9, 0x51, 0xdd, 0xeb, 0x53,
-	0xf3, 0x15, 0x28, 0x46, 0x84, 0xc7, 0x51, 0xd8, 0xe8, 0xe1, 0x48, 0xec, 0xfe, 0x42, 0xad, 0xb8,
-	0x6b, 0x5a, 0x63, 0x4c, 0xd6, 0x72, 0x24, 0xee, 0x00, 0x47, 0xac, 0xfe, 0x46, 0x9a, 0x98, 0x50,
-	0x31, 0xe4, 0xb2, 0x91, 0x03, 0xa2, 0x01, 0x06, 0x7d, 0x0d, 0xc0, 0x30, 0x43, 0x28, 0x94, 0x7d,
-	0x5d, 0x3b, 0x9d, 0xaa, 0x1d, 0x19, 0x1c, 0xde, 0x67, 0x35, 0xdf, 0x9b, 0xee, 0xf3, 0xee, 0xbf,
-	0x4b, 0x60, 0x51, 0xb6, 0x02, 0x7f, 0x36, 0x40, 0x41, 0xb8, 0x25, 0x7c, 0x34, 0x56, 0xf2, 0x55,
-	0x3f, 0xae, 0x6c, 0xde, 0x06, 0x53, 0x63, 0x41, 0xef, 0xff, 0xf0, 0xd7, 0x7f, 0xbf, 0xce, 0xdb,
-	0x70, 0xdb, 0x1e, 0xfb, 0x95, 0xa0, 0x21, 0x8f, 0xb0, 0xc7, 0x99, 0x7d, 0xaa, 0xef, 0xc4, 0x99,
-	0x2d, 0xcf, 0xe0, 0x8f, 0x06, 0x58, 0xd8, 0x73, 0x7d, 0xf8, 0xee, 0xcd, 0x34, 0x43, 0x87, 0xae,
-	0x3c, 0xba, 0x05, 0xa5, 0xb5, 0x3c, 0x93, 0x5a, 0x2c, 0xb8, 0x35, 0xb5, 0x16, 0xec, 0xfa, 0xf0,
-	0x37, 0x03, 0x2c, 0x69, 0xbf, 0x84, 0xb5, 0x9b, 0x89, 0x46, 0x9d, 0xbb, 0xf2, 0x78, 0x0a, 0xa4,
-	0x96, 0xf5, 0x5c, 0xca, 0xda, 0x85, 0x4f, 0xa6, 0x96, 0xc5, 0xb4, 0x9c, 0xdf, 0x0d, 0x50, 0xcc,
-	0x39, 0x28, 0xdc, 0x9a, 0x30, 0x87, 0x6b, 0x8e, 0x5d, 0xd9, 0x9e, 0x12, 0x3d, 0xf3, 0x4e, 0x0a,
-	0xa3, 0x84, 0xdf, 0x83, 0x82, 0xd4, 0x36, 0x61, 0x8f, 0xf2, 0xa2, 0x36, 0x6f, 0x83, 0x69, 0x35,
-	0x35, 0xa9, 0x06, 0xc1, 0xf5, 0xb1, 0x6a, 0x04, 0xb3, 0x7d, 0x2a, 0x2c, 0xfe, 0x0c, 0x1e, 0x83,
-	0xa5, 0xcc, 0xfe, 0x26, 0x6c, 0xdf, 0xa8, 0x8f, 0x57, 0x4a, 0x96, 0xf8, 0x1d, 0xd1, 0x8b, 0xc8,
-	0x92, 0x64, 0x35, 0xb8, 0x39, 0x96, 0x4c, 0x5b, 0xed, 0xb0, 0x71, 0xf8, 0x93, 0x01, 0x0a, 0xc2,
-	0x1c, 0x26, 0x35, 0x9d, 0xb3, 0xce, 0x49, 0x4d, 0xe7, 0x3d, 0x06, 0x3d, 0x95, 0x3a, 0xb6, 0xe1,
-	0x7b, 0x63, 0x75, 0xf4, 0x7c, 0x72, 0x62, 0x9f, 0x2a, 0x8b, 0x3d, 0xd3, 0x0f, 0xe4, 0xac, 0xfe,
-	0xe2, 0xcf, 0x8b, 0xaa, 0x71, 0x7e, 0x51, 0x35, 0xfe, 0xb9, 0xa8, 0x1a, 0xbf, 0x5c, 0x56, 0xe7,
-	0xce, 0x2f, 0xab, 0x73, 0x7f, 0x5f, 0x56, 0xe7, 0xbe, 0xb1, 0x5a, 0x3e, 0x6f, 0xc7, 0xae, 0xe5,
-	0xd1, 0xc0, 0xf6, 0x48, 0xc4, 0xfd, 0xa3, 0x43, 0x1a, 0x87, 0x4d, 0xf9, 0x73, 0x97, 0x31, 0x7c,
-	0x27, 0x39, 0x78, 0xbf, 0x4b, 0x98, 0x7b, 0x47, 0xfe, 0x9e, 0x3d, 0x7d, 0x1d, 0x00, 0x00, 0xff,
-	0xff, 0x91, 0x47, 0x4a, 0xe5, 0x5b, 0x0a, 0x00, 0x00,
+func (m *QuerySimulateRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
-	Abi(ctx context.Context, in *QueryAbiRequest, opts ...grpc.CallOption) (*QueryAbiResponse, error)
-	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
-	AddressMeta(ctx context.Context, in *QueryAddressMetaRequest, opts ...grpc.CallOption) (*QueryAddressMetaResponse, error)
-	Meta(ctx context.Context, in *QueryMetaRequest, opts ...grpc.CallOption) (*QueryMetaResponse, error)
-	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*acm.Account, error)
-	View(ctx context.Context, in *QueryViewRequest, opts ...grpc.CallOption) (*QueryViewResponse, error)
+func (m *QuerySimulateRequest) GetIsEwasm() bool {
+	if m != nil {
+		return m.IsEwasm
+	}
+	return false
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySimulateRequest) GetIsRuntime() bool {
+	if m != nil {
+		return m.IsRuntime
+	}
+	return false
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QuerySimulateResponse struct {
+	GasUsed      uint64 `protobuf:"varint,1,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+	SdkGasUsed   uint64 `protobuf:"varint,2,opt,name=sdk_gas_used,json=sdkGasUsed,proto3" json:"sdk_gas_used,omitempty" yaml:"sdk_gas_used"`
+	Refund       uint64 `protobuf:"varint,3,opt,name=refund,proto3" json:"refund,omitempty" yaml:"refund"`
+	ReturnData   []byte `protobuf:"bytes,4,opt,name=return_data,json=returnData,proto3" json:"return_data,omitempty" yaml:"return_data"`
+	Reverted     bool   `protobuf:"varint,5,opt,name=reverted,proto3" json:"reverted,omitempty" yaml:"reverted"`
+	RevertReason string `protobuf:"bytes,6,opt,name=revert_reason,json=revertReason,proto3" json:"revert_reason,omitempty" yaml:"revert_reason"`
 }
 
-func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
-	out := new(QueryCodeResponse)
-	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Code", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateResponse) Reset()         { *m = QuerySimulateResponse{} }
+func (m *QuerySimulateResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateResponse) ProtoMessage()    {}
+func (*QuerySimulateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{17}
+}
+func (m *QuerySimulateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySimulateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateResponse.Merge(m, src)
+}
+func (m *QuerySimulateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Abi(ctx context.Context, in *QueryAbiRequest, opts ...grpc.CallOption) (*QueryAbiResponse, error) {
-	out := new(QueryAbiResponse)
-	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Abi", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error) {
-	out := new(QueryStorageResponse)
-	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Storage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateResponse) GetSdkGasUsed() uint64 {
+	if m != nil {
+		return m.SdkGasUsed
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) AddressMeta(ctx context.Context, in *QueryAddressMetaRequest, opts ...grpc.CallOption) (*QueryAddressMetaResponse, error) {
-	out := new(QueryAddressMetaResponse)
-	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/AddressMeta", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateResponse) GetRefund() uint64 {
+	if m != nil {
+		return m.Refund
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Meta(ctx context.Context, in *QueryMetaRequest, opts ...grpc.CallOption) (*QueryMetaResponse, error) {
-	out := new(QueryMetaResponse)
-	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Meta", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateResponse) GetReturnData() []byte {
+	if m != nil {
+		return m.ReturnData
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*acm.Account, error) {
-	out := new(acm.Account)
-	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Account", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateResponse) GetReverted() bool {
+	if m != nil {
+		return m.Reverted
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) View(ctx context.Context, in *QueryViewRequest, opts ...grpc.CallOption) (*QueryViewResponse, error) {
-	out := new(QueryViewResponse)
-	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/View", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateResponse) GetRevertReason() string {
+	if m != nil {
+		return m.RevertReason
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
-	Abi(context.Context, *QueryAbiRequest) (*QueryAbiResponse, error)
-	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
-	AddressMeta(context.Context, *QueryAddressMetaRequest) (*QueryAddressMetaResponse, error)
-	Meta(context.Context, *QueryMetaRequest) (*QueryMetaResponse, error)
-	Account(context.Context, *QueryAccountRequest) (*acm.Account, error)
-	View(context.Context, *QueryViewRequest) (*QueryViewResponse, error)
+type QueryDecodeLogsRequest struct {
+	Logs []Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs" yaml:"logs"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryDecodeLogsRequest) Reset()         { *m = QueryDecodeLogsRequest{} }
+func (m *QueryDecodeLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDecodeLogsRequest) ProtoMessage()    {}
+func (*QueryDecodeLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{18}
 }
-
-func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
+func (m *QueryDecodeLogsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Abi(ctx context.Context, req *QueryAbiRequest) (*QueryAbiResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Abi not implemented")
+func (m *QueryDecodeLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecodeLogsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageRequest) (*QueryStorageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
+func (m *QueryDecodeLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecodeLogsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AddressMeta(ctx context.Context, req *QueryAddressMetaRequest) (*QueryAddressMetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddressMeta not implemented")
-}
-func (*UnimplementedQueryServer) Meta(ctx context.Context, req *QueryMetaRequest) (*QueryMetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Meta not implemented")
+func (m *QueryDecodeLogsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Account(ctx context.Context, req *QueryAccountRequest) (*acm.Account, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+func (m *QueryDecodeLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecodeLogsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) View(ctx context.Context, req *QueryViewRequest) (*QueryViewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method View not implemented")
+
+var xxx_messageInfo_QueryDecodeLogsRequest proto.InternalMessageInfo
+
+func (m *QueryDecodeLogsRequest) GetLogs() []Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryDecodeLogsResponse struct {
+	Logs []DecodedLog `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs" yaml:"logs"`
 }
 
-func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Code(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.cvm.v1alpha1.Query/Code",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Code(ctx, req.(*QueryCodeRequest))
+func (m *QueryDecodeLogsResponse) Reset()         { *m = QueryDecodeLogsResponse{} }
+func (m *QueryDecodeLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDecodeLogsResponse) ProtoMessage()    {}
+func (*QueryDecodeLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{19}
+}
+func (m *QueryDecodeLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecodeLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecodeLogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDecodeLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecodeLogsResponse.Merge(m, src)
+}
+func (m *QueryDecodeLogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecodeLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecodeLogsResponse.DiscardUnknown(m)
 }
 
-func _Query_Abi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAbiRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Abi(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.cvm.v1alpha1.Query/Abi",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Abi(ctx, req.(*QueryAbiRequest))
+var xxx_messageInfo_QueryDecodeLogsResponse proto.InternalMessageInfo
+
+func (m *QueryDecodeLogsResponse) GetLogs() []DecodedLog {
+	if m != nil {
+		return m.Logs
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStorageRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Storage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.cvm.v1alpha1.Query/Storage",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Storage(ctx, req.(*QueryStorageRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// DecodedLog is a log decoded with the ABI of the contract that emitted it. The event is empty
+// if the contract has no ABI for the log.
+type DecodedLog struct {
+	Log    Log          `protobuf:"bytes,1,opt,name=log,proto3" json:"log" yaml:"log"`
+	Event  string       `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty" yaml:"event"`
+	Params []EventParam `protobuf:"bytes,3,rep,name=params,proto3" json:"params" yaml:"params"`
 }
 
-func _Query_AddressMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAddressMetaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AddressMeta(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.cvm.v1alpha1.Query/AddressMeta",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AddressMeta(ctx, req.(*QueryAddressMetaRequest))
+func (m *DecodedLog) Reset()         { *m = DecodedLog{} }
+func (m *DecodedLog) String() string { return proto.CompactTextString(m) }
+func (*DecodedLog) ProtoMessage()    {}
+func (*DecodedLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{20}
+}
+func (m *DecodedLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodedLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodedLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DecodedLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedLog.Merge(m, src)
+}
+func (m *DecodedLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodedLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedLog.DiscardUnknown(m)
 }
 
-func _Query_Meta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMetaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Meta(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.cvm.v1alpha1.Query/Meta",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Meta(ctx, req.(*QueryMetaRequest))
+var xxx_messageInfo_DecodedLog proto.InternalMessageInfo
+
+func (m *DecodedLog) GetLog() Log {
+	if m != nil {
+		return m.Log
 	}
-	return interceptor(ctx, in, info, handler)
+	return Log{}
 }
 
-func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Account(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.cvm.v1alpha1.Query/Account",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Account(ctx, req.(*QueryAccountRequest))
+func (m *DecodedLog) GetEvent() string {
+	if m != nil {
+		return m.Event
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_View_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryViewRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).View(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.cvm.v1alpha1.Query/View",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).View(ctx, req.(*QueryViewRequest))
+func (m *DecodedLog) GetParams() []EventParam {
+	if m != nil {
+		return m.Params
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "shentu.cvm.v1alpha1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Code",
-			Handler:    _Query_Code_Handler,
-		},
-		{
-			MethodName: "Abi",
-			Handler:    _Query_Abi_Handler,
-		},
-		{
-			MethodName: "Storage",
-			Handler:    _Query_Storage_Handler,
-		},
-		{
-			MethodName: "AddressMeta",
-			Handler:    _Query_AddressMeta_Handler,
-		},
-		{
-			MethodName: "Meta",
-			Handler:    _Query_Meta_Handler,
-		},
-		{
-			MethodName: "Account",
-			Handler:    _Query_Account_Handler,
-		},
-		{
-			MethodName: "View",
-			Handler:    _Query_View_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "shentu/cvm/v1alpha1/query.proto",
+type EventParam struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty" yaml:"value"`
+	Indexed bool   `protobuf:"varint,3,opt,name=indexed,proto3" json:"indexed,omitempty" yaml:"indexed"`
 }
 
-func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventParam) Reset()         { *m = EventParam{} }
+func (m *EventParam) String() string { return proto.CompactTextString(m) }
+func (*EventParam) ProtoMessage()    {}
+func (*EventParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{21}
+}
+func (m *EventParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventParam.Merge(m, src)
+}
+func (m *EventParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventParam.DiscardUnknown(m)
 }
 
-func (m *QueryCodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventParam proto.InternalMessageInfo
+
+func (m *EventParam) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *QueryCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventParam) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryCodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventParam) GetIndexed() bool {
+	if m != nil {
+		return m.Indexed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryCodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryReceiptRequest struct {
+	// tx_hash is the hex encoded hash of the transaction.
+	TxHash   string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+	MsgIndex uint32 `protobuf:"varint,2,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty" yaml:"msg_index"`
 }
 
-func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Code) > 0 {
-		i -= len(m.Code)
-		copy(dAtA[i:], m.Code)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryReceiptRequest) Reset()         { *m = QueryReceiptRequest{} }
+func (m *QueryReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiptRequest) ProtoMessage()    {}
+func (*QueryReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{22}
 }
-
-func (m *QueryAbiRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiptRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryAbiRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiptRequest.Merge(m, src)
+}
+func (m *QueryReceiptRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiptRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAbiRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryReceiptRequest proto.InternalMessageInfo
+
+func (m *QueryReceiptRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAbiResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReceiptRequest) GetMsgIndex() uint32 {
+	if m != nil {
+		return m.MsgIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAbiResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryReceiptResponse struct {
+	Receipt Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt" yaml:"receipt"`
 }
 
-func (m *QueryAbiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Abi) > 0 {
-		i -= len(m.Abi)
-		copy(dAtA[i:], m.Abi)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Abi)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryReceiptResponse) Reset()         { *m = QueryReceiptResponse{} }
+func (m *QueryReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiptResponse) ProtoMessage()    {}
+func (*QueryReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{23}
+}
+func (m *QueryReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiptResponse.Merge(m, src)
+}
+func (m *QueryReceiptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiptResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStorageRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReceiptResponse proto.InternalMessageInfo
+
+func (m *QueryReceiptResponse) GetReceipt() Receipt {
+	if m != nil {
+		return m.Receipt
 	}
-	return dAtA[:n], nil
+	return Receipt{}
 }
 
-func (m *QueryStorageRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryReceiptsRequest lists the receipts in a height range, optionally filtered by a contract address
+// or a hex encoded log topic. The filters require the receipt index.
+type QueryReceiptsRequest struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Topic      string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty" yaml:"topic"`
+	FromHeight int64  `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty" yaml:"from_height"`
+	// to_height is the last height of the range. Zero stands for the latest height.
+	ToHeight int64 `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty" yaml:"to_height"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryReceiptsRequest) Reset()         { *m = QueryReceiptsRequest{} }
+func (m *QueryReceiptsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiptsRequest) ProtoMessage()    {}
+func (*QueryReceiptsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{24}
 }
-
-func (m *QueryStorageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReceiptsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiptsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiptsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryStorageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryReceiptsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiptsRequest.Merge(m, src)
+}
+func (m *QueryReceiptsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiptsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiptsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryReceiptsRequest proto.InternalMessageInfo
+
+func (m *QueryReceiptsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAddressMetaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReceiptsRequest) GetTopic() string {
+	if m != nil {
+		return m.Topic
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAddressMetaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryReceiptsRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
 }
 
-func (m *QueryAddressMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryReceiptsRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAddressMetaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReceiptsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAddressMetaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryReceiptsResponse struct {
+	Receipts []Receipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts" yaml:"receipts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAddressMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MetaHash) > 0 {
-		i -= len(m.MetaHash)
-		copy(dAtA[i:], m.MetaHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MetaHash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryReceiptsResponse) Reset()         { *m = QueryReceiptsResponse{} }
+func (m *QueryReceiptsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiptsResponse) ProtoMessage()    {}
+func (*QueryReceiptsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{25}
 }
-
-func (m *QueryMetaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReceiptsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiptsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiptsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryReceiptsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiptsResponse.Merge(m, src)
+}
+func (m *QueryReceiptsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiptsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiptsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMetaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryReceiptsResponse proto.InternalMessageInfo
+
+func (m *QueryReceiptsResponse) GetReceipts() []Receipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
 }
 
-func (m *QueryMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryReceiptsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryMetaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueryAdminRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 }
 
-func (m *QueryMetaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAdminRequest) Reset()         { *m = QueryAdminRequest{} }
+func (m *QueryAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminRequest) ProtoMessage()    {}
+func (*QueryAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{26}
 }
-
-func (m *QueryMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Meta) > 0 {
-		i -= len(m.Meta)
-		copy(dAtA[i:], m.Meta)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Meta)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminRequest.Merge(m, src)
 }
-
-func (m *QueryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminRequest.DiscardUnknown(m)
 }
 
-func (m *CVMAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAdminRequest proto.InternalMessageInfo
+
+func (m *QueryAdminRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CVMAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAdminResponse struct {
+	// admin is empty if the contract has no admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
 }
 
-func (m *CVMAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Abi) > 0 {
-		i -= len(m.Abi)
-		copy(dAtA[i:], m.Abi)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Abi)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Code) > 0 {
-		i -= len(m.Code)
-		copy(dAtA[i:], m.Code)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BaseAccount != nil {
-		{
-			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAdminResponse) Reset()         { *m = QueryAdminResponse{} }
+func (m *QueryAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminResponse) ProtoMessage()    {}
+func (*QueryAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{27}
+}
+func (m *QueryAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminResponse.Merge(m, src)
+}
+func (m *QueryAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminResponse.DiscardUnknown(m)
 }
 
-func (m *QueryViewRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAdminResponse proto.InternalMessageInfo
+
+func (m *QueryAdminResponse) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryViewRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryVerifiedContractRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 }
 
-func (m *QueryViewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.FunctionName) > 0 {
-		i -= len(m.FunctionName)
-		copy(dAtA[i:], m.FunctionName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FunctionName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.AbiSpec) > 0 {
-		i -= len(m.AbiSpec)
-		copy(dAtA[i:], m.AbiSpec)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AbiSpec)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Callee) > 0 {
-		i -= len(m.Callee)
-		copy(dAtA[i:], m.Callee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Callee)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Caller) > 0 {
-		i -= len(m.Caller)
-		copy(dAtA[i:], m.Caller)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Caller)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryVerifiedContractRequest) Reset()         { *m = QueryVerifiedContractRequest{} }
+func (m *QueryVerifiedContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifiedContractRequest) ProtoMessage()    {}
+func (*QueryVerifiedContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{28}
+}
+func (m *QueryVerifiedContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifiedContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifiedContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVerifiedContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifiedContractRequest.Merge(m, src)
+}
+func (m *QueryVerifiedContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifiedContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifiedContractRequest.DiscardUnknown(m)
 }
 
-func (m *QueryViewResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerifiedContractRequest proto.InternalMessageInfo
+
+func (m *QueryVerifiedContractRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryViewResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryVerifiedContractResponse struct {
+	VerifiedContract VerifiedContract `protobuf:"bytes,1,opt,name=verified_contract,json=verifiedContract,proto3" json:"verified_contract" yaml:"verified_contract"`
 }
 
-func (m *QueryViewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReturnVars) > 0 {
-		for iNdEx := len(m.ReturnVars) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReturnVars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryVerifiedContractResponse) Reset()         { *m = QueryVerifiedContractResponse{} }
+func (m *QueryVerifiedContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifiedContractResponse) ProtoMessage()    {}
+func (*QueryVerifiedContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{29}
+}
+func (m *QueryVerifiedContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifiedContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifiedContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVerifiedContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifiedContractResponse.Merge(m, src)
+}
+func (m *QueryVerifiedContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifiedContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifiedContractResponse.DiscardUnknown(m)
 }
 
-func (m *ReturnVars) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerifiedContractResponse proto.InternalMessageInfo
+
+func (m *QueryVerifiedContractResponse) GetVerifiedContract() VerifiedContract {
+	if m != nil {
+		return m.VerifiedContract
 	}
-	return dAtA[:n], nil
+	return VerifiedContract{}
 }
 
-func (m *ReturnVars) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFrozenContractRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 }
 
-func (m *ReturnVars) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryFrozenContractRequest) Reset()         { *m = QueryFrozenContractRequest{} }
+func (m *QueryFrozenContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenContractRequest) ProtoMessage()    {}
+func (*QueryFrozenContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{30}
+}
+func (m *QueryFrozenContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFrozenContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenContractRequest.Merge(m, src)
+}
+func (m *QueryFrozenContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenContractRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryFrozenContractRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenContractRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryCodeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+type QueryFrozenContractResponse struct {
+	FrozenContract FrozenContract `protobuf:"bytes,1,opt,name=frozen_contract,json=frozenContract,proto3" json:"frozen_contract" yaml:"frozen_contract"`
 }
 
-func (m *QueryCodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFrozenContractResponse) Reset()         { *m = QueryFrozenContractResponse{} }
+func (m *QueryFrozenContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenContractResponse) ProtoMessage()    {}
+func (*QueryFrozenContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{31}
+}
+func (m *QueryFrozenContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryFrozenContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenContractResponse.Merge(m, src)
+}
+func (m *QueryFrozenContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenContractResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAbiRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryFrozenContractResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenContractResponse) GetFrozenContract() FrozenContract {
+	if m != nil {
+		return m.FrozenContract
 	}
-	return n
+	return FrozenContract{}
 }
 
-func (m *QueryAbiResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Abi)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryFrozenContractsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStorageRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFrozenContractsRequest) Reset()         { *m = QueryFrozenContractsRequest{} }
+func (m *QueryFrozenContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenContractsRequest) ProtoMessage()    {}
+func (*QueryFrozenContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{32}
+}
+func (m *QueryFrozenContractsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenContractsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryFrozenContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenContractsRequest.Merge(m, src)
+}
+func (m *QueryFrozenContractsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenContractsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStorageResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryFrozenContractsRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenContractsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAddressMetaRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryFrozenContractsResponse struct {
+	FrozenContracts []FrozenContract `protobuf:"bytes,1,rep,name=frozen_contracts,json=frozenContracts,proto3" json:"frozen_contracts" yaml:"frozen_contracts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAddressMetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MetaHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFrozenContractsResponse) Reset()         { *m = QueryFrozenContractsResponse{} }
+func (m *QueryFrozenContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenContractsResponse) ProtoMessage()    {}
+func (*QueryFrozenContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{33}
+}
+func (m *QueryFrozenContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryFrozenContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenContractsResponse.Merge(m, src)
+}
+func (m *QueryFrozenContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenContractsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMetaRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryFrozenContractsResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenContractsResponse) GetFrozenContracts() []FrozenContract {
+	if m != nil {
+		return m.FrozenContracts
 	}
-	return n
+	return nil
 }
 
-func (m *QueryMetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Meta)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFrozenContractsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QuerySponsorshipRequest struct {
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
 }
 
-func (m *CVMAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseAccount != nil {
-		l = m.BaseAccount.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Abi)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySponsorshipRequest) Reset()         { *m = QuerySponsorshipRequest{} }
+func (m *QuerySponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipRequest) ProtoMessage()    {}
+func (*QuerySponsorshipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{34}
+}
+func (m *QuerySponsorshipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipRequest.Merge(m, src)
+}
+func (m *QuerySponsorshipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipRequest.DiscardUnknown(m)
 }
 
-func (m *QueryViewRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySponsorshipRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorshipRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	var l int
-	_ = l
-	l = len(m.Caller)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+type QuerySponsorshipResponse struct {
+	Sponsorship Sponsorship `protobuf:"bytes,1,opt,name=sponsorship,proto3" json:"sponsorship" yaml:"sponsorship"`
+	// balance is the spendable balance of the sponsor.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance" yaml:"balance"`
+}
+
+func (m *QuerySponsorshipResponse) Reset()         { *m = QuerySponsorshipResponse{} }
+func (m *QuerySponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipResponse) ProtoMessage()    {}
+func (*QuerySponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{35}
+}
+func (m *QuerySponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Callee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuerySponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipResponse.Merge(m, src)
+}
+func (m *QuerySponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorshipResponse) GetSponsorship() Sponsorship {
+	if m != nil {
+		return m.Sponsorship
 	}
-	l = len(m.AbiSpec)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return Sponsorship{}
+}
+
+func (m *QuerySponsorshipResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
 	}
-	l = len(m.FunctionName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QuerySponsorUsageRequest struct {
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	User     string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty" yaml:"user"`
+}
+
+func (m *QuerySponsorUsageRequest) Reset()         { *m = QuerySponsorUsageRequest{} }
+func (m *QuerySponsorUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorUsageRequest) ProtoMessage()    {}
+func (*QuerySponsorUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{36}
+}
+func (m *QuerySponsorUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuerySponsorUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorUsageRequest.Merge(m, src)
+}
+func (m *QuerySponsorUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorUsageRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorUsageRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return n
+	return ""
 }
 
-func (m *QueryViewResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySponsorUsageRequest) GetUser() string {
+	if m != nil {
+		return m.User
 	}
-	var l int
-	_ = l
-	if len(m.ReturnVars) > 0 {
-		for _, e := range m.ReturnVars {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+type QuerySponsorUsageResponse struct {
+	Usage SponsorUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage" yaml:"usage"`
+	// remaining is the fees the sponsor pays for the calls of the user for the rest of the day.
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining" yaml:"remaining"`
+}
+
+func (m *QuerySponsorUsageResponse) Reset()         { *m = QuerySponsorUsageResponse{} }
+func (m *QuerySponsorUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorUsageResponse) ProtoMessage()    {}
+func (*QuerySponsorUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{37}
+}
+func (m *QuerySponsorUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorUsageResponse.Merge(m, src)
+}
+func (m *QuerySponsorUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorUsageResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorUsageResponse) GetUsage() SponsorUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return SponsorUsage{}
+}
+
+func (m *QuerySponsorUsageResponse) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
+type QuerySponsorUsagesRequest struct {
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySponsorUsagesRequest) Reset()         { *m = QuerySponsorUsagesRequest{} }
+func (m *QuerySponsorUsagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorUsagesRequest) ProtoMessage()    {}
+func (*QuerySponsorUsagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{38}
+}
+func (m *QuerySponsorUsagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorUsagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorUsagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorUsagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorUsagesRequest.Merge(m, src)
+}
+func (m *QuerySponsorUsagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorUsagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorUsagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorUsagesRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorUsagesRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QuerySponsorUsagesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySponsorUsagesResponse struct {
+	Usages []SponsorUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages" yaml:"usages"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySponsorUsagesResponse) Reset()         { *m = QuerySponsorUsagesResponse{} }
+func (m *QuerySponsorUsagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorUsagesResponse) ProtoMessage()    {}
+func (*QuerySponsorUsagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{39}
+}
+func (m *QuerySponsorUsagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorUsagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorUsagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorUsagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorUsagesResponse.Merge(m, src)
+}
+func (m *QuerySponsorUsagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorUsagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorUsagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorUsagesResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorUsagesResponse) GetUsages() []SponsorUsage {
+	if m != nil {
+		return m.Usages
+	}
+	return nil
+}
+
+func (m *QuerySponsorUsagesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryScheduledCallRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+}
+
+func (m *QueryScheduledCallRequest) Reset()         { *m = QueryScheduledCallRequest{} }
+func (m *QueryScheduledCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallRequest) ProtoMessage()    {}
+func (*QueryScheduledCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{40}
+}
+func (m *QueryScheduledCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallRequest.Merge(m, src)
+}
+func (m *QueryScheduledCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledCallRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledCallRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryScheduledCallResponse struct {
+	ScheduledCall ScheduledCall `protobuf:"bytes,1,opt,name=scheduled_call,json=scheduledCall,proto3" json:"scheduled_call" yaml:"scheduled_call"`
+}
+
+func (m *QueryScheduledCallResponse) Reset()         { *m = QueryScheduledCallResponse{} }
+func (m *QueryScheduledCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallResponse) ProtoMessage()    {}
+func (*QueryScheduledCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{41}
+}
+func (m *QueryScheduledCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallResponse.Merge(m, src)
+}
+func (m *QueryScheduledCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledCallResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledCallResponse) GetScheduledCall() ScheduledCall {
+	if m != nil {
+		return m.ScheduledCall
+	}
+	return ScheduledCall{}
+}
+
+type QueryScheduledCallsRequest struct {
+	// caller is the caller of the scheduled calls. All the scheduled calls are queried if empty.
+	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty" yaml:"caller"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledCallsRequest) Reset()         { *m = QueryScheduledCallsRequest{} }
+func (m *QueryScheduledCallsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallsRequest) ProtoMessage()    {}
+func (*QueryScheduledCallsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{42}
+}
+func (m *QueryScheduledCallsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledCallsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledCallsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallsRequest.Merge(m, src)
+}
+func (m *QueryScheduledCallsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledCallsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledCallsRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledCallsRequest) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *QueryScheduledCallsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryScheduledCallsResponse struct {
+	ScheduledCalls []ScheduledCall `protobuf:"bytes,1,rep,name=scheduled_calls,json=scheduledCalls,proto3" json:"scheduled_calls" yaml:"scheduled_calls"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledCallsResponse) Reset()         { *m = QueryScheduledCallsResponse{} }
+func (m *QueryScheduledCallsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallsResponse) ProtoMessage()    {}
+func (*QueryScheduledCallsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{43}
+}
+func (m *QueryScheduledCallsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledCallsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledCallsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallsResponse.Merge(m, src)
+}
+func (m *QueryScheduledCallsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledCallsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledCallsResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledCallsResponse) GetScheduledCalls() []ScheduledCall {
+	if m != nil {
+		return m.ScheduledCalls
+	}
+	return nil
+}
+
+func (m *QueryScheduledCallsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySweptFundsRequest struct {
+}
+
+func (m *QuerySweptFundsRequest) Reset()         { *m = QuerySweptFundsRequest{} }
+func (m *QuerySweptFundsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySweptFundsRequest) ProtoMessage()    {}
+func (*QuerySweptFundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{44}
+}
+func (m *QuerySweptFundsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySweptFundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySweptFundsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySweptFundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySweptFundsRequest.Merge(m, src)
+}
+func (m *QuerySweptFundsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySweptFundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySweptFundsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySweptFundsRequest proto.InternalMessageInfo
+
+type QuerySweptFundsResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+}
+
+func (m *QuerySweptFundsResponse) Reset()         { *m = QuerySweptFundsResponse{} }
+func (m *QuerySweptFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySweptFundsResponse) ProtoMessage()    {}
+func (*QuerySweptFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{45}
+}
+func (m *QuerySweptFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySweptFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySweptFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySweptFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySweptFundsResponse.Merge(m, src)
+}
+func (m *QuerySweptFundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySweptFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySweptFundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySweptFundsResponse proto.InternalMessageInfo
+
+func (m *QuerySweptFundsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type QueryDebugTraceCallRequest struct {
+	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty" yaml:"caller"`
+	// callee is empty for contract deployments.
+	Callee         string `protobuf:"bytes,2,opt,name=callee,proto3" json:"callee,omitempty" yaml:"callee"`
+	Value          uint64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty" yaml:"value"`
+	Data           []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty" yaml:"data"`
+	DisableStack   bool   `protobuf:"varint,5,opt,name=disable_stack,json=disableStack,proto3" json:"disable_stack,omitempty" yaml:"disable_stack"`
+	DisableMemory  bool   `protobuf:"varint,6,opt,name=disable_memory,json=disableMemory,proto3" json:"disable_memory,omitempty" yaml:"disable_memory"`
+	DisableStorage bool   `protobuf:"varint,7,opt,name=disable_storage,json=disableStorage,proto3" json:"disable_storage,omitempty" yaml:"disable_storage"`
+}
+
+func (m *QueryDebugTraceCallRequest) Reset()         { *m = QueryDebugTraceCallRequest{} }
+func (m *QueryDebugTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDebugTraceCallRequest) ProtoMessage()    {}
+func (*QueryDebugTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{46}
+}
+func (m *QueryDebugTraceCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDebugTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDebugTraceCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDebugTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDebugTraceCallRequest.Merge(m, src)
+}
+func (m *QueryDebugTraceCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDebugTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDebugTraceCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDebugTraceCallRequest proto.InternalMessageInfo
+
+func (m *QueryDebugTraceCallRequest) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *QueryDebugTraceCallRequest) GetCallee() string {
+	if m != nil {
+		return m.Callee
+	}
+	return ""
+}
+
+func (m *QueryDebugTraceCallRequest) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *QueryDebugTraceCallRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *QueryDebugTraceCallRequest) GetDisableStack() bool {
+	if m != nil {
+		return m.DisableStack
+	}
+	return false
+}
+
+func (m *QueryDebugTraceCallRequest) GetDisableMemory() bool {
+	if m != nil {
+		return m.DisableMemory
+	}
+	return false
+}
+
+func (m *QueryDebugTraceCallRequest) GetDisableStorage() bool {
+	if m != nil {
+		return m.DisableStorage
+	}
+	return false
+}
+
+type QueryDebugTraceCallResponse struct {
+	// trace is the JSON encoded trace in the struct log format of Ethereum debug traces.
+	Trace string `protobuf:"bytes,1,opt,name=trace,proto3" json:"trace,omitempty" yaml:"trace"`
+}
+
+func (m *QueryDebugTraceCallResponse) Reset()         { *m = QueryDebugTraceCallResponse{} }
+func (m *QueryDebugTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDebugTraceCallResponse) ProtoMessage()    {}
+func (*QueryDebugTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{47}
+}
+func (m *QueryDebugTraceCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDebugTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDebugTraceCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDebugTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDebugTraceCallResponse.Merge(m, src)
+}
+func (m *QueryDebugTraceCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDebugTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDebugTraceCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDebugTraceCallResponse proto.InternalMessageInfo
+
+func (m *QueryDebugTraceCallResponse) GetTrace() string {
+	if m != nil {
+		return m.Trace
+	}
+	return ""
+}
+
+type QueryDeployAddressRequest struct {
+	Deployer string `protobuf:"bytes,1,opt,name=deployer,proto3" json:"deployer,omitempty" yaml:"deployer"`
+	// salt is the hex encoded 32-byte salt.
+	Salt string `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty" yaml:"salt"`
+	// code_hash is the hex encoded Keccak-256 hash of the deployed code, including the constructor arguments.
+	CodeHash string `protobuf:"bytes,3,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty" yaml:"code_hash"`
+}
+
+func (m *QueryDeployAddressRequest) Reset()         { *m = QueryDeployAddressRequest{} }
+func (m *QueryDeployAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeployAddressRequest) ProtoMessage()    {}
+func (*QueryDeployAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{48}
+}
+func (m *QueryDeployAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeployAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeployAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeployAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeployAddressRequest.Merge(m, src)
+}
+func (m *QueryDeployAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeployAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeployAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeployAddressRequest proto.InternalMessageInfo
+
+func (m *QueryDeployAddressRequest) GetDeployer() string {
+	if m != nil {
+		return m.Deployer
+	}
+	return ""
+}
+
+func (m *QueryDeployAddressRequest) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+func (m *QueryDeployAddressRequest) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+type QueryDeployAddressResponse struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// exists is true if an account with code or sent transactions already exists at the address, in which case the
+	// deployment fails.
+	Exists bool `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty" yaml:"exists"`
+}
+
+func (m *QueryDeployAddressResponse) Reset()         { *m = QueryDeployAddressResponse{} }
+func (m *QueryDeployAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeployAddressResponse) ProtoMessage()    {}
+func (*QueryDeployAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{49}
+}
+func (m *QueryDeployAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeployAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeployAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeployAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeployAddressResponse.Merge(m, src)
+}
+func (m *QueryDeployAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeployAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeployAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeployAddressResponse proto.InternalMessageInfo
+
+func (m *QueryDeployAddressResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryDeployAddressResponse) GetExists() bool {
+	if m != nil {
+		return m.Exists
+	}
+	return false
+}
+
+type ReturnVars struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty" yaml:"value"`
+}
+
+func (m *ReturnVars) Reset()         { *m = ReturnVars{} }
+func (m *ReturnVars) String() string { return proto.CompactTextString(m) }
+func (*ReturnVars) ProtoMessage()    {}
+func (*ReturnVars) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca105b05fbd41d30, []int{50}
+}
+func (m *ReturnVars) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReturnVars) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReturnVars.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReturnVars) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReturnVars.Merge(m, src)
+}
+func (m *ReturnVars) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReturnVars) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReturnVars.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReturnVars proto.InternalMessageInfo
+
+func (m *ReturnVars) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReturnVars) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryCodeRequest)(nil), "shentu.cvm.v1alpha1.QueryCodeRequest")
+	proto.RegisterType((*QueryCodeResponse)(nil), "shentu.cvm.v1alpha1.QueryCodeResponse")
+	proto.RegisterType((*QueryAbiRequest)(nil), "shentu.cvm.v1alpha1.QueryAbiRequest")
+	proto.RegisterType((*QueryAbiResponse)(nil), "shentu.cvm.v1alpha1.QueryAbiResponse")
+	proto.RegisterType((*QueryStorageRequest)(nil), "shentu.cvm.v1alpha1.QueryStorageRequest")
+	proto.RegisterType((*QueryStorageResponse)(nil), "shentu.cvm.v1alpha1.QueryStorageResponse")
+	proto.RegisterType((*QueryContractStorageRequest)(nil), "shentu.cvm.v1alpha1.QueryContractStorageRequest")
+	proto.RegisterType((*QueryContractStorageResponse)(nil), "shentu.cvm.v1alpha1.QueryContractStorageResponse")
+	proto.RegisterType((*QueryAddressMetaRequest)(nil), "shentu.cvm.v1alpha1.QueryAddressMetaRequest")
+	proto.RegisterType((*QueryAddressMetaResponse)(nil), "shentu.cvm.v1alpha1.QueryAddressMetaResponse")
+	proto.RegisterType((*QueryMetaRequest)(nil), "shentu.cvm.v1alpha1.QueryMetaRequest")
+	proto.RegisterType((*QueryMetaResponse)(nil), "shentu.cvm.v1alpha1.QueryMetaResponse")
+	proto.RegisterType((*QueryAccountRequest)(nil), "shentu.cvm.v1alpha1.QueryAccountRequest")
+	proto.RegisterType((*CVMAccount)(nil), "shentu.cvm.v1alpha1.CVMAccount")
+	proto.RegisterType((*QueryViewRequest)(nil), "shentu.cvm.v1alpha1.QueryViewRequest")
+	proto.RegisterType((*QueryViewResponse)(nil), "shentu.cvm.v1alpha1.QueryViewResponse")
+	proto.RegisterType((*QuerySimulateRequest)(nil), "shentu.cvm.v1alpha1.QuerySimulateRequest")
+	proto.RegisterType((*QuerySimulateResponse)(nil), "shentu.cvm.v1alpha1.QuerySimulateResponse")
+	proto.RegisterType((*QueryDecodeLogsRequest)(nil), "shentu.cvm.v1alpha1.QueryDecodeLogsRequest")
+	proto.RegisterType((*QueryDecodeLogsResponse)(nil), "shentu.cvm.v1alpha1.QueryDecodeLogsResponse")
+	proto.RegisterType((*DecodedLog)(nil), "shentu.cvm.v1alpha1.DecodedLog")
+	proto.RegisterType((*EventParam)(nil), "shentu.cvm.v1alpha1.EventParam")
+	proto.RegisterType((*QueryReceiptRequest)(nil), "shentu.cvm.v1alpha1.QueryReceiptRequest")
+	proto.RegisterType((*QueryReceiptResponse)(nil), "shentu.cvm.v1alpha1.QueryReceiptResponse")
+	proto.RegisterType((*QueryReceiptsRequest)(nil), "shentu.cvm.v1alpha1.QueryReceiptsRequest")
+	proto.RegisterType((*QueryReceiptsResponse)(nil), "shentu.cvm.v1alpha1.QueryReceiptsResponse")
+	proto.RegisterType((*QueryAdminRequest)(nil), "shentu.cvm.v1alpha1.QueryAdminRequest")
+	proto.RegisterType((*QueryAdminResponse)(nil), "shentu.cvm.v1alpha1.QueryAdminResponse")
+	proto.RegisterType((*QueryVerifiedContractRequest)(nil), "shentu.cvm.v1alpha1.QueryVerifiedContractRequest")
+	proto.RegisterType((*QueryVerifiedContractResponse)(nil), "shentu.cvm.v1alpha1.QueryVerifiedContractResponse")
+	proto.RegisterType((*QueryFrozenContractRequest)(nil), "shentu.cvm.v1alpha1.QueryFrozenContractRequest")
+	proto.RegisterType((*QueryFrozenContractResponse)(nil), "shentu.cvm.v1alpha1.QueryFrozenContractResponse")
+	proto.RegisterType((*QueryFrozenContractsRequest)(nil), "shentu.cvm.v1alpha1.QueryFrozenContractsRequest")
+	proto.RegisterType((*QueryFrozenContractsResponse)(nil), "shentu.cvm.v1alpha1.QueryFrozenContractsResponse")
+	proto.RegisterType((*QuerySponsorshipRequest)(nil), "shentu.cvm.v1alpha1.QuerySponsorshipRequest")
+	proto.RegisterType((*QuerySponsorshipResponse)(nil), "shentu.cvm.v1alpha1.QuerySponsorshipResponse")
+	proto.RegisterType((*QuerySponsorUsageRequest)(nil), "shentu.cvm.v1alpha1.QuerySponsorUsageRequest")
+	proto.RegisterType((*QuerySponsorUsageResponse)(nil), "shentu.cvm.v1alpha1.QuerySponsorUsageResponse")
+	proto.RegisterType((*QuerySponsorUsagesRequest)(nil), "shentu.cvm.v1alpha1.QuerySponsorUsagesRequest")
+	proto.RegisterType((*QuerySponsorUsagesResponse)(nil), "shentu.cvm.v1alpha1.QuerySponsorUsagesResponse")
+	proto.RegisterType((*QueryScheduledCallRequest)(nil), "shentu.cvm.v1alpha1.QueryScheduledCallRequest")
+	proto.RegisterType((*QueryScheduledCallResponse)(nil), "shentu.cvm.v1alpha1.QueryScheduledCallResponse")
+	proto.RegisterType((*QueryScheduledCallsRequest)(nil), "shentu.cvm.v1alpha1.QueryScheduledCallsRequest")
+	proto.RegisterType((*QueryScheduledCallsResponse)(nil), "shentu.cvm.v1alpha1.QueryScheduledCallsResponse")
+	proto.RegisterType((*QuerySweptFundsRequest)(nil), "shentu.cvm.v1alpha1.QuerySweptFundsRequest")
+	proto.RegisterType((*QuerySweptFundsResponse)(nil), "shentu.cvm.v1alpha1.QuerySweptFundsResponse")
+	proto.RegisterType((*QueryDebugTraceCallRequest)(nil), "shentu.cvm.v1alpha1.QueryDebugTraceCallRequest")
+	proto.RegisterType((*QueryDebugTraceCallResponse)(nil), "shentu.cvm.v1alpha1.QueryDebugTraceCallResponse")
+	proto.RegisterType((*QueryDeployAddressRequest)(nil), "shentu.cvm.v1alpha1.QueryDeployAddressRequest")
+	proto.RegisterType((*QueryDeployAddressResponse)(nil), "shentu.cvm.v1alpha1.QueryDeployAddressResponse")
+	proto.RegisterType((*ReturnVars)(nil), "shentu.cvm.v1alpha1.ReturnVars")
+}
+
+func init() { proto.RegisterFile("shentu/cvm/v1alpha1/query.proto", fileDescriptor_ca105b05fbd41d30) }
+
+var fileDescriptor_ca105b05fbd41d30 = []byte{
+	// 2946 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5d, 0x6c, 0x1c, 0x57,
+	0xf5, 0xcf, 0xd8, 0x8e, 0xed, 0x1c, 0x7f, 0xe6, 0xe6, 0x6b, 0xbb, 0x8d, 0xbd, 0xe9, 0x4d, 0xe3,
+	0xe6, 0xc3, 0xd9, 0x89, 0x93, 0xf4, 0x9f, 0x36, 0xff, 0xb6, 0xd4, 0xeb, 0x24, 0x0d, 0x25, 0xa9,
+	0xda, 0x1b, 0x1a, 0xf1, 0x21, 0xb1, 0xcc, 0xee, 0x5c, 0xaf, 0x47, 0xde, 0xdd, 0xd9, 0xce, 0x9d,
+	0x75, 0x12, 0x2c, 0x0b, 0x09, 0x89, 0x82, 0x28, 0x12, 0xa0, 0x16, 0x09, 0x09, 0x21, 0x40, 0x50,
+	0x24, 0x10, 0x6f, 0x14, 0x90, 0x78, 0x06, 0xa9, 0x8f, 0x95, 0x2a, 0xa1, 0x3e, 0x2d, 0xa8, 0xe5,
+	0x85, 0x57, 0xf3, 0xca, 0x03, 0xba, 0x77, 0xce, 0x9d, 0x9d, 0x99, 0x1d, 0xaf, 0xc7, 0x5b, 0xf3,
+	0xc0, 0x93, 0x77, 0xee, 0xf9, 0xfa, 0xdd, 0x73, 0xcf, 0x39, 0xf7, 0xde, 0x73, 0x0d, 0x05, 0xb1,
+	0xc6, 0x9b, 0x7e, 0xdb, 0xac, 0x6e, 0x34, 0xcc, 0x8d, 0x25, 0xab, 0xde, 0x5a, 0xb3, 0x96, 0xcc,
+	0x37, 0xda, 0xdc, 0x7b, 0x54, 0x6c, 0x79, 0xae, 0xef, 0x92, 0x23, 0x01, 0x43, 0xb1, 0xba, 0xd1,
+	0x28, 0x6a, 0x86, 0xfc, 0xd1, 0x9a, 0x5b, 0x73, 0x15, 0xdd, 0x94, 0xbf, 0x02, 0xd6, 0xfc, 0xf9,
+	0xaa, 0x2b, 0x1a, 0xae, 0x30, 0x2b, 0x96, 0xe0, 0x81, 0x0e, 0x73, 0x63, 0xa9, 0xc2, 0x7d, 0x6b,
+	0xc9, 0x6c, 0x59, 0x35, 0xa7, 0x69, 0xf9, 0x8e, 0xdb, 0x44, 0xde, 0xf9, 0x28, 0xaf, 0xe6, 0xaa,
+	0xba, 0x8e, 0xa6, 0x9f, 0xac, 0xb9, 0x6e, 0xad, 0xce, 0x4d, 0xab, 0xe5, 0x98, 0x56, 0xb3, 0xe9,
+	0xfa, 0x4a, 0x58, 0x20, 0x75, 0x2e, 0x0d, 0xb5, 0x44, 0x18, 0x90, 0x9f, 0x48, 0x23, 0xd7, 0x78,
+	0x93, 0x0b, 0x47, 0x24, 0xec, 0x5b, 0x6d, 0x7f, 0x2d, 0xb4, 0x2f, 0x3f, 0x90, 0x3e, 0x5b, 0x69,
+	0x7b, 0x9e, 0xfb, 0xc0, 0xb4, 0xaa, 0xa8, 0x94, 0xbe, 0x08, 0xb3, 0xaf, 0xc9, 0x39, 0xad, 0xb8,
+	0x36, 0x67, 0xfc, 0x8d, 0x36, 0x17, 0x3e, 0x59, 0x84, 0x31, 0xcb, 0xb6, 0x3d, 0x2e, 0x44, 0xce,
+	0x38, 0x65, 0x9c, 0x3d, 0x54, 0x22, 0xdb, 0x9d, 0xc2, 0xf4, 0x23, 0xab, 0x51, 0xbf, 0x4e, 0x91,
+	0x40, 0x99, 0x66, 0xa1, 0xcf, 0xc0, 0xe1, 0x88, 0x06, 0xd1, 0x72, 0x9b, 0x82, 0x93, 0xd3, 0x30,
+	0x52, 0x75, 0x6d, 0x8e, 0xf2, 0x33, 0xdb, 0x9d, 0xc2, 0x44, 0x20, 0x2f, 0x47, 0x29, 0x53, 0x44,
+	0xfa, 0x19, 0x98, 0x51, 0x92, 0xcb, 0x15, 0x67, 0x30, 0xd3, 0x57, 0x61, 0xb6, 0xab, 0x00, 0x2d,
+	0x9f, 0x82, 0x61, 0xab, 0xe2, 0xa0, 0xf4, 0xf4, 0x76, 0xa7, 0x00, 0x28, 0x5d, 0x71, 0x28, 0x93,
+	0x24, 0xca, 0xe1, 0x88, 0x92, 0xba, 0xe7, 0xbb, 0x9e, 0x55, 0x1b, 0x6c, 0xd6, 0xd2, 0xcc, 0x3a,
+	0x7f, 0x94, 0x1b, 0x4a, 0x9a, 0x59, 0xe7, 0x8f, 0x28, 0x93, 0x24, 0xfa, 0x02, 0x1c, 0x8d, 0x9b,
+	0x41, 0x80, 0x0b, 0x70, 0x70, 0xc3, 0xaa, 0xb7, 0x03, 0xdf, 0x4c, 0x96, 0x66, 0xb7, 0x3b, 0x85,
+	0xc9, 0x40, 0x56, 0x0d, 0x53, 0x16, 0x90, 0xe9, 0xdb, 0x06, 0x3c, 0x8e, 0x8e, 0x6d, 0xfa, 0x9e,
+	0x55, 0xf5, 0x3f, 0x15, 0xde, 0x5b, 0x00, 0xdd, 0x68, 0x55, 0xb0, 0x27, 0x2e, 0x2f, 0x14, 0x83,
+	0x70, 0x29, 0xca, 0x70, 0x2d, 0x06, 0xe9, 0x81, 0x41, 0x53, 0x7c, 0xb5, 0x6b, 0x89, 0x45, 0x24,
+	0xe9, 0x1f, 0x0d, 0x38, 0x99, 0x8e, 0x0a, 0xa7, 0xf7, 0x0a, 0x8c, 0x89, 0x60, 0x28, 0x67, 0x9c,
+	0x1a, 0x3e, 0x3b, 0x71, 0xf9, 0x64, 0x31, 0x25, 0xd7, 0x8a, 0x28, 0x56, 0x3a, 0xfe, 0x7e, 0xa7,
+	0x70, 0xa0, 0x0b, 0x1c, 0x45, 0x29, 0xd3, 0x4a, 0xc8, 0x4b, 0x29, 0xc0, 0x9f, 0xda, 0x15, 0x78,
+	0x00, 0x26, 0x86, 0xfc, 0x25, 0x38, 0x11, 0x04, 0x4b, 0xe0, 0x91, 0xbb, 0xdc, 0xb7, 0x06, 0x8b,
+	0xba, 0x3b, 0x90, 0xeb, 0x55, 0x84, 0xb3, 0xbf, 0x04, 0x87, 0x1a, 0xdc, 0xb7, 0xca, 0x6b, 0x96,
+	0x58, 0x43, 0x5d, 0x47, 0xb6, 0x3b, 0x85, 0x99, 0x40, 0x97, 0x24, 0xdd, 0xb6, 0xc4, 0x1a, 0x65,
+	0xe3, 0xe1, 0xcf, 0x6b, 0x18, 0xc3, 0x51, 0x3c, 0xa7, 0x61, 0x24, 0xa2, 0x20, 0x92, 0x3d, 0x6b,
+	0x4a, 0x58, 0x11, 0xc3, 0xbc, 0x8b, 0xd9, 0x3f, 0x0d, 0x23, 0x52, 0x73, 0xaf, 0xa4, 0x1c, 0xa5,
+	0x4c, 0x11, 0xe9, 0x0a, 0x26, 0xc0, 0x72, 0xb5, 0xea, 0xb6, 0x9b, 0xfe, 0x60, 0x5e, 0xf8, 0xbd,
+	0x01, 0xb0, 0x72, 0xff, 0x2e, 0xea, 0x20, 0x5f, 0x85, 0x49, 0xb9, 0x18, 0x65, 0x2b, 0xf8, 0x56,
+	0x1a, 0x26, 0x2e, 0x9f, 0xd2, 0x0b, 0xa5, 0x6a, 0x90, 0x5e, 0xa2, 0x92, 0x25, 0x38, 0xca, 0x95,
+	0x1e, 0xff, 0xa0, 0x53, 0x30, 0xb6, 0x3b, 0x85, 0x23, 0x81, 0x9d, 0xa8, 0x0e, 0xca, 0x26, 0x2a,
+	0x5d, 0xce, 0xb0, 0xa4, 0x0c, 0xf5, 0x29, 0x29, 0x3a, 0xfb, 0x87, 0x77, 0xce, 0xfe, 0x7f, 0x1b,
+	0xe8, 0xf0, 0xfb, 0x0e, 0x7f, 0xa0, 0xa7, 0x7e, 0x0e, 0x46, 0xab, 0x56, 0xbd, 0xce, 0x3d, 0x9c,
+	0xf9, 0xe1, 0xed, 0x4e, 0x61, 0x0a, 0xb5, 0xab, 0x71, 0xca, 0x90, 0x21, 0x64, 0xd5, 0x40, 0x92,
+	0xac, 0x5c, 0xb3, 0x72, 0x52, 0x84, 0x71, 0xab, 0xe2, 0x94, 0x45, 0x8b, 0x57, 0x15, 0xa2, 0xc9,
+	0x68, 0x2c, 0x68, 0x8a, 0x74, 0x69, 0xc5, 0xb9, 0xd7, 0xe2, 0x55, 0xf2, 0x3c, 0x4c, 0xad, 0xb6,
+	0x9b, 0x55, 0x19, 0xad, 0xe5, 0xa6, 0xd5, 0xe0, 0xb9, 0x11, 0x65, 0x21, 0xb7, 0xdd, 0x29, 0x1c,
+	0x0d, 0x84, 0x62, 0x64, 0xca, 0x26, 0xf5, 0xf7, 0x2b, 0x56, 0x43, 0xad, 0xbd, 0x6d, 0xf9, 0x56,
+	0xee, 0xa0, 0x32, 0x15, 0x71, 0x90, 0x1c, 0xa5, 0x4c, 0x11, 0xe9, 0xaf, 0x0c, 0x38, 0x1c, 0x99,
+	0x3e, 0x86, 0xcd, 0x17, 0x60, 0xc2, 0xe3, 0x7e, 0xdb, 0x6b, 0x96, 0x37, 0x2c, 0x4f, 0x60, 0xe2,
+	0x16, 0x52, 0x13, 0x97, 0x29, 0xbe, 0xfb, 0x96, 0x27, 0x4a, 0xc7, 0xb7, 0x3b, 0x05, 0x12, 0x98,
+	0x88, 0x48, 0x53, 0x06, 0x5e, 0xc8, 0x43, 0xae, 0x85, 0x9a, 0x15, 0xb6, 0x21, 0x85, 0xad, 0x57,
+	0x30, 0x80, 0x88, 0x82, 0x37, 0xe4, 0xc7, 0x4f, 0x87, 0x74, 0xfd, 0x74, 0x1a, 0xed, 0xba, 0xe5,
+	0xf3, 0xff, 0xee, 0x5a, 0x85, 0x55, 0x59, 0x2e, 0xd4, 0xc8, 0x8e, 0x55, 0x39, 0x74, 0xf2, 0x48,
+	0x1f, 0x27, 0xcb, 0x85, 0x77, 0x44, 0x99, 0x3f, 0xb0, 0x44, 0x43, 0xad, 0xc6, 0x78, 0x74, 0xe1,
+	0x35, 0x85, 0xb2, 0x31, 0x47, 0xdc, 0x94, 0xbf, 0xc8, 0x55, 0x00, 0x47, 0x94, 0xbd, 0x76, 0xd3,
+	0x77, 0x1a, 0x3c, 0x37, 0xaa, 0x24, 0x8e, 0x6d, 0x77, 0x0a, 0x87, 0x43, 0x09, 0xa4, 0x51, 0x76,
+	0xc8, 0x11, 0x0c, 0x7f, 0xff, 0x75, 0x08, 0x8e, 0x25, 0x3c, 0x84, 0xcb, 0x59, 0x84, 0xf1, 0x9a,
+	0x25, 0xca, 0x6d, 0xc1, 0x6d, 0xe5, 0xa4, 0x91, 0xa8, 0x7d, 0x4d, 0xa1, 0x6c, 0xac, 0x66, 0x89,
+	0xd7, 0x05, 0xb7, 0xc9, 0xb3, 0x30, 0x29, 0xec, 0xf5, 0x72, 0x28, 0x33, 0xa4, 0x64, 0x4e, 0x74,
+	0xd3, 0x32, 0x4a, 0xa5, 0x0c, 0x84, 0xbd, 0xfe, 0x12, 0x8a, 0x9e, 0x83, 0x51, 0x8f, 0xaf, 0xb6,
+	0x9b, 0x36, 0x3a, 0x2e, 0xe2, 0xe2, 0x60, 0x9c, 0x32, 0x64, 0x48, 0x86, 0xc2, 0x48, 0xd6, 0x50,
+	0x20, 0x26, 0x8c, 0x7b, 0x7c, 0x83, 0x7b, 0x3e, 0xb7, 0x7b, 0xdd, 0xa9, 0x29, 0x94, 0x85, 0x4c,
+	0x32, 0x91, 0x82, 0xdf, 0x65, 0x8f, 0x5b, 0xc2, 0x6d, 0xe6, 0x46, 0x93, 0x89, 0x14, 0x23, 0x53,
+	0x36, 0x19, 0x7c, 0xb3, 0xe0, 0xf3, 0xcb, 0x70, 0x5c, 0xf9, 0xf5, 0x06, 0x97, 0x35, 0xe5, 0x8e,
+	0x5b, 0x13, 0x3a, 0xf6, 0x96, 0x61, 0xa4, 0xee, 0xd6, 0x74, 0x82, 0xe4, 0x52, 0x13, 0xe4, 0x8e,
+	0x5b, 0x2b, 0x1d, 0xc1, 0x5d, 0x0d, 0x63, 0x43, 0xca, 0x50, 0xa6, 0x44, 0x69, 0x15, 0x4e, 0xf4,
+	0x28, 0xc7, 0x65, 0xbb, 0x1d, 0xd3, 0x9e, 0x9e, 0x7e, 0x81, 0x98, 0xbd, 0x8b, 0x91, 0x3f, 0x1b,
+	0x00, 0x5d, 0x4e, 0xf2, 0x02, 0x0c, 0xd7, 0xdd, 0x1a, 0xd6, 0xe4, 0x9d, 0x51, 0x13, 0x54, 0x08,
+	0xa1, 0x42, 0xca, 0xa4, 0xa0, 0x4c, 0x0e, 0xbe, 0xc1, 0x9b, 0x3e, 0xa6, 0x51, 0x24, 0x39, 0xd4,
+	0x30, 0x65, 0x01, 0x99, 0xbc, 0x02, 0xa3, 0x2d, 0xcb, 0xb3, 0x1a, 0x22, 0x37, 0xdc, 0x67, 0x0a,
+	0x37, 0x25, 0xef, 0xab, 0x92, 0xaf, 0x74, 0x0c, 0x2d, 0x62, 0xc4, 0x04, 0xc2, 0x94, 0xa1, 0x16,
+	0xfa, 0x2d, 0x03, 0xa0, 0xcb, 0x2d, 0x73, 0x4f, 0x95, 0xc5, 0x9e, 0xcd, 0x2d, 0xa8, 0x86, 0x8a,
+	0xd8, 0x4d, 0xe4, 0x1e, 0xac, 0xf1, 0x44, 0x5e, 0x84, 0x31, 0xa7, 0x69, 0xf3, 0x87, 0x3c, 0x88,
+	0xdc, 0xf1, 0xe8, 0x6e, 0x87, 0x04, 0x99, 0xa1, 0xf8, 0xab, 0x8d, 0x5b, 0x26, 0xe3, 0x55, 0xee,
+	0xb4, 0xc2, 0x2d, 0xf3, 0x02, 0x8c, 0xf9, 0x0f, 0xa3, 0x9b, 0x7d, 0x44, 0x09, 0x12, 0x28, 0x1b,
+	0xf5, 0x1f, 0xca, 0x9d, 0x9e, 0x2c, 0xc1, 0xa1, 0x86, 0xa8, 0x95, 0x95, 0x4a, 0x85, 0x6e, 0xaa,
+	0x74, 0x74, 0xbb, 0x53, 0x98, 0x0d, 0xd8, 0x43, 0x92, 0x3c, 0x1c, 0x88, 0xda, 0x67, 0xd5, 0xcf,
+	0x55, 0x38, 0x1a, 0x37, 0xdb, 0x3d, 0x64, 0x79, 0xc1, 0x10, 0x2e, 0xea, 0xc9, 0x1d, 0x6a, 0xb5,
+	0xe2, 0x49, 0x1e, 0xb2, 0x50, 0x94, 0x32, 0xad, 0x84, 0xfe, 0x6c, 0x28, 0x6e, 0x48, 0x0c, 0x76,
+	0xc8, 0x5c, 0x80, 0x83, 0xbe, 0xdb, 0x72, 0xaa, 0xbd, 0xbe, 0x57, 0xc3, 0x94, 0x05, 0x64, 0x59,
+	0x09, 0x56, 0x3d, 0xb7, 0x51, 0x5e, 0xe3, 0x4e, 0x6d, 0xcd, 0x57, 0xfe, 0x1f, 0x8e, 0x56, 0x82,
+	0x08, 0x91, 0x32, 0x90, 0x5f, 0xb7, 0xd5, 0x87, 0x74, 0xa1, 0xef, 0x6a, 0xb1, 0x11, 0x25, 0x16,
+	0x71, 0x61, 0x48, 0xa2, 0x6c, 0xdc, 0x77, 0x51, 0x24, 0x7e, 0xf0, 0x3d, 0x38, 0xf0, 0xc1, 0xf7,
+	0x77, 0x06, 0x1c, 0x4b, 0xb8, 0x08, 0x17, 0xe3, 0x35, 0x59, 0x9e, 0x82, 0xb1, 0xbe, 0x47, 0x5e,
+	0xbd, 0x1a, 0x27, 0x70, 0x35, 0x66, 0x62, 0xab, 0x21, 0x54, 0x01, 0x0b, 0x7e, 0xee, 0xdf, 0xa1,
+	0x77, 0x19, 0x77, 0xfb, 0x65, 0xbb, 0xe1, 0x34, 0x07, 0x3b, 0xe8, 0x3d, 0x07, 0x24, 0xaa, 0xa2,
+	0x7b, 0x8b, 0xb1, 0xe4, 0x40, 0xce, 0x48, 0x2e, 0xb5, 0x1a, 0xa6, 0x2c, 0x20, 0xd3, 0x3b, 0x78,
+	0x5d, 0xb8, 0xcf, 0x3d, 0x67, 0xd5, 0xe1, 0xb6, 0xbe, 0x36, 0x0c, 0x86, 0xe5, 0x87, 0x06, 0xcc,
+	0xed, 0xa0, 0x0e, 0x71, 0xf9, 0x70, 0x78, 0x03, 0x69, 0xe5, 0x2a, 0x12, 0x31, 0x47, 0xce, 0xa4,
+	0xae, 0x4a, 0x52, 0x53, 0xe9, 0x14, 0x2e, 0x4f, 0x0e, 0xab, 0x46, 0x52, 0x1b, 0x65, 0xb3, 0x1b,
+	0x09, 0x19, 0xfa, 0x32, 0xe4, 0x15, 0xac, 0x5b, 0x9e, 0xfb, 0x35, 0xde, 0xfc, 0x74, 0x73, 0x7c,
+	0x4b, 0xdf, 0xfb, 0x92, 0xca, 0x70, 0x86, 0x75, 0x98, 0x59, 0x55, 0x94, 0xe4, 0xfc, 0x4e, 0xa7,
+	0xce, 0x2f, 0xae, 0xa5, 0x34, 0x8f, 0xb3, 0x3b, 0x1e, 0x66, 0x5a, 0x54, 0x13, 0x65, 0xd3, 0xab,
+	0x31, 0x7e, 0xca, 0x53, 0xc1, 0x84, 0xf5, 0x21, 0x9e, 0x5d, 0xc6, 0xc0, 0xd9, 0xf5, 0x91, 0xbe,
+	0x56, 0xf6, 0xd8, 0xc1, 0x59, 0xbb, 0x30, 0x9b, 0xc0, 0xaa, 0x93, 0x2d, 0xd3, 0xb4, 0x0b, 0x38,
+	0xed, 0x13, 0xa9, 0xd3, 0x16, 0x94, 0xcd, 0xc4, 0xe7, 0xbd, 0x8f, 0x29, 0xf8, 0x32, 0x6e, 0xf8,
+	0xf7, 0x24, 0xc9, 0xf5, 0xc4, 0x9a, 0xd3, 0xd2, 0xde, 0x33, 0x61, 0x3c, 0xb6, 0x86, 0xb1, 0xcb,
+	0x62, 0x77, 0x4d, 0x42, 0x26, 0xfa, 0x2f, 0x03, 0x72, 0xbd, 0xca, 0xd0, 0x45, 0x5f, 0x81, 0x09,
+	0xd1, 0x1d, 0x0e, 0x6f, 0x60, 0xa9, 0xb7, 0xef, 0x2e, 0x5f, 0x29, 0x8f, 0xae, 0xc1, 0xda, 0x1b,
+	0x51, 0x41, 0x59, 0x54, 0x21, 0x79, 0x00, 0x63, 0x15, 0xab, 0x6e, 0x35, 0xab, 0x72, 0x6f, 0x95,
+	0x9e, 0x7f, 0x2c, 0xe6, 0x0e, 0xed, 0x88, 0x15, 0xd7, 0x69, 0x96, 0x4a, 0xf1, 0x1d, 0x07, 0xe5,
+	0xe8, 0x6f, 0xfe, 0x56, 0x38, 0x5b, 0x73, 0xfc, 0xb5, 0x76, 0xa5, 0x58, 0x75, 0x1b, 0x66, 0x20,
+	0x8e, 0x7f, 0x2e, 0x0a, 0x7b, 0xdd, 0xf4, 0x1f, 0xb5, 0xb8, 0x50, 0x2a, 0x04, 0xd3, 0xd6, 0x68,
+	0x2b, 0x3e, 0xe9, 0xd7, 0x45, 0xa4, 0x0b, 0xb2, 0x57, 0x17, 0xca, 0x43, 0x44, 0x5b, 0x70, 0xaf,
+	0xf7, 0x1a, 0x29, 0x47, 0x29, 0x53, 0x44, 0xfa, 0x4f, 0x03, 0x1e, 0x4b, 0x31, 0x89, 0x8e, 0xbe,
+	0x0b, 0x07, 0xdb, 0x22, 0x68, 0x70, 0x48, 0x17, 0x3f, 0xd1, 0xcf, 0xc5, 0x4a, 0xb2, 0x74, 0x14,
+	0xdd, 0x31, 0xa9, 0x4d, 0xa9, 0x1e, 0x47, 0xa0, 0x85, 0x6c, 0xc1, 0x21, 0x8f, 0x37, 0x2c, 0xa7,
+	0xe9, 0x34, 0x6b, 0xbb, 0x7b, 0xf6, 0x06, 0xaa, 0x9a, 0xd5, 0xbb, 0x07, 0x4a, 0xee, 0xcd, 0xb7,
+	0x5d, 0x8b, 0xf4, 0x9d, 0xb4, 0xb9, 0x8a, 0x81, 0xfd, 0xbb, 0x8f, 0x8d, 0xa6, 0x7c, 0x1a, 0x2c,
+	0x5c, 0x83, 0x57, 0x61, 0x54, 0x79, 0x4f, 0x57, 0x81, 0x0c, 0x8b, 0x90, 0x38, 0x6c, 0x06, 0xe2,
+	0x94, 0xa1, 0x9e, 0xfd, 0x4b, 0xf8, 0xeb, 0xda, 0x9f, 0xd5, 0x35, 0x6e, 0xb7, 0xeb, 0xdc, 0x5e,
+	0xb1, 0xea, 0x75, 0xed, 0xcf, 0x39, 0x18, 0x72, 0xf4, 0xa5, 0x6c, 0x6a, 0xbb, 0x53, 0x38, 0x84,
+	0x27, 0x4e, 0x9b, 0xb2, 0x21, 0xc7, 0xa6, 0x6f, 0x86, 0xb3, 0x8e, 0x0b, 0xe3, 0xac, 0xd7, 0x60,
+	0x5a, 0x68, 0x42, 0x59, 0xde, 0x5c, 0x31, 0x04, 0x69, 0xfa, 0xec, 0xa3, 0x3a, 0x4a, 0x73, 0x38,
+	0xfd, 0x63, 0x98, 0xe7, 0x31, 0x3d, 0x94, 0x4d, 0x89, 0x28, 0x37, 0xfd, 0x5e, 0x2a, 0x10, 0x31,
+	0xc0, 0x25, 0x7c, 0xbf, 0x02, 0xe2, 0x43, 0xbd, 0x2f, 0x26, 0x11, 0xa1, 0x6f, 0xd6, 0x61, 0x26,
+	0x3e, 0x27, 0x1d, 0x1a, 0x59, 0x9c, 0x93, 0xd8, 0x16, 0x13, 0x8a, 0x28, 0x9b, 0x8e, 0x79, 0x67,
+	0x1f, 0x83, 0x25, 0x87, 0x77, 0xcd, 0x7b, 0x0f, 0x78, 0xcb, 0xbf, 0xd5, 0x6e, 0xda, 0xda, 0xc5,
+	0x72, 0x05, 0x4e, 0xf4, 0x90, 0xc2, 0x53, 0xce, 0xa8, 0xd5, 0xc0, 0x3e, 0xdb, 0x2e, 0xf5, 0x62,
+	0x39, 0x1e, 0xf5, 0x81, 0xd8, 0xde, 0x8a, 0x05, 0xda, 0xa2, 0xdf, 0x1c, 0xc6, 0x98, 0xb8, 0xc1,
+	0x2b, 0xed, 0xda, 0xe7, 0x3d, 0xab, 0xca, 0xa3, 0xa1, 0xfd, 0x3f, 0xd0, 0x98, 0x79, 0x1e, 0xa6,
+	0x6c, 0x47, 0x58, 0x95, 0x3a, 0x2f, 0x0b, 0xdf, 0xaa, 0xae, 0x63, 0x3b, 0x21, 0xd2, 0x18, 0x88,
+	0x91, 0x29, 0x9b, 0xc4, 0xef, 0x7b, 0xf2, 0x93, 0xbc, 0x08, 0xd3, 0x9a, 0xde, 0xe0, 0x0d, 0xd7,
+	0x7b, 0x84, 0xbd, 0x9a, 0xc7, 0xba, 0x69, 0x15, 0xa7, 0x53, 0xa6, 0xed, 0xdd, 0x55, 0xdf, 0x64,
+	0x05, 0x66, 0xba, 0x16, 0x82, 0x2e, 0xf9, 0x98, 0x52, 0x91, 0xef, 0x06, 0x5f, 0x82, 0x81, 0xb2,
+	0xe9, 0x10, 0x44, 0x30, 0x70, 0x13, 0x1e, 0x4f, 0x5d, 0x86, 0xee, 0xd1, 0x5c, 0x96, 0x62, 0xde,
+	0x7b, 0x34, 0x57, 0xc3, 0xf2, 0x16, 0xa6, 0xfe, 0xfe, 0x52, 0x17, 0xfe, 0x1b, 0xbc, 0x55, 0x77,
+	0x75, 0x3b, 0x3b, 0x52, 0xf8, 0x6d, 0x35, 0xce, 0xbd, 0xde, 0xc2, 0xaf, 0x29, 0x94, 0x85, 0x4c,
+	0x72, 0x01, 0x84, 0x55, 0xf7, 0x7b, 0x37, 0x56, 0x39, 0x4a, 0x99, 0x22, 0xca, 0x0b, 0x9c, 0xec,
+	0x4a, 0x04, 0x57, 0xe6, 0xa0, 0x4b, 0x1b, 0xb9, 0xc0, 0x85, 0x24, 0xb5, 0xa1, 0xd8, 0x5c, 0x35,
+	0xc8, 0xdb, 0x61, 0xd0, 0xc5, 0x50, 0xe2, 0x64, 0xf7, 0x76, 0x41, 0x3d, 0x07, 0xa3, 0xfc, 0xa1,
+	0x23, 0x7c, 0xa1, 0x50, 0x8e, 0x47, 0xe3, 0x2e, 0x18, 0xa7, 0x0c, 0x19, 0xe8, 0x17, 0x01, 0xba,
+	0xad, 0xce, 0x7d, 0x6d, 0x3d, 0x5c, 0xfe, 0xcb, 0x1c, 0x1c, 0x54, 0x53, 0x22, 0xdf, 0x35, 0x60,
+	0x44, 0xbe, 0x9b, 0x91, 0xf4, 0xbb, 0x49, 0xf2, 0x65, 0x2e, 0xbf, 0xb0, 0x1b, 0x5b, 0xe0, 0x15,
+	0xfa, 0xf4, 0x37, 0x3e, 0xfc, 0xc7, 0xdb, 0x43, 0x26, 0xb9, 0x68, 0xa6, 0x3e, 0x29, 0xea, 0x43,
+	0xae, 0xb9, 0x89, 0x8e, 0xd9, 0x32, 0x55, 0xf7, 0xfc, 0xdb, 0x06, 0x0c, 0x2f, 0x57, 0x1c, 0xf2,
+	0xe4, 0xce, 0x66, 0xba, 0x6f, 0x75, 0xf9, 0x33, 0xbb, 0x70, 0x21, 0x96, 0xab, 0x0a, 0x4b, 0x91,
+	0x2c, 0x66, 0xc6, 0x62, 0x55, 0x1c, 0xf2, 0x23, 0x03, 0xc6, 0x30, 0xde, 0xc9, 0xd9, 0x9d, 0x0d,
+	0xc5, 0xdf, 0xc4, 0xf2, 0xe7, 0x32, 0x70, 0x22, 0xac, 0x67, 0x14, 0xac, 0xcb, 0xe4, 0x52, 0x66,
+	0x58, 0xfa, 0x45, 0xea, 0x3d, 0x03, 0x66, 0x12, 0xaf, 0x5f, 0xe4, 0x52, 0xbf, 0x85, 0x49, 0x7b,
+	0xbe, 0xcb, 0x2f, 0xed, 0x41, 0x02, 0x21, 0x3f, 0xa7, 0x20, 0xff, 0x1f, 0xb9, 0xba, 0x57, 0xc8,
+	0xa6, 0x55, 0xaf, 0x93, 0x9f, 0x1b, 0x30, 0x11, 0x79, 0xb2, 0x22, 0x8b, 0x7d, 0x96, 0xaf, 0xe7,
+	0x89, 0x2c, 0x7f, 0x31, 0x23, 0xf7, 0xc0, 0x01, 0xd8, 0x90, 0x98, 0xbe, 0x0e, 0x23, 0x0a, 0x5b,
+	0x9f, 0xd0, 0x8a, 0x82, 0x5a, 0xd8, 0x8d, 0x0d, 0xd1, 0x9c, 0x55, 0x68, 0x28, 0x39, 0x95, 0x8a,
+	0x46, 0x5a, 0x36, 0x37, 0x65, 0xd9, 0xd9, 0x22, 0x6f, 0xc0, 0x98, 0x7e, 0x6f, 0xea, 0x13, 0x75,
+	0xf1, 0x87, 0xb3, 0xfc, 0x64, 0x51, 0xbe, 0xa7, 0xe3, 0x20, 0x2d, 0x2a, 0x63, 0x67, 0xc9, 0x42,
+	0xaa, 0x31, 0x7c, 0xdb, 0xea, 0x4e, 0x9c, 0x7c, 0xc7, 0x80, 0x11, 0xf9, 0x18, 0xd3, 0x6f, 0xd2,
+	0x91, 0xb7, 0xaa, 0xfc, 0xc2, 0x6e, 0x6c, 0x38, 0xe9, 0x2b, 0x0a, 0xc7, 0x45, 0x72, 0x21, 0x15,
+	0xc7, 0x86, 0xc3, 0x1f, 0x98, 0x9b, 0xc1, 0x76, 0xbc, 0x85, 0x3f, 0xf8, 0x16, 0x79, 0xcb, 0x80,
+	0x71, 0xfd, 0x9c, 0x40, 0xfa, 0x65, 0x53, 0xfc, 0x51, 0x26, 0x7f, 0x3e, 0x0b, 0x6b, 0x7c, 0x35,
+	0xe8, 0x5c, 0x2a, 0x30, 0x81, 0xec, 0xd7, 0x8d, 0xf3, 0xe4, 0x9d, 0xb0, 0x8d, 0x2d, 0xfb, 0xe4,
+	0xe4, 0xc2, 0xce, 0x46, 0x7a, 0x5a, 0xf5, 0xf9, 0xc5, 0x6c, 0xcc, 0x88, 0xe9, 0x82, 0xc2, 0x74,
+	0x86, 0xa6, 0x47, 0x88, 0xec, 0xa9, 0x9b, 0xb6, 0x92, 0x92, 0xb0, 0x7e, 0x6c, 0xc0, 0x18, 0x36,
+	0xf3, 0xfa, 0x45, 0x49, 0xbc, 0x57, 0x9c, 0x3f, 0x97, 0x81, 0x13, 0xd1, 0xfc, 0xbf, 0x42, 0xf3,
+	0x34, 0xb9, 0x92, 0x8a, 0x46, 0x77, 0x09, 0xcd, 0x4d, 0x6c, 0x31, 0x6f, 0x99, 0x9b, 0x61, 0xf7,
+	0x78, 0x4b, 0x16, 0xf1, 0x71, 0x54, 0x28, 0xc8, 0xee, 0x46, 0x45, 0x86, 0x25, 0x4c, 0xb6, 0x3c,
+	0xe9, 0x19, 0x05, 0xb0, 0x40, 0xe6, 0xfa, 0x02, 0x24, 0x6f, 0x1a, 0x70, 0x50, 0xb5, 0x0d, 0xc9,
+	0x42, 0xbf, 0xf2, 0xd1, 0x6d, 0x4d, 0xe6, 0x9f, 0xda, 0x95, 0x0f, 0x11, 0x2c, 0x2a, 0x04, 0x0b,
+	0xe4, 0xc9, 0xf4, 0x2c, 0x93, 0xbc, 0x91, 0x1c, 0x7b, 0xcf, 0x80, 0xd9, 0x64, 0xa3, 0x8f, 0xf4,
+	0xa9, 0xc0, 0x3b, 0x74, 0x2b, 0xf3, 0x97, 0xf7, 0x22, 0x82, 0x48, 0x9f, 0x55, 0x48, 0xaf, 0x90,
+	0xa5, 0xcc, 0xa5, 0x50, 0xb7, 0x17, 0xc9, 0x6f, 0x0d, 0x98, 0x8e, 0x37, 0xb2, 0x88, 0xb9, 0x33,
+	0x82, 0xd4, 0xe6, 0x63, 0xfe, 0x52, 0x76, 0x01, 0x04, 0x7c, 0x4d, 0x01, 0x5e, 0x22, 0x66, 0x66,
+	0xc0, 0x41, 0xef, 0x8c, 0xbc, 0x6b, 0xc0, 0xcc, 0xad, 0x44, 0x1b, 0x2d, 0xb3, 0x79, 0x91, 0x61,
+	0x63, 0xdc, 0xa1, 0x39, 0x48, 0x2f, 0x2a, 0xc4, 0x4f, 0x91, 0x33, 0xa9, 0x88, 0x93, 0xcd, 0x3e,
+	0xf2, 0x6b, 0x03, 0x26, 0x22, 0x1d, 0xb0, 0x7e, 0x3b, 0x61, 0x6f, 0xd3, 0x2e, 0x7f, 0x31, 0x23,
+	0x37, 0x62, 0x7b, 0x5e, 0x61, 0xbb, 0x46, 0x9e, 0xde, 0xcd, 0x9b, 0xfa, 0xe7, 0x96, 0x19, 0x6d,
+	0xba, 0xfd, 0xc9, 0x80, 0xc9, 0x68, 0x17, 0x83, 0xec, 0x6e, 0x3e, 0xda, 0x1f, 0xcb, 0x17, 0xb3,
+	0xb2, 0x23, 0xdc, 0xcf, 0x29, 0xb8, 0x37, 0xc9, 0xca, 0x40, 0x70, 0xcd, 0xa0, 0x97, 0x62, 0x6e,
+	0xb6, 0x05, 0xf7, 0xb6, 0xc8, 0x1f, 0x0c, 0x98, 0x8a, 0x5a, 0x11, 0x24, 0x23, 0x9c, 0x30, 0x18,
+	0xcc, 0xcc, 0xfc, 0x88, 0xff, 0x86, 0xc2, 0xff, 0x02, 0x79, 0xee, 0xd3, 0xe0, 0x27, 0xbf, 0x90,
+	0xc0, 0xa3, 0x37, 0xfe, 0xbe, 0xc0, 0x53, 0xfa, 0x3c, 0x79, 0x33, 0x33, 0x3f, 0x02, 0x5f, 0x52,
+	0xc0, 0x2f, 0x90, 0x73, 0xe9, 0xbb, 0x62, 0xbc, 0x21, 0x61, 0x6e, 0x3a, 0xf6, 0x96, 0x3c, 0xd1,
+	0x4d, 0xdf, 0x8b, 0xf7, 0x25, 0xb2, 0x9a, 0x15, 0x19, 0xca, 0x43, 0x7a, 0x9f, 0x65, 0x97, 0xca,
+	0x9b, 0x00, 0x4a, 0x7e, 0x60, 0x00, 0x74, 0x1b, 0x18, 0xfd, 0xb6, 0xf0, 0x9e, 0x0e, 0x48, 0x7e,
+	0x31, 0x1b, 0x73, 0xa6, 0x43, 0x9e, 0x90, 0x02, 0xe5, 0x55, 0x05, 0xe2, 0x27, 0x06, 0x4c, 0xc5,
+	0x6e, 0x93, 0xfd, 0x56, 0x37, 0xed, 0x72, 0x9c, 0x37, 0x33, 0xf3, 0xc7, 0xcf, 0x17, 0xe4, 0x74,
+	0x2a, 0xb8, 0xe0, 0x0e, 0x5d, 0xd6, 0xb7, 0xd4, 0x77, 0x0d, 0x98, 0x8e, 0xdf, 0xed, 0x49, 0x5f,
+	0x83, 0x29, 0xcd, 0x98, 0xfc, 0xa5, 0xec, 0x02, 0x08, 0xf1, 0x92, 0x82, 0x78, 0x9e, 0x9e, 0xd9,
+	0x01, 0x62, 0xa5, 0x5d, 0x33, 0x55, 0xe3, 0x40, 0xad, 0xec, 0x75, 0xe3, 0x7c, 0xe9, 0xf6, 0xfb,
+	0x1f, 0xcf, 0x1b, 0x1f, 0x7c, 0x3c, 0x6f, 0xfc, 0xfd, 0xe3, 0x79, 0xe3, 0xfb, 0x9f, 0xcc, 0x1f,
+	0xf8, 0xe0, 0x93, 0xf9, 0x03, 0x1f, 0x7d, 0x32, 0x7f, 0xe0, 0x4b, 0xc5, 0x68, 0x6f, 0x89, 0x7b,
+	0xbe, 0xb3, 0xbe, 0xea, 0xb6, 0x9b, 0xb6, 0x6a, 0x79, 0x69, 0xf5, 0x0f, 0x95, 0x01, 0xd5, 0x67,
+	0xaa, 0x8c, 0xaa, 0x7f, 0x46, 0xbd, 0xf2, 0x9f, 0x01, 0x00, 0x4e, 0xe9, 0xeb, 0x52, 0xb8, 0x2b,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+	Abi(ctx context.Context, in *QueryAbiRequest, opts ...grpc.CallOption) (*QueryAbiResponse, error)
+	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
+	// ContractStorage returns the storage of a contract in key order. The storage at a past height is
+	// queried at that height, e.g. with the x-cosmos-block-height header or the --height flag of the CLI.
+	ContractStorage(ctx context.Context, in *QueryContractStorageRequest, opts ...grpc.CallOption) (*QueryContractStorageResponse, error)
+	AddressMeta(ctx context.Context, in *QueryAddressMetaRequest, opts ...grpc.CallOption) (*QueryAddressMetaResponse, error)
+	Meta(ctx context.Context, in *QueryMetaRequest, opts ...grpc.CallOption) (*QueryMetaResponse, error)
+	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*acm.Account, error)
+	View(ctx context.Context, in *QueryViewRequest, opts ...grpc.CallOption) (*QueryViewResponse, error)
+	Simulate(ctx context.Context, in *QuerySimulateRequest, opts ...grpc.CallOption) (*QuerySimulateResponse, error)
+	DecodeLogs(ctx context.Context, in *QueryDecodeLogsRequest, opts ...grpc.CallOption) (*QueryDecodeLogsResponse, error)
+	Receipt(ctx context.Context, in *QueryReceiptRequest, opts ...grpc.CallOption) (*QueryReceiptResponse, error)
+	Receipts(ctx context.Context, in *QueryReceiptsRequest, opts ...grpc.CallOption) (*QueryReceiptsResponse, error)
+	Admin(ctx context.Context, in *QueryAdminRequest, opts ...grpc.CallOption) (*QueryAdminResponse, error)
+	// VerifiedContract returns the verified source code of a contract.
+	VerifiedContract(ctx context.Context, in *QueryVerifiedContractRequest, opts ...grpc.CallOption) (*QueryVerifiedContractResponse, error)
+	// FrozenContract returns the freeze of a frozen contract.
+	FrozenContract(ctx context.Context, in *QueryFrozenContractRequest, opts ...grpc.CallOption) (*QueryFrozenContractResponse, error)
+	// FrozenContracts returns the freezes of all the frozen contracts.
+	FrozenContracts(ctx context.Context, in *QueryFrozenContractsRequest, opts ...grpc.CallOption) (*QueryFrozenContractsResponse, error)
+	// Sponsorship returns the sponsorship of the calls into a contract and the balance of its sponsor.
+	Sponsorship(ctx context.Context, in *QuerySponsorshipRequest, opts ...grpc.CallOption) (*QuerySponsorshipResponse, error)
+	// SponsorUsage returns the fees the sponsor of a contract paid for the calls of a user today.
+	SponsorUsage(ctx context.Context, in *QuerySponsorUsageRequest, opts ...grpc.CallOption) (*QuerySponsorUsageResponse, error)
+	// SponsorUsages returns the latest fees the sponsor of a contract paid for the calls of each user.
+	SponsorUsages(ctx context.Context, in *QuerySponsorUsagesRequest, opts ...grpc.CallOption) (*QuerySponsorUsagesResponse, error)
+	// SweptFunds returns the total amount of coins swept from the zero address to the community pool.
+	// ScheduledCall returns a scheduled call.
+	ScheduledCall(ctx context.Context, in *QueryScheduledCallRequest, opts ...grpc.CallOption) (*QueryScheduledCallResponse, error)
+	// ScheduledCalls returns the scheduled calls, optionally of a caller.
+	ScheduledCalls(ctx context.Context, in *QueryScheduledCallsRequest, opts ...grpc.CallOption) (*QueryScheduledCallsResponse, error)
+	SweptFunds(ctx context.Context, in *QuerySweptFundsRequest, opts ...grpc.CallOption) (*QuerySweptFundsResponse, error)
+	// DeployAddress precomputes the address of a contract deployed with a salt.
+	DeployAddress(ctx context.Context, in *QueryDeployAddressRequest, opts ...grpc.CallOption) (*QueryDeployAddressResponse, error)
+	// DebugTraceCall replays a call on the state of the queried height and returns its opcode-level trace.
+	DebugTraceCall(ctx context.Context, in *QueryDebugTraceCallRequest, opts ...grpc.CallOption) (*QueryDebugTraceCallResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
+	out := new(QueryCodeResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Code", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Abi(ctx context.Context, in *QueryAbiRequest, opts ...grpc.CallOption) (*QueryAbiResponse, error) {
+	out := new(QueryAbiResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Abi", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error) {
+	out := new(QueryStorageResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Storage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractStorage(ctx context.Context, in *QueryContractStorageRequest, opts ...grpc.CallOption) (*QueryContractStorageResponse, error) {
+	out := new(QueryContractStorageResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/ContractStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressMeta(ctx context.Context, in *QueryAddressMetaRequest, opts ...grpc.CallOption) (*QueryAddressMetaResponse, error) {
+	out := new(QueryAddressMetaResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/AddressMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Meta(ctx context.Context, in *QueryMetaRequest, opts ...grpc.CallOption) (*QueryMetaResponse, error) {
+	out := new(QueryMetaResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Meta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*acm.Account, error) {
+	out := new(acm.Account)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Account", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) View(ctx context.Context, in *QueryViewRequest, opts ...grpc.CallOption) (*QueryViewResponse, error) {
+	out := new(QueryViewResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/View", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Simulate(ctx context.Context, in *QuerySimulateRequest, opts ...grpc.CallOption) (*QuerySimulateResponse, error) {
+	out := new(QuerySimulateResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Simulate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DecodeLogs(ctx context.Context, in *QueryDecodeLogsRequest, opts ...grpc.CallOption) (*QueryDecodeLogsResponse, error) {
+	out := new(QueryDecodeLogsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/DecodeLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Receipt(ctx context.Context, in *QueryReceiptRequest, opts ...grpc.CallOption) (*QueryReceiptResponse, error) {
+	out := new(QueryReceiptResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Receipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Receipts(ctx context.Context, in *QueryReceiptsRequest, opts ...grpc.CallOption) (*QueryReceiptsResponse, error) {
+	out := new(QueryReceiptsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Receipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Admin(ctx context.Context, in *QueryAdminRequest, opts ...grpc.CallOption) (*QueryAdminResponse, error) {
+	out := new(QueryAdminResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Admin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifiedContract(ctx context.Context, in *QueryVerifiedContractRequest, opts ...grpc.CallOption) (*QueryVerifiedContractResponse, error) {
+	out := new(QueryVerifiedContractResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/VerifiedContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenContract(ctx context.Context, in *QueryFrozenContractRequest, opts ...grpc.CallOption) (*QueryFrozenContractResponse, error) {
+	out := new(QueryFrozenContractResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/FrozenContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenContracts(ctx context.Context, in *QueryFrozenContractsRequest, opts ...grpc.CallOption) (*QueryFrozenContractsResponse, error) {
+	out := new(QueryFrozenContractsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/FrozenContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sponsorship(ctx context.Context, in *QuerySponsorshipRequest, opts ...grpc.CallOption) (*QuerySponsorshipResponse, error) {
+	out := new(QuerySponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/Sponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsorUsage(ctx context.Context, in *QuerySponsorUsageRequest, opts ...grpc.CallOption) (*QuerySponsorUsageResponse, error) {
+	out := new(QuerySponsorUsageResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/SponsorUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsorUsages(ctx context.Context, in *QuerySponsorUsagesRequest, opts ...grpc.CallOption) (*QuerySponsorUsagesResponse, error) {
+	out := new(QuerySponsorUsagesResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/SponsorUsages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledCall(ctx context.Context, in *QueryScheduledCallRequest, opts ...grpc.CallOption) (*QueryScheduledCallResponse, error) {
+	out := new(QueryScheduledCallResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/ScheduledCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledCalls(ctx context.Context, in *QueryScheduledCallsRequest, opts ...grpc.CallOption) (*QueryScheduledCallsResponse, error) {
+	out := new(QueryScheduledCallsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/ScheduledCalls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SweptFunds(ctx context.Context, in *QuerySweptFundsRequest, opts ...grpc.CallOption) (*QuerySweptFundsResponse, error) {
+	out := new(QuerySweptFundsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/SweptFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeployAddress(ctx context.Context, in *QueryDeployAddressRequest, opts ...grpc.CallOption) (*QueryDeployAddressResponse, error) {
+	out := new(QueryDeployAddressResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/DeployAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DebugTraceCall(ctx context.Context, in *QueryDebugTraceCallRequest, opts ...grpc.CallOption) (*QueryDebugTraceCallResponse, error) {
+	out := new(QueryDebugTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Query/DebugTraceCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
+	Abi(context.Context, *QueryAbiRequest) (*QueryAbiResponse, error)
+	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
+	// ContractStorage returns the storage of a contract in key order. The storage at a past height is
+	// queried at that height, e.g. with the x-cosmos-block-height header or the --height flag of the CLI.
+	ContractStorage(context.Context, *QueryContractStorageRequest) (*QueryContractStorageResponse, error)
+	AddressMeta(context.Context, *QueryAddressMetaRequest) (*QueryAddressMetaResponse, error)
+	Meta(context.Context, *QueryMetaRequest) (*QueryMetaResponse, error)
+	Account(context.Context, *QueryAccountRequest) (*acm.Account, error)
+	View(context.Context, *QueryViewRequest) (*QueryViewResponse, error)
+	Simulate(context.Context, *QuerySimulateRequest) (*QuerySimulateResponse, error)
+	DecodeLogs(context.Context, *QueryDecodeLogsRequest) (*QueryDecodeLogsResponse, error)
+	Receipt(context.Context, *QueryReceiptRequest) (*QueryReceiptResponse, error)
+	Receipts(context.Context, *QueryReceiptsRequest) (*QueryReceiptsResponse, error)
+	Admin(context.Context, *QueryAdminRequest) (*QueryAdminResponse, error)
+	// VerifiedContract returns the verified source code of a contract.
+	VerifiedContract(context.Context, *QueryVerifiedContractRequest) (*QueryVerifiedContractResponse, error)
+	// FrozenContract returns the freeze of a frozen contract.
+	FrozenContract(context.Context, *QueryFrozenContractRequest) (*QueryFrozenContractResponse, error)
+	// FrozenContracts returns the freezes of all the frozen contracts.
+	FrozenContracts(context.Context, *QueryFrozenContractsRequest) (*QueryFrozenContractsResponse, error)
+	// Sponsorship returns the sponsorship of the calls into a contract and the balance of its sponsor.
+	Sponsorship(context.Context, *QuerySponsorshipRequest) (*QuerySponsorshipResponse, error)
+	// SponsorUsage returns the fees the sponsor of a contract paid for the calls of a user today.
+	SponsorUsage(context.Context, *QuerySponsorUsageRequest) (*QuerySponsorUsageResponse, error)
+	// SponsorUsages returns the latest fees the sponsor of a contract paid for the calls of each user.
+	SponsorUsages(context.Context, *QuerySponsorUsagesRequest) (*QuerySponsorUsagesResponse, error)
+	// SweptFunds returns the total amount of coins swept from the zero address to the community pool.
+	// ScheduledCall returns a scheduled call.
+	ScheduledCall(context.Context, *QueryScheduledCallRequest) (*QueryScheduledCallResponse, error)
+	// ScheduledCalls returns the scheduled calls, optionally of a caller.
+	ScheduledCalls(context.Context, *QueryScheduledCallsRequest) (*QueryScheduledCallsResponse, error)
+	SweptFunds(context.Context, *QuerySweptFundsRequest) (*QuerySweptFundsResponse, error)
+	// DeployAddress precomputes the address of a contract deployed with a salt.
+	DeployAddress(context.Context, *QueryDeployAddressRequest) (*QueryDeployAddressResponse, error)
+	// DebugTraceCall replays a call on the state of the queried height and returns its opcode-level trace.
+	DebugTraceCall(context.Context, *QueryDebugTraceCallRequest) (*QueryDebugTraceCallResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
+}
+func (*UnimplementedQueryServer) Abi(ctx context.Context, req *QueryAbiRequest) (*QueryAbiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Abi not implemented")
+}
+func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageRequest) (*QueryStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
+}
+func (*UnimplementedQueryServer) ContractStorage(ctx context.Context, req *QueryContractStorageRequest) (*QueryContractStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractStorage not implemented")
+}
+func (*UnimplementedQueryServer) AddressMeta(ctx context.Context, req *QueryAddressMetaRequest) (*QueryAddressMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressMeta not implemented")
+}
+func (*UnimplementedQueryServer) Meta(ctx context.Context, req *QueryMetaRequest) (*QueryMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Meta not implemented")
+}
+func (*UnimplementedQueryServer) Account(ctx context.Context, req *QueryAccountRequest) (*acm.Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+}
+func (*UnimplementedQueryServer) View(ctx context.Context, req *QueryViewRequest) (*QueryViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method View not implemented")
+}
+func (*UnimplementedQueryServer) Simulate(ctx context.Context, req *QuerySimulateRequest) (*QuerySimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+}
+func (*UnimplementedQueryServer) DecodeLogs(ctx context.Context, req *QueryDecodeLogsRequest) (*QueryDecodeLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeLogs not implemented")
+}
+func (*UnimplementedQueryServer) Receipt(ctx context.Context, req *QueryReceiptRequest) (*QueryReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Receipt not implemented")
+}
+func (*UnimplementedQueryServer) Receipts(ctx context.Context, req *QueryReceiptsRequest) (*QueryReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Receipts not implemented")
+}
+func (*UnimplementedQueryServer) Admin(ctx context.Context, req *QueryAdminRequest) (*QueryAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Admin not implemented")
+}
+func (*UnimplementedQueryServer) VerifiedContract(ctx context.Context, req *QueryVerifiedContractRequest) (*QueryVerifiedContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifiedContract not implemented")
+}
+func (*UnimplementedQueryServer) FrozenContract(ctx context.Context, req *QueryFrozenContractRequest) (*QueryFrozenContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenContract not implemented")
+}
+func (*UnimplementedQueryServer) FrozenContracts(ctx context.Context, req *QueryFrozenContractsRequest) (*QueryFrozenContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenContracts not implemented")
+}
+func (*UnimplementedQueryServer) Sponsorship(ctx context.Context, req *QuerySponsorshipRequest) (*QuerySponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsorship not implemented")
+}
+func (*UnimplementedQueryServer) SponsorUsage(ctx context.Context, req *QuerySponsorUsageRequest) (*QuerySponsorUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorUsage not implemented")
+}
+func (*UnimplementedQueryServer) SponsorUsages(ctx context.Context, req *QuerySponsorUsagesRequest) (*QuerySponsorUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorUsages not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledCall(ctx context.Context, req *QueryScheduledCallRequest) (*QueryScheduledCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledCall not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledCalls(ctx context.Context, req *QueryScheduledCallsRequest) (*QueryScheduledCallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledCalls not implemented")
+}
+func (*UnimplementedQueryServer) SweptFunds(ctx context.Context, req *QuerySweptFundsRequest) (*QuerySweptFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweptFunds not implemented")
+}
+func (*UnimplementedQueryServer) DeployAddress(ctx context.Context, req *QueryDeployAddressRequest) (*QueryDeployAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployAddress not implemented")
+}
+func (*UnimplementedQueryServer) DebugTraceCall(ctx context.Context, req *QueryDebugTraceCallRequest) (*QueryDebugTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugTraceCall not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Code(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/Code",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Code(ctx, req.(*QueryCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Abi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAbiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Abi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/Abi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Abi(ctx, req.(*QueryAbiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Storage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/Storage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Storage(ctx, req.(*QueryStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/ContractStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractStorage(ctx, req.(*QueryContractStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/AddressMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressMeta(ctx, req.(*QueryAddressMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Meta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Meta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/Meta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Meta(ctx, req.(*QueryMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Account(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/Account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Account(ctx, req.(*QueryAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_View_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).View(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/View",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).View(ctx, req.(*QueryViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Simulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/Simulate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Simulate(ctx, req.(*QuerySimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DecodeLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDecodeLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DecodeLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/DecodeLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DecodeLogs(ctx, req.(*QueryDecodeLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Receipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Receipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/Receipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Receipt(ctx, req.(*QueryReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Receipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Receipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/Receipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Receipts(ctx, req.(*QueryReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Admin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Admin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/Admin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Admin(ctx, req.(*QueryAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifiedContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifiedContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifiedContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/VerifiedContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifiedContract(ctx, req.(*QueryVerifiedContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/FrozenContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenContract(ctx, req.(*QueryFrozenContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/FrozenContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenContracts(ctx, req.(*QueryFrozenContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/Sponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsorship(ctx, req.(*QuerySponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsorUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/SponsorUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorUsage(ctx, req.(*QuerySponsorUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsorUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/SponsorUsages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorUsages(ctx, req.(*QuerySponsorUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/ScheduledCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledCall(ctx, req.(*QueryScheduledCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/ScheduledCalls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledCalls(ctx, req.(*QueryScheduledCallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SweptFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySweptFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SweptFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/SweptFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SweptFunds(ctx, req.(*QuerySweptFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeployAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeployAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeployAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/DeployAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeployAddress(ctx, req.(*QueryDeployAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DebugTraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDebugTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DebugTraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Query/DebugTraceCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DebugTraceCall(ctx, req.(*QueryDebugTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "shentu.cvm.v1alpha1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Code",
+			Handler:    _Query_Code_Handler,
+		},
+		{
+			MethodName: "Abi",
+			Handler:    _Query_Abi_Handler,
+		},
+		{
+			MethodName: "Storage",
+			Handler:    _Query_Storage_Handler,
+		},
+		{
+			MethodName: "ContractStorage",
+			Handler:    _Query_ContractStorage_Handler,
+		},
+		{
+			MethodName: "AddressMeta",
+			Handler:    _Query_AddressMeta_Handler,
+		},
+		{
+			MethodName: "Meta",
+			Handler:    _Query_Meta_Handler,
+		},
+		{
+			MethodName: "Account",
+			Handler:    _Query_Account_Handler,
+		},
+		{
+			MethodName: "View",
+			Handler:    _Query_View_Handler,
+		},
+		{
+			MethodName: "Simulate",
+			Handler:    _Query_Simulate_Handler,
+		},
+		{
+			MethodName: "DecodeLogs",
+			Handler:    _Query_DecodeLogs_Handler,
+		},
+		{
+			MethodName: "Receipt",
+			Handler:    _Query_Receipt_Handler,
+		},
+		{
+			MethodName: "Receipts",
+			Handler:    _Query_Receipts_Handler,
+		},
+		{
+			MethodName: "Admin",
+			Handler:    _Query_Admin_Handler,
+		},
+		{
+			MethodName: "VerifiedContract",
+			Handler:    _Query_VerifiedContract_Handler,
+		},
+		{
+			MethodName: "FrozenContract",
+			Handler:    _Query_FrozenContract_Handler,
+		},
+		{
+			MethodName: "FrozenContracts",
+			Handler:    _Query_FrozenContracts_Handler,
+		},
+		{
+			MethodName: "Sponsorship",
+			Handler:    _Query_Sponsorship_Handler,
+		},
+		{
+			MethodName: "SponsorUsage",
+			Handler:    _Query_SponsorUsage_Handler,
+		},
+		{
+			MethodName: "SponsorUsages",
+			Handler:    _Query_SponsorUsages_Handler,
+		},
+		{
+			MethodName: "ScheduledCall",
+			Handler:    _Query_ScheduledCall_Handler,
+		},
+		{
+			MethodName: "ScheduledCalls",
+			Handler:    _Query_ScheduledCalls_Handler,
+		},
+		{
+			MethodName: "SweptFunds",
+			Handler:    _Query_SweptFunds_Handler,
+		},
+		{
+			MethodName: "DeployAddress",
+			Handler:    _Query_DeployAddress_Handler,
+		},
+		{
+			MethodName: "DebugTraceCall",
+			Handler:    _Query_DebugTraceCall_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shentu/cvm/v1alpha1/query.proto",
+}
+
+func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAbiRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAbiRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAbiRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAbiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAbiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAbiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressMetaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressMetaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressMetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetaHash) > 0 {
+		i -= len(m.MetaHash)
+		copy(dAtA[i:], m.MetaHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MetaHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMetaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMetaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Meta) > 0 {
+		i -= len(m.Meta)
+		copy(dAtA[i:], m.Meta)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Meta)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CVMAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CVMAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CVMAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryViewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryViewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryViewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AbiSpec) > 0 {
+		i -= len(m.AbiSpec)
+		copy(dAtA[i:], m.AbiSpec)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AbiSpec)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Callee) > 0 {
+		i -= len(m.Callee)
+		copy(dAtA[i:], m.Callee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Callee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryViewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryViewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryViewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReturnData) > 0 {
+		i -= len(m.ReturnData)
+		copy(dAtA[i:], m.ReturnData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReturnData)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReturnVars) > 0 {
+		for iNdEx := len(m.ReturnVars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReturnVars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsRuntime {
+		i--
+		if m.IsRuntime {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsEwasm {
+		i--
+		if m.IsEwasm {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Value != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Callee) > 0 {
+		i -= len(m.Callee)
+		copy(dAtA[i:], m.Callee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Callee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RevertReason) > 0 {
+		i -= len(m.RevertReason)
+		copy(dAtA[i:], m.RevertReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RevertReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Reverted {
+		i--
+		if m.Reverted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ReturnData) > 0 {
+		i -= len(m.ReturnData)
+		copy(dAtA[i:], m.ReturnData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReturnData)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Refund != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Refund))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SdkGasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SdkGasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecodeLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecodeLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecodeLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecodeLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecodeLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecodeLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecodedLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Indexed {
+		i--
+		if m.Indexed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReceiptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReceiptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReceiptsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiptsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiptsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReceiptsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiptsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiptsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifiedContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifiedContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifiedContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifiedContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifiedContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifiedContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VerifiedContract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FrozenContract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FrozenContracts) > 0 {
+		for iNdEx := len(m.FrozenContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Sponsorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorUsagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorUsagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorUsagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorUsagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorUsagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorUsagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ScheduledCall.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCallsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCallsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduledCalls) > 0 {
+		for iNdEx := len(m.ScheduledCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySweptFundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySweptFundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySweptFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySweptFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySweptFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySweptFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDebugTraceCallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDebugTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDebugTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisableStorage {
+		i--
+		if m.DisableStorage {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DisableMemory {
+		i--
+		if m.DisableMemory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DisableStack {
+		i--
+		if m.DisableStack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Value != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Callee) > 0 {
+		i -= len(m.Callee)
+		copy(dAtA[i:], m.Callee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Callee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDebugTraceCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDebugTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDebugTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trace) > 0 {
+		i -= len(m.Trace)
+		copy(dAtA[i:], m.Trace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeployAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeployAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeployAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deployer) > 0 {
+		i -= len(m.Deployer)
+		copy(dAtA[i:], m.Deployer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Deployer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeployAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeployAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeployAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exists {
+		i--
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReturnVars) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReturnVars) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReturnVars) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAbiRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAbiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressMetaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressMetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetaHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMetaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CVMAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryViewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Callee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AbiSpec)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryViewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReturnVars) > 0 {
+		for _, e := range m.ReturnVars {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ReturnData)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Callee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovQuery(uint64(m.Value))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsEwasm {
+		n += 2
+	}
+	if m.IsRuntime {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySimulateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if m.SdkGasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.SdkGasUsed))
+	}
+	if m.Refund != 0 {
+		n += 1 + sovQuery(uint64(m.Refund))
+	}
+	l = len(m.ReturnData)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Reverted {
+		n += 2
+	}
+	l = len(m.RevertReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDecodeLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDecodeLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DecodedLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Log.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Indexed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryReceiptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *QueryReceiptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Receipt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReceiptsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReceiptsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifiedContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifiedContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VerifiedContract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFrozenContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FrozenContract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFrozenContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FrozenContracts) > 0 {
+		for _, e := range m.FrozenContracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sponsorship.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySponsorUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySponsorUsagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorUsagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryScheduledCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScheduledCall.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScheduledCallsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledCallsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledCalls) > 0 {
+		for _, e := range m.ScheduledCalls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySweptFundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySweptFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDebugTraceCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Callee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovQuery(uint64(m.Value))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DisableStack {
+		n += 2
+	}
+	if m.DisableMemory {
+		n += 2
+	}
+	if m.DisableStorage {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDebugTraceCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeployAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Deployer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeployAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Exists {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReturnVars) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAbiRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAbiRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAbiRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAbiResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAbiResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAbiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractStorageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractStorageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractStorageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storage = append(m.Storage, Storage{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressMetaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressMetaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressMetaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressMetaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMetaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMetaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMetaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMetaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CVMAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CVMAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CVMAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &types.BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryViewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryViewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryViewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbiSpec", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbiSpec = append(m.AbiSpec[:0], dAtA[iNdEx:postIndex]...)
+			if m.AbiSpec == nil {
+				m.AbiSpec = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryViewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryViewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryViewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnVars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnVars = append(m.ReturnVars, &ReturnVars{})
+			if err := m.ReturnVars[len(m.ReturnVars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnData == nil {
+				m.ReturnData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEwasm", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEwasm = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRuntime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRuntime = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SdkGasUsed", wireType)
+			}
+			m.SdkGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SdkGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			m.Refund = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Refund |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnData == nil {
+				m.ReturnData = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevertReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDecodeLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDecodeLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDecodeLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDecodeLogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDecodeLogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDecodeLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, DecodedLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecodedLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecodedLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecodedLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, EventParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Indexed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReceiptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReceiptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReceiptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReceiptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReceiptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReceiptsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReceiptsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReceiptsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReceiptsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReceiptsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReceiptsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, Receipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifiedContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifiedContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifiedContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifiedContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifiedContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifiedContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VerifiedContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FrozenContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenContracts = append(m.FrozenContracts, FrozenContract{})
+			if err := m.FrozenContracts[len(m.FrozenContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *ReturnVars) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1917,15 +10563,15 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1953,7 +10599,7 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1979,7 +10625,7 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2002,15 +10648,135 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorship", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types1.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2038,7 +10804,39 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2064,7 +10862,7 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAbiRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2083,21 +10881,54 @@ func (m *QueryAbiRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAbiRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAbiRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2107,23 +10938,25 @@ func (m *QueryAbiRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Remaining = append(m.Remaining, types1.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2149,7 +10982,7 @@ func (m *QueryAbiRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAbiResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorUsagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2172,15 +11005,15 @@ func (m *QueryAbiResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAbiResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorUsagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAbiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorUsagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2208,7 +11041,43 @@ func (m *QueryAbiResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Abi = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2234,7 +11103,7 @@ func (m *QueryAbiResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorUsagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2257,17 +11126,17 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorUsagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorUsagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2277,29 +11146,31 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Usages = append(m.Usages, SponsorUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2309,23 +11180,27 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2351,7 +11226,7 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2374,17 +11249,17 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var byteLen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2394,26 +11269,11 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2438,7 +11298,7 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressMetaRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2461,17 +11321,17 @@ func (m *QueryAddressMetaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressMetaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCall", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2481,23 +11341,24 @@ func (m *QueryAddressMetaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.ScheduledCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2523,7 +11384,7 @@ func (m *QueryAddressMetaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressMetaResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledCallsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2546,15 +11407,15 @@ func (m *QueryAddressMetaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressMetaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledCallsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledCallsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2582,7 +11443,43 @@ func (m *QueryAddressMetaResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaHash = string(dAtA[iNdEx:postIndex])
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2608,7 +11505,7 @@ func (m *QueryAddressMetaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMetaRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledCallsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2631,17 +11528,17 @@ func (m *QueryMetaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMetaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledCallsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledCallsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCalls", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2651,23 +11548,61 @@ func (m *QueryMetaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.ScheduledCalls = append(m.ScheduledCalls, ScheduledCall{})
+			if err := m.ScheduledCalls[len(m.ScheduledCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2693,7 +11628,7 @@ func (m *QueryMetaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMetaResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySweptFundsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2716,44 +11651,12 @@ func (m *QueryMetaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMetaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySweptFundsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySweptFundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2778,7 +11681,7 @@ func (m *QueryMetaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySweptFundsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2801,17 +11704,17 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySweptFundsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySweptFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2821,23 +11724,25 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2863,7 +11768,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CVMAccount) Unmarshal(dAtA []byte) error {
+func (m *QueryDebugTraceCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2886,17 +11791,17 @@ func (m *CVMAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CVMAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDebugTraceCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CVMAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDebugTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2906,31 +11811,27 @@ func (m *CVMAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAccount == nil {
-				m.BaseAccount = &types.BaseAccount{}
-			}
-			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2958,13 +11859,32 @@ func (m *CVMAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = string(dAtA[iNdEx:postIndex])
+			m.Callee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2974,24 +11894,86 @@ func (m *CVMAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Abi = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableStack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableStack = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableMemory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableMemory = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableStorage", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableStorage = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3016,7 +11998,7 @@ func (m *CVMAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryViewRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDebugTraceCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3039,15 +12021,15 @@ func (m *QueryViewRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryViewRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDebugTraceCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryViewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDebugTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3075,45 +12057,66 @@ func (m *QueryViewRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Caller = string(dAtA[iNdEx:postIndex])
+			m.Trace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Callee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeployAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeployAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeployAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbiSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3123,29 +12126,27 @@ func (m *QueryViewRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbiSpec = append(m.AbiSpec[:0], dAtA[iNdEx:postIndex]...)
-			if m.AbiSpec == nil {
-				m.AbiSpec = []byte{}
-			}
+			m.Deployer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3173,13 +12174,13 @@ func (m *QueryViewRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3189,25 +12190,23 @@ func (m *QueryViewRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3233,7 +12232,7 @@ func (m *QueryViewRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryViewResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeployAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3256,17 +12255,17 @@ func (m *QueryViewResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryViewResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeployAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryViewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeployAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnVars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3276,26 +12275,44 @@ func (m *QueryViewResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReturnVars = append(m.ReturnVars, &ReturnVars{})
-			if err := m.ReturnVars[len(m.ReturnVars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exists = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,7 @@
+package types
+
+// Receipt statuses, which match the statuses of Ethereum receipts.
+const (
+	ReceiptStatusFailed     uint32 = 0
+	ReceiptStatusSuccessful uint32 = 1
+)
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MinScheduledCallGasLimit is the minimum gas limit of the runs of a scheduled call, which covers
+	// the setup of a run.
+	MinScheduledCallGasLimit uint64 = 20000
+
+	// MaxScheduledCallsPerBlock is the maximum number of scheduled calls due at the beginning of a
+	// block, the rest of which are left to the next blocks.
+	MaxScheduledCallsPerBlock = 100
+)
+
+// IsUnderfunded returns whether the prepaid gas left for a scheduled call does not cover its next run.
+func (c ScheduledCall) IsUnderfunded() bool {
+	return c.PrepaidGas < c.GasLimit
+}
+
+// IsCompleted returns whether a scheduled call has run its maximum number of runs.
+func (c ScheduledCall) IsCompleted() bool {
+	return c.MaxRuns != 0 && c.Runs >= c.MaxRuns
+}
+
+// Validate runs stateless checks on a scheduled call.
+func (c ScheduledCall) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.Caller); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, c.Caller)
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Callee); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, c.Callee)
+	}
+	if c.Interval == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "zero interval")
+	}
+	if c.GasLimit == 0 || c.GasLimit > math.MaxInt64 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid gas limit %d", c.GasLimit)
+	}
+	if !c.PrepaidFee.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, c.PrepaidFee.String())
+	}
+	return nil
+}
+
+// validateSchedule runs stateless checks on the interval, the gas and the fee of a scheduled call,
+// which prepays at least one run.
+func validateSchedule(interval, gasLimit, prepaidGas uint64, prepaidFee sdk.Coins) error {
+	if interval == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "zero interval")
+	}
+	if gasLimit < MinScheduledCallGasLimit || gasLimit > math.MaxInt64 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "gas limit %d is below the minimum %d or too large",
+			gasLimit, MinScheduledCallGasLimit)
+	}
+	if prepaidGas < gasLimit || prepaidGas > math.MaxInt64 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "prepaid gas %d does not cover a run with gas limit %d",
+			prepaidGas, gasLimit)
+	}
+	if !prepaidFee.IsValid() || prepaidFee.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid prepaid fee %s", prepaidFee)
+	}
+	return nil
+}
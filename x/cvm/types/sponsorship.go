@@ -0,0 +1,89 @@
+package types
+
+import (
+	"encoding/hex"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SponsorshipDay returns the UTC day of a time, as the number of days since the Unix epoch, which
+// the daily caps of sponsorships are reset at.
+func SponsorshipDay(t time.Time) int64 {
+	return t.Unix() / int64(24*time.Hour/time.Second)
+}
+
+// NewSponsorship returns a new Sponsorship object. The selectors are lowercased.
+func NewSponsorship(contract, sponsor string, dailyCap sdk.Coins, selectors []string, expiry time.Time) Sponsorship {
+	lowered := make([]string, len(selectors))
+	for i, selector := range selectors {
+		lowered[i] = strings.ToLower(selector)
+	}
+	return Sponsorship{
+		Contract:  contract,
+		Sponsor:   sponsor,
+		DailyCap:  dailyCap,
+		Selectors: lowered,
+		Expiry:    expiry,
+	}
+}
+
+// IsExpired returns whether a sponsorship has expired at a time.
+func (s Sponsorship) IsExpired(t time.Time) bool {
+	return !s.Expiry.IsZero() && !t.Before(s.Expiry)
+}
+
+// Allows returns whether a sponsorship sponsors the calls of a function, which is the hex encoded
+// function selector prefixed with 0x, or empty for the calls without a selector.
+func (s Sponsorship) Allows(function string) bool {
+	if len(s.Selectors) == 0 {
+		return true
+	}
+	for _, selector := range s.Selectors {
+		if selector == function {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate runs stateless checks on a sponsorship.
+func (s Sponsorship) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, s.Contract)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Sponsor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, s.Sponsor)
+	}
+	return validateSponsorshipPolicy(s.DailyCap, s.Selectors)
+}
+
+// validateSponsorshipPolicy runs stateless checks on the daily cap and the selectors of a sponsorship.
+func validateSponsorshipPolicy(dailyCap sdk.Coins, selectors []string) error {
+	if dailyCap.Empty() || !dailyCap.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid daily cap %s", dailyCap)
+	}
+	for _, selector := range selectors {
+		if bz, err := hex.DecodeString(strings.TrimPrefix(selector, "0x")); err != nil || len(bz) != 4 ||
+			!strings.HasPrefix(selector, "0x") {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid function selector %s", selector)
+		}
+	}
+	return nil
+}
+
+// Validate runs stateless checks on a sponsor usage.
+func (u SponsorUsage) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(u.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, u.Contract)
+	}
+	if _, err := sdk.AccAddressFromBech32(u.User); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, u.User)
+	}
+	if !u.Spent.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spent fees %s", u.Spent)
+	}
+	return nil
+}
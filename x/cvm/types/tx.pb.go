@@ -6,9 +6,13 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	payload "github.com/hyperledger/burrow/txs/payload"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -16,12 +20,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -34,6 +40,8 @@ type MsgCall struct {
 	Callee string `protobuf:"bytes,2,opt,name=callee,proto3" json:"callee,omitempty" yaml:"callee"`
 	Value  uint64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty" yaml:"value"`
 	Data   []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty" yaml:"data"`
+	// accept_risk accepts calling a contract whose oracle score is below the threshold score.
+	AcceptRisk bool `protobuf:"varint,5,opt,name=accept_risk,json=acceptRisk,proto3" json:"accept_risk,omitempty" yaml:"accept_risk"`
 }
 
 func (m *MsgCall) Reset()         { *m = MsgCall{} }
@@ -97,6 +105,13 @@ func (m *MsgCall) GetData() []byte {
 	return nil
 }
 
+func (m *MsgCall) GetAcceptRisk() bool {
+	if m != nil {
+		return m.AcceptRisk
+	}
+	return false
+}
+
 type MsgCallResponse struct {
 	Result []byte `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty" yaml:"result"`
 }
@@ -141,6 +156,71 @@ func (m *MsgCallResponse) GetResult() []byte {
 	return nil
 }
 
+// CallFailure describes a failed CVM call. A failed call fails its transaction, whose ABCI error
+// carries the failure.
+type CallFailure struct {
+	// code is the CVM error code of the failure.
+	Code uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty" yaml:"code"`
+	// revert_data is the raw output of a reverted execution.
+	RevertData []byte `protobuf:"bytes,2,opt,name=revert_data,json=revertData,proto3" json:"revert_data,omitempty" yaml:"revert_data"`
+	// reason is the decoded Error(string) or Panic(uint256) reason of a reverted execution.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+}
+
+func (m *CallFailure) Reset()         { *m = CallFailure{} }
+func (m *CallFailure) String() string { return proto.CompactTextString(m) }
+func (*CallFailure) ProtoMessage()    {}
+func (*CallFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{2}
+}
+func (m *CallFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CallFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallFailure.Merge(m, src)
+}
+func (m *CallFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallFailure proto.InternalMessageInfo
+
+func (m *CallFailure) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *CallFailure) GetRevertData() []byte {
+	if m != nil {
+		return m.RevertData
+	}
+	return nil
+}
+
+func (m *CallFailure) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type MsgDeploy struct {
 	// Caller is the sender of the CVM-message.
 	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty" yaml:"caller"`
@@ -156,13 +236,18 @@ type MsgDeploy struct {
 	IsEWASM bool `protobuf:"varint,6,opt,name=is_eWASM,json=isEWASM,proto3" json:"is_eWASM,omitempty" yaml:"is_EWASM"`
 	// is_runtime is true if the code is runtime code.
 	IsRuntime bool `protobuf:"varint,7,opt,name=is_runtime,json=isRuntime,proto3" json:"is_runtime,omitempty" yaml:"is_runtime"`
+	// Admin is the optional account allowed to migrate the contract code.
+	Admin string `protobuf:"bytes,8,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// Salt is the optional 32-byte salt deriving the contract address like CREATE2, from the caller,
+	// the salt and the Keccak-256 hash of the code, instead of from the caller sequence.
+	Salt []byte `protobuf:"bytes,9,opt,name=salt,proto3" json:"salt,omitempty" yaml:"salt"`
 }
 
 func (m *MsgDeploy) Reset()         { *m = MsgDeploy{} }
 func (m *MsgDeploy) String() string { return proto.CompactTextString(m) }
 func (*MsgDeploy) ProtoMessage()    {}
 func (*MsgDeploy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83f9b3577718d7e8, []int{2}
+	return fileDescriptor_83f9b3577718d7e8, []int{3}
 }
 func (m *MsgDeploy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,6 +325,20 @@ func (m *MsgDeploy) GetIsRuntime() bool {
 	return false
 }
 
+func (m *MsgDeploy) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgDeploy) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
 type MsgDeployResponse struct {
 	Result []byte `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty" yaml:"result"`
 }
@@ -248,7 +347,7 @@ func (m *MsgDeployResponse) Reset()         { *m = MsgDeployResponse{} }
 func (m *MsgDeployResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeployResponse) ProtoMessage()    {}
 func (*MsgDeployResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83f9b3577718d7e8, []int{3}
+	return fileDescriptor_83f9b3577718d7e8, []int{4}
 }
 func (m *MsgDeployResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,464 +383,5764 @@ func (m *MsgDeployResponse) GetResult() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgCall)(nil), "shentu.cvm.v1alpha1.MsgCall")
-	proto.RegisterType((*MsgCallResponse)(nil), "shentu.cvm.v1alpha1.MsgCallResponse")
-	proto.RegisterType((*MsgDeploy)(nil), "shentu.cvm.v1alpha1.MsgDeploy")
-	proto.RegisterType((*MsgDeployResponse)(nil), "shentu.cvm.v1alpha1.MsgDeployResponse")
+// MsgMigrate replaces the code of a contract while keeping its storage and balance.
+type MsgMigrate struct {
+	// Sender is the admin of the contract.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// Contract is the address of the migrated contract.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// Code is the new runtime code of the contract. It has the code type of the replaced code.
+	Code []byte `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty" yaml:"code"`
+	// Abi is the Solidity ABI for the new code.
+	Abi string `protobuf:"bytes,4,opt,name=abi,proto3" json:"abi,omitempty" yaml:"abi"`
+	// Meta is the metadata for the new code.
+	Meta []*payload.ContractMeta `protobuf:"bytes,5,rep,name=meta,proto3" json:"meta,omitempty" yaml:"meta"`
 }
 
-func init() { proto.RegisterFile("shentu/cvm/v1alpha1/tx.proto", fileDescriptor_83f9b3577718d7e8) }
-
-var fileDescriptor_83f9b3577718d7e8 = []byte{
-	// 523 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0x80, 0x9b, 0xb6, 0xdb, 0x6e, 0x67, 0xab, 0xb5, 0xd9, 0x5d, 0x08, 0x65, 0x4d, 0xc2, 0x28,
-	0x4b, 0xbd, 0x24, 0xec, 0xea, 0x69, 0x11, 0xc1, 0xac, 0x82, 0x08, 0x05, 0x19, 0x0f, 0x82, 0x97,
-	0x32, 0x4d, 0xc7, 0x74, 0x30, 0xc9, 0x84, 0xcc, 0xa4, 0x6e, 0xff, 0x85, 0xff, 0xc1, 0xab, 0xff,
-	0x43, 0x8f, 0x7b, 0xf4, 0x14, 0xa4, 0xfd, 0x07, 0xf9, 0x05, 0x92, 0x99, 0x34, 0xac, 0x50, 0x57,
-	0xf4, 0x36, 0xbc, 0xef, 0x7b, 0x93, 0xf7, 0xf2, 0xe6, 0x81, 0x13, 0xbe, 0x20, 0xb1, 0xc8, 0x5c,
-	0x7f, 0x19, 0xb9, 0xcb, 0x33, 0x1c, 0x26, 0x0b, 0x7c, 0xe6, 0x8a, 0x2b, 0x27, 0x49, 0x99, 0x60,
-	0xfa, 0xa1, 0xa2, 0x8e, 0xbf, 0x8c, 0x9c, 0x2d, 0x1d, 0x1d, 0x05, 0x2c, 0x60, 0x92, 0xbb, 0xe5,
-	0x49, 0xa9, 0xa3, 0xfb, 0xbb, 0x2e, 0x2a, 0xf3, 0x14, 0x3e, 0x9a, 0x65, 0x69, 0xca, 0x3e, 0xb9,
-	0x09, 0x5e, 0x85, 0x0c, 0xcf, 0x55, 0x14, 0x7e, 0xd5, 0x40, 0x77, 0xc2, 0x83, 0x4b, 0x1c, 0x86,
-	0xfa, 0x23, 0xd0, 0xf1, 0x71, 0x18, 0x92, 0xd4, 0xd0, 0x6c, 0x6d, 0xdc, 0xf3, 0x86, 0x45, 0x6e,
-	0xdd, 0x59, 0xe1, 0x28, 0xbc, 0x80, 0x2a, 0x0e, 0x51, 0x25, 0xd4, 0x2a, 0x31, 0x9a, 0x3b, 0x55,
-	0xb2, 0x55, 0x89, 0x7e, 0x0a, 0xf6, 0x96, 0x38, 0xcc, 0x88, 0xd1, 0xb2, 0xb5, 0x71, 0xdb, 0xbb,
-	0x57, 0xe4, 0x56, 0x5f, 0x99, 0x32, 0x0c, 0x91, 0xc2, 0xfa, 0x03, 0xd0, 0x9e, 0x63, 0x81, 0x8d,
-	0xb6, 0xad, 0x8d, 0xfb, 0xde, 0xa0, 0xc8, 0xad, 0x03, 0xa5, 0x95, 0x51, 0x88, 0x24, 0x84, 0x4f,
-	0xc1, 0xa0, 0xaa, 0x16, 0x11, 0x9e, 0xb0, 0x98, 0x93, 0xb2, 0x94, 0x94, 0xf0, 0x2c, 0x14, 0xb2,
-	0xea, 0xfe, 0xcd, 0x52, 0x54, 0x1c, 0xa2, 0x4a, 0x80, 0xdf, 0x9a, 0xa0, 0x37, 0xe1, 0xc1, 0x0b,
-	0x92, 0x84, 0x6c, 0xf5, 0x2f, 0xed, 0xd6, 0x3d, 0x34, 0xff, 0xda, 0x83, 0xcf, 0xe6, 0xaa, 0xd5,
-	0xdf, 0x7a, 0x28, 0xa3, 0x10, 0x49, 0xa8, 0xdb, 0xa0, 0x85, 0x67, 0x54, 0xf6, 0xd9, 0xf3, 0xee,
-	0x16, 0xb9, 0x05, 0x94, 0x83, 0x67, 0x14, 0xa2, 0x12, 0xe9, 0x17, 0xa0, 0x1d, 0x11, 0x81, 0x8d,
-	0x3d, 0xbb, 0x35, 0x3e, 0x38, 0x3f, 0x76, 0xb6, 0x23, 0xbb, 0x64, 0xb1, 0x48, 0xb1, 0x2f, 0x26,
-	0x44, 0xe0, 0x9b, 0xb7, 0x97, 0x32, 0x44, 0x32, 0x47, 0x77, 0xc0, 0x3e, 0xe5, 0x53, 0xf2, 0xee,
-	0xf9, 0xdb, 0x89, 0xd1, 0xb1, 0xb5, 0xf1, 0xbe, 0x77, 0x58, 0xe4, 0xd6, 0x40, 0x89, 0x94, 0x4f,
-	0x5f, 0x96, 0x04, 0xa2, 0x2e, 0xe5, 0xf2, 0xa4, 0x3f, 0x01, 0x80, 0xf2, 0x69, 0x9a, 0xc5, 0x82,
-	0x46, 0xc4, 0xe8, 0xca, 0x8c, 0xe3, 0x22, 0xb7, 0x86, 0x75, 0x46, 0xc5, 0x20, 0xea, 0x51, 0x8e,
-	0xaa, 0xf3, 0x33, 0x30, 0xac, 0x7f, 0xe4, 0x7f, 0x4c, 0xe2, 0xfc, 0x8b, 0x06, 0x5a, 0x13, 0x1e,
-	0xe8, 0xaf, 0x41, 0x5b, 0x3e, 0xbd, 0x13, 0x67, 0xc7, 0x3b, 0x77, 0xaa, 0x51, 0x8f, 0x1e, 0xde,
-	0x46, 0xeb, 0xcf, 0xbf, 0x01, 0x9d, 0x6a, 0xb2, 0xe6, 0x9f, 0x7c, 0xc5, 0x47, 0xa7, 0xb7, 0xf3,
-	0xed, 0x8d, 0xde, 0xab, 0xef, 0x6b, 0x53, 0xbb, 0x5e, 0x9b, 0xda, 0xcf, 0xb5, 0xa9, 0x7d, 0xde,
-	0x98, 0x8d, 0xeb, 0x8d, 0xd9, 0xf8, 0xb1, 0x31, 0x1b, 0xef, 0x9d, 0x80, 0x8a, 0x45, 0x36, 0x73,
-	0x7c, 0x16, 0xb9, 0x3e, 0x49, 0x05, 0xfd, 0xf8, 0x81, 0x65, 0xf1, 0x1c, 0x0b, 0xca, 0x62, 0xb7,
-	0xda, 0xc3, 0x2b, 0xb9, 0x89, 0x62, 0x95, 0x10, 0x3e, 0xeb, 0xc8, 0x6d, 0x7b, 0xfc, 0x2b, 0x00,
-	0x00, 0xff, 0xff, 0xdb, 0x15, 0x80, 0x1e, 0xed, 0x03, 0x00, 0x00,
+func (m *MsgMigrate) Reset()         { *m = MsgMigrate{} }
+func (m *MsgMigrate) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrate) ProtoMessage()    {}
+func (*MsgMigrate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{5}
+}
+func (m *MsgMigrate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrate.Merge(m, src)
+}
+func (m *MsgMigrate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrate.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgMigrate proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	Call(ctx context.Context, in *MsgCall, opts ...grpc.CallOption) (*MsgCallResponse, error)
-	Deploy(ctx context.Context, in *MsgDeploy, opts ...grpc.CallOption) (*MsgDeployResponse, error)
+func (m *MsgMigrate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgMigrate) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgMigrate) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
 }
 
-func (c *msgClient) Call(ctx context.Context, in *MsgCall, opts ...grpc.CallOption) (*MsgCallResponse, error) {
-	out := new(MsgCallResponse)
-	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Msg/Call", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgMigrate) GetAbi() string {
+	if m != nil {
+		return m.Abi
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Deploy(ctx context.Context, in *MsgDeploy, opts ...grpc.CallOption) (*MsgDeployResponse, error) {
-	out := new(MsgDeployResponse)
-	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Msg/Deploy", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgMigrate) GetMeta() []*payload.ContractMeta {
+	if m != nil {
+		return m.Meta
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	Call(context.Context, *MsgCall) (*MsgCallResponse, error)
-	Deploy(context.Context, *MsgDeploy) (*MsgDeployResponse, error)
+type MsgMigrateResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgMigrateResponse) Reset()         { *m = MsgMigrateResponse{} }
+func (m *MsgMigrateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateResponse) ProtoMessage()    {}
+func (*MsgMigrateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{6}
 }
-
-func (*UnimplementedMsgServer) Call(ctx context.Context, req *MsgCall) (*MsgCallResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+func (m *MsgMigrateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Deploy(ctx context.Context, req *MsgDeploy) (*MsgDeployResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deploy not implemented")
+func (m *MsgMigrateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateResponse.Merge(m, src)
+}
+func (m *MsgMigrateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgMigrateResponse proto.InternalMessageInfo
+
+// MsgVerifyContract submits the source code of a contract, which is bound to the compilation
+// certificate of its code.
+type MsgVerifyContract struct {
+	// Sender is the deployer or the admin of the contract, or a certifier.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// Contract is the address of the verified contract.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// SourceFiles are the source files the code of the contract is compiled from.
+	SourceFiles []SourceFile `protobuf:"bytes,3,rep,name=source_files,json=sourceFiles,proto3" json:"source_files" yaml:"source_files"`
+	// CompilerVersion is the version of the compiler, which matches the compiler of the certificate.
+	CompilerVersion string `protobuf:"bytes,4,opt,name=compiler_version,json=compilerVersion,proto3" json:"compiler_version,omitempty" yaml:"compiler_version"`
+	// CompilerSettings are the JSON encoded settings the source files were compiled with.
+	CompilerSettings string `protobuf:"bytes,5,opt,name=compiler_settings,json=compilerSettings,proto3" json:"compiler_settings,omitempty" yaml:"compiler_settings"`
+	// CertificateId is the ID of the compilation certificate of the code of the contract.
+	CertificateId uint64 `protobuf:"varint,6,opt,name=certificate_id,json=certificateId,proto3" json:"certificate_id,omitempty" yaml:"certificate_id"`
 }
 
-func _Msg_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCall)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Call(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.cvm.v1alpha1.Msg/Call",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Call(ctx, req.(*MsgCall))
+func (m *MsgVerifyContract) Reset()         { *m = MsgVerifyContract{} }
+func (m *MsgVerifyContract) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifyContract) ProtoMessage()    {}
+func (*MsgVerifyContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{7}
+}
+func (m *MsgVerifyContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifyContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifyContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgVerifyContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifyContract.Merge(m, src)
+}
+func (m *MsgVerifyContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifyContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifyContract.DiscardUnknown(m)
 }
 
-func _Msg_Deploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeploy)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgVerifyContract proto.InternalMessageInfo
+
+func (m *MsgVerifyContract) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Deploy(ctx, in)
+	return ""
+}
+
+func (m *MsgVerifyContract) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.cvm.v1alpha1.Msg/Deploy",
+	return ""
+}
+
+func (m *MsgVerifyContract) GetSourceFiles() []SourceFile {
+	if m != nil {
+		return m.SourceFiles
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Deploy(ctx, req.(*MsgDeploy))
+	return nil
+}
+
+func (m *MsgVerifyContract) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "shentu.cvm.v1alpha1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Call",
-			Handler:    _Msg_Call_Handler,
-		},
-		{
-			MethodName: "Deploy",
-			Handler:    _Msg_Deploy_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "shentu/cvm/v1alpha1/tx.proto",
+func (m *MsgVerifyContract) GetCompilerSettings() string {
+	if m != nil {
+		return m.CompilerSettings
+	}
+	return ""
 }
 
-func (m *MsgCall) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVerifyContract) GetCertificateId() uint64 {
+	if m != nil {
+		return m.CertificateId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCall) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgVerifyContractResponse struct {
 }
 
-func (m *MsgCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Value != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Callee) > 0 {
-		i -= len(m.Callee)
-		copy(dAtA[i:], m.Callee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Callee)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Caller) > 0 {
-		i -= len(m.Caller)
-		copy(dAtA[i:], m.Caller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgVerifyContractResponse) Reset()         { *m = MsgVerifyContractResponse{} }
+func (m *MsgVerifyContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifyContractResponse) ProtoMessage()    {}
+func (*MsgVerifyContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{8}
+}
+func (m *MsgVerifyContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifyContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifyContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgVerifyContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifyContractResponse.Merge(m, src)
+}
+func (m *MsgVerifyContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifyContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifyContractResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCallResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgVerifyContractResponse proto.InternalMessageInfo
+
+// MsgFreezeContract freezes a contract for the certifier freeze duration, so that its calls are rejected.
+type MsgFreezeContract struct {
+	// Certifier is the certifier who freezes the contract.
+	Certifier string `protobuf:"bytes,1,opt,name=certifier,proto3" json:"certifier,omitempty" yaml:"certifier"`
+	// Contract is the address of the frozen contract.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// Reason is the reason of the freeze.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+}
+
+func (m *MsgFreezeContract) Reset()         { *m = MsgFreezeContract{} }
+func (m *MsgFreezeContract) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeContract) ProtoMessage()    {}
+func (*MsgFreezeContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{9}
+}
+func (m *MsgFreezeContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgFreezeContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeContract.Merge(m, src)
+}
+func (m *MsgFreezeContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeContract.DiscardUnknown(m)
 }
 
-func (m *MsgCallResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgFreezeContract proto.InternalMessageInfo
+
+func (m *MsgFreezeContract) GetCertifier() string {
+	if m != nil {
+		return m.Certifier
+	}
+	return ""
 }
 
-func (m *MsgCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Result) > 0 {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgFreezeContract) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeploy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFreezeContract) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeploy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgFreezeContractResponse struct {
 }
 
-func (m *MsgDeploy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsRuntime {
-		i--
-		if m.IsRuntime {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.IsEWASM {
-		i--
-		if m.IsEWASM {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Meta) > 0 {
-		for iNdEx := len(m.Meta) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Meta[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgFreezeContractResponse) Reset()         { *m = MsgFreezeContractResponse{} }
+func (m *MsgFreezeContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeContractResponse) ProtoMessage()    {}
+func (*MsgFreezeContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{10}
+}
+func (m *MsgFreezeContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Abi) > 0 {
-		i -= len(m.Abi)
-		copy(dAtA[i:], m.Abi)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Abi)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Code) > 0 {
-		i -= len(m.Code)
-		copy(dAtA[i:], m.Code)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Code)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Value != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Caller) > 0 {
-		i -= len(m.Caller)
-		copy(dAtA[i:], m.Caller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDeployResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgFreezeContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeContractResponse.Merge(m, src)
+}
+func (m *MsgFreezeContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeContractResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeployResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgFreezeContractResponse proto.InternalMessageInfo
+
+// MsgUnfreezeContract unfreezes a contract frozen by a certifier.
+type MsgUnfreezeContract struct {
+	// Certifier is a certifier.
+	Certifier string `protobuf:"bytes,1,opt,name=certifier,proto3" json:"certifier,omitempty" yaml:"certifier"`
+	// Contract is the address of the frozen contract.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
 }
 
-func (m *MsgDeployResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Result) > 0 {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUnfreezeContract) Reset()         { *m = MsgUnfreezeContract{} }
+func (m *MsgUnfreezeContract) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeContract) ProtoMessage()    {}
+func (*MsgUnfreezeContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{11}
+}
+func (m *MsgUnfreezeContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnfreezeContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeContract.Merge(m, src)
+}
+func (m *MsgUnfreezeContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeContract.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgUnfreezeContract proto.InternalMessageInfo
+
+func (m *MsgUnfreezeContract) GetCertifier() string {
+	if m != nil {
+		return m.Certifier
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MsgCall) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Caller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Callee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Value != 0 {
-		n += 1 + sovTx(uint64(m.Value))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgUnfreezeContract) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return n
+	return ""
 }
 
-func (m *MsgCallResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+type MsgUnfreezeContractResponse struct {
 }
 
-func (m *MsgDeploy) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnfreezeContractResponse) Reset()         { *m = MsgUnfreezeContractResponse{} }
+func (m *MsgUnfreezeContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeContractResponse) ProtoMessage()    {}
+func (*MsgUnfreezeContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{12}
+}
+func (m *MsgUnfreezeContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Caller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUnfreezeContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeContractResponse.Merge(m, src)
+}
+func (m *MsgUnfreezeContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeContractResponse proto.InternalMessageInfo
+
+// MsgSetSponsorship sets the sponsorship of the calls into a contract, replacing its previous
+// sponsorship. It is signed by both the admin of the contract and the sponsor.
+type MsgSetSponsorship struct {
+	// Admin is the admin of the contract.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// Contract is the address of the sponsored contract.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// Sponsor is the account paying the fees of the sponsored calls.
+	Sponsor string `protobuf:"bytes,3,opt,name=sponsor,proto3" json:"sponsor,omitempty" yaml:"sponsor"`
+	// DailyCap is the maximum fees the sponsor pays for the calls of each user in a UTC day.
+	DailyCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=daily_cap,json=dailyCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"daily_cap" yaml:"daily_cap"`
+	// Selectors are the function selectors of the sponsored calls. All the calls are sponsored if empty.
+	Selectors []string `protobuf:"bytes,5,rep,name=selectors,proto3" json:"selectors,omitempty" yaml:"selectors"`
+	// Expiry is the time the sponsorship expires at. The zero time never expires.
+	Expiry time.Time `protobuf:"bytes,6,opt,name=expiry,proto3,stdtime" json:"expiry" yaml:"expiry"`
+}
+
+func (m *MsgSetSponsorship) Reset()         { *m = MsgSetSponsorship{} }
+func (m *MsgSetSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSponsorship) ProtoMessage()    {}
+func (*MsgSetSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{13}
+}
+func (m *MsgSetSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Value != 0 {
-		n += 1 + sovTx(uint64(m.Value))
+}
+func (m *MsgSetSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSponsorship.Merge(m, src)
+}
+func (m *MsgSetSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSponsorship proto.InternalMessageInfo
+
+func (m *MsgSetSponsorship) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSetSponsorship) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	l = len(m.Abi)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSetSponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
 	}
-	if len(m.Meta) > 0 {
-		for _, e := range m.Meta {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSetSponsorship) GetDailyCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DailyCap
+	}
+	return nil
+}
+
+func (m *MsgSetSponsorship) GetSelectors() []string {
+	if m != nil {
+		return m.Selectors
+	}
+	return nil
+}
+
+func (m *MsgSetSponsorship) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+type MsgSetSponsorshipResponse struct {
+}
+
+func (m *MsgSetSponsorshipResponse) Reset()         { *m = MsgSetSponsorshipResponse{} }
+func (m *MsgSetSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSponsorshipResponse) ProtoMessage()    {}
+func (*MsgSetSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{14}
+}
+func (m *MsgSetSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgSetSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSponsorshipResponse proto.InternalMessageInfo
+
+// MsgRevokeSponsorship revokes the sponsorship of the calls into a contract.
+type MsgRevokeSponsorship struct {
+	// Sender is the admin of the contract or the sponsor.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// Contract is the address of the sponsored contract.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+}
+
+func (m *MsgRevokeSponsorship) Reset()         { *m = MsgRevokeSponsorship{} }
+func (m *MsgRevokeSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSponsorship) ProtoMessage()    {}
+func (*MsgRevokeSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{15}
+}
+func (m *MsgRevokeSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSponsorship.Merge(m, src)
+}
+func (m *MsgRevokeSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSponsorship proto.InternalMessageInfo
+
+func (m *MsgRevokeSponsorship) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRevokeSponsorship) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+type MsgRevokeSponsorshipResponse struct {
+}
+
+func (m *MsgRevokeSponsorshipResponse) Reset()         { *m = MsgRevokeSponsorshipResponse{} }
+func (m *MsgRevokeSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSponsorshipResponse) ProtoMessage()    {}
+func (*MsgRevokeSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{16}
+}
+func (m *MsgRevokeSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgRevokeSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSponsorshipResponse proto.InternalMessageInfo
+
+// MsgScheduleCall schedules a contract call executed periodically at the beginning of blocks. The
+// prepaid fee is held in escrow by the module, which pays the fee collector for the gas of each run
+// and refunds the rest to the caller when the call ends.
+type MsgScheduleCall struct {
+	// Caller is the caller of the scheduled call.
+	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty" yaml:"caller"`
+	// Callee is the address of the called contract.
+	Callee string `protobuf:"bytes,2,opt,name=callee,proto3" json:"callee,omitempty" yaml:"callee"`
+	// Data is the calldata of the call.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty" yaml:"data"`
+	// Interval is the number of blocks between the runs of the call, the first of which is an interval after the transaction.
+	Interval uint64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty" yaml:"interval"`
+	// MaxRuns is the maximum number of runs of the call. Zero runs the call until its prepaid gas runs out.
+	MaxRuns uint64 `protobuf:"varint,5,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty" yaml:"max_runs"`
+	// GasLimit is the gas limit of each run.
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+	// PrepaidGas is the gas paid for the runs.
+	PrepaidGas uint64 `protobuf:"varint,7,opt,name=prepaid_gas,json=prepaidGas,proto3" json:"prepaid_gas,omitempty" yaml:"prepaid_gas"`
+	// PrepaidFee is the fee paid for the prepaid gas.
+	PrepaidFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=prepaid_fee,json=prepaidFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"prepaid_fee" yaml:"prepaid_fee"`
+}
+
+func (m *MsgScheduleCall) Reset()         { *m = MsgScheduleCall{} }
+func (m *MsgScheduleCall) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleCall) ProtoMessage()    {}
+func (*MsgScheduleCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{17}
+}
+func (m *MsgScheduleCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleCall.Merge(m, src)
+}
+func (m *MsgScheduleCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleCall proto.InternalMessageInfo
+
+func (m *MsgScheduleCall) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *MsgScheduleCall) GetCallee() string {
+	if m != nil {
+		return m.Callee
+	}
+	return ""
+}
+
+func (m *MsgScheduleCall) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *MsgScheduleCall) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *MsgScheduleCall) GetMaxRuns() uint64 {
+	if m != nil {
+		return m.MaxRuns
+	}
+	return 0
+}
+
+func (m *MsgScheduleCall) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *MsgScheduleCall) GetPrepaidGas() uint64 {
+	if m != nil {
+		return m.PrepaidGas
+	}
+	return 0
+}
+
+func (m *MsgScheduleCall) GetPrepaidFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PrepaidFee
+	}
+	return nil
+}
+
+type MsgScheduleCallResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+}
+
+func (m *MsgScheduleCallResponse) Reset()         { *m = MsgScheduleCallResponse{} }
+func (m *MsgScheduleCallResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleCallResponse) ProtoMessage()    {}
+func (*MsgScheduleCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{18}
+}
+func (m *MsgScheduleCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleCallResponse.Merge(m, src)
+}
+func (m *MsgScheduleCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleCallResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleCallResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelScheduledCall cancels a scheduled call and refunds its prepaid fee left.
+type MsgCancelScheduledCall struct {
+	// Caller is the caller of the scheduled call.
+	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty" yaml:"caller"`
+	// Id is the ID of the scheduled call.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+}
+
+func (m *MsgCancelScheduledCall) Reset()         { *m = MsgCancelScheduledCall{} }
+func (m *MsgCancelScheduledCall) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledCall) ProtoMessage()    {}
+func (*MsgCancelScheduledCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{19}
+}
+func (m *MsgCancelScheduledCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledCall.Merge(m, src)
+}
+func (m *MsgCancelScheduledCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledCall proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledCall) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *MsgCancelScheduledCall) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgCancelScheduledCallResponse struct {
+}
+
+func (m *MsgCancelScheduledCallResponse) Reset()         { *m = MsgCancelScheduledCallResponse{} }
+func (m *MsgCancelScheduledCallResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledCallResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{20}
+}
+func (m *MsgCancelScheduledCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledCallResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledCallResponse proto.InternalMessageInfo
+
+// MsgMultiCall calls contracts in order within one message. In atomic mode, a failed call fails the
+// message, and with it the transaction, whose ABCI error carries the failure and the index of the
+// failed call instead of the results. Otherwise, the state changes of a failed call are discarded
+// and the next calls still run.
+type MsgMultiCall struct {
+	// Caller is the caller of the calls.
+	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty" yaml:"caller"`
+	// Calls are the calls in the order they run.
+	Calls []MultiCallEntry `protobuf:"bytes,2,rep,name=calls,proto3" json:"calls" yaml:"calls"`
+	// Atomic fails the message if any call fails.
+	Atomic bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty" yaml:"atomic"`
+	// AcceptRisk accepts calling contracts whose oracle scores are below the threshold score.
+	AcceptRisk bool `protobuf:"varint,4,opt,name=accept_risk,json=acceptRisk,proto3" json:"accept_risk,omitempty" yaml:"accept_risk"`
+}
+
+func (m *MsgMultiCall) Reset()         { *m = MsgMultiCall{} }
+func (m *MsgMultiCall) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiCall) ProtoMessage()    {}
+func (*MsgMultiCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{21}
+}
+func (m *MsgMultiCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMultiCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiCall.Merge(m, src)
+}
+func (m *MsgMultiCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiCall proto.InternalMessageInfo
+
+func (m *MsgMultiCall) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *MsgMultiCall) GetCalls() []MultiCallEntry {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+func (m *MsgMultiCall) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
+	}
+	return false
+}
+
+func (m *MsgMultiCall) GetAcceptRisk() bool {
+	if m != nil {
+		return m.AcceptRisk
+	}
+	return false
+}
+
+// MultiCallEntry is a call of a MsgMultiCall.
+type MultiCallEntry struct {
+	Callee string `protobuf:"bytes,1,opt,name=callee,proto3" json:"callee,omitempty" yaml:"callee"`
+	Value  uint64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty" yaml:"value"`
+	Data   []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty" yaml:"data"`
+}
+
+func (m *MultiCallEntry) Reset()         { *m = MultiCallEntry{} }
+func (m *MultiCallEntry) String() string { return proto.CompactTextString(m) }
+func (*MultiCallEntry) ProtoMessage()    {}
+func (*MultiCallEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{22}
+}
+func (m *MultiCallEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiCallEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiCallEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiCallEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiCallEntry.Merge(m, src)
+}
+func (m *MultiCallEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiCallEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiCallEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiCallEntry proto.InternalMessageInfo
+
+func (m *MultiCallEntry) GetCallee() string {
+	if m != nil {
+		return m.Callee
+	}
+	return ""
+}
+
+func (m *MultiCallEntry) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *MultiCallEntry) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type MsgMultiCallResponse struct {
+	// results are the results of the calls, in order.
+	Results []MultiCallResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results" yaml:"results"`
+}
+
+func (m *MsgMultiCallResponse) Reset()         { *m = MsgMultiCallResponse{} }
+func (m *MsgMultiCallResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiCallResponse) ProtoMessage()    {}
+func (*MsgMultiCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{23}
+}
+func (m *MsgMultiCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMultiCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiCallResponse.Merge(m, src)
+}
+func (m *MsgMultiCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiCallResponse proto.InternalMessageInfo
+
+func (m *MsgMultiCallResponse) GetResults() []MultiCallResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// MultiCallResult is the result of a call of a MsgMultiCall.
+type MultiCallResult struct {
+	Result []byte `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty" yaml:"result"`
+	// failure is null if the call succeeded.
+	Failure *CallFailure `protobuf:"bytes,2,opt,name=failure,proto3" json:"failure,omitempty" yaml:"failure"`
+}
+
+func (m *MultiCallResult) Reset()         { *m = MultiCallResult{} }
+func (m *MultiCallResult) String() string { return proto.CompactTextString(m) }
+func (*MultiCallResult) ProtoMessage()    {}
+func (*MultiCallResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83f9b3577718d7e8, []int{24}
+}
+func (m *MultiCallResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiCallResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiCallResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiCallResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiCallResult.Merge(m, src)
+}
+func (m *MultiCallResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiCallResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiCallResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiCallResult proto.InternalMessageInfo
+
+func (m *MultiCallResult) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *MultiCallResult) GetFailure() *CallFailure {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgCall)(nil), "shentu.cvm.v1alpha1.MsgCall")
+	proto.RegisterType((*MsgCallResponse)(nil), "shentu.cvm.v1alpha1.MsgCallResponse")
+	proto.RegisterType((*CallFailure)(nil), "shentu.cvm.v1alpha1.CallFailure")
+	proto.RegisterType((*MsgDeploy)(nil), "shentu.cvm.v1alpha1.MsgDeploy")
+	proto.RegisterType((*MsgDeployResponse)(nil), "shentu.cvm.v1alpha1.MsgDeployResponse")
+	proto.RegisterType((*MsgMigrate)(nil), "shentu.cvm.v1alpha1.MsgMigrate")
+	proto.RegisterType((*MsgMigrateResponse)(nil), "shentu.cvm.v1alpha1.MsgMigrateResponse")
+	proto.RegisterType((*MsgVerifyContract)(nil), "shentu.cvm.v1alpha1.MsgVerifyContract")
+	proto.RegisterType((*MsgVerifyContractResponse)(nil), "shentu.cvm.v1alpha1.MsgVerifyContractResponse")
+	proto.RegisterType((*MsgFreezeContract)(nil), "shentu.cvm.v1alpha1.MsgFreezeContract")
+	proto.RegisterType((*MsgFreezeContractResponse)(nil), "shentu.cvm.v1alpha1.MsgFreezeContractResponse")
+	proto.RegisterType((*MsgUnfreezeContract)(nil), "shentu.cvm.v1alpha1.MsgUnfreezeContract")
+	proto.RegisterType((*MsgUnfreezeContractResponse)(nil), "shentu.cvm.v1alpha1.MsgUnfreezeContractResponse")
+	proto.RegisterType((*MsgSetSponsorship)(nil), "shentu.cvm.v1alpha1.MsgSetSponsorship")
+	proto.RegisterType((*MsgSetSponsorshipResponse)(nil), "shentu.cvm.v1alpha1.MsgSetSponsorshipResponse")
+	proto.RegisterType((*MsgRevokeSponsorship)(nil), "shentu.cvm.v1alpha1.MsgRevokeSponsorship")
+	proto.RegisterType((*MsgRevokeSponsorshipResponse)(nil), "shentu.cvm.v1alpha1.MsgRevokeSponsorshipResponse")
+	proto.RegisterType((*MsgScheduleCall)(nil), "shentu.cvm.v1alpha1.MsgScheduleCall")
+	proto.RegisterType((*MsgScheduleCallResponse)(nil), "shentu.cvm.v1alpha1.MsgScheduleCallResponse")
+	proto.RegisterType((*MsgCancelScheduledCall)(nil), "shentu.cvm.v1alpha1.MsgCancelScheduledCall")
+	proto.RegisterType((*MsgCancelScheduledCallResponse)(nil), "shentu.cvm.v1alpha1.MsgCancelScheduledCallResponse")
+	proto.RegisterType((*MsgMultiCall)(nil), "shentu.cvm.v1alpha1.MsgMultiCall")
+	proto.RegisterType((*MultiCallEntry)(nil), "shentu.cvm.v1alpha1.MultiCallEntry")
+	proto.RegisterType((*MsgMultiCallResponse)(nil), "shentu.cvm.v1alpha1.MsgMultiCallResponse")
+	proto.RegisterType((*MultiCallResult)(nil), "shentu.cvm.v1alpha1.MultiCallResult")
+}
+
+func init() { proto.RegisterFile("shentu/cvm/v1alpha1/tx.proto", fileDescriptor_83f9b3577718d7e8) }
+
+var fileDescriptor_83f9b3577718d7e8 = []byte{
+	// 1638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0xdb, 0x46,
+	0x16, 0x37, 0x25, 0xd9, 0x92, 0xc6, 0xff, 0x69, 0x27, 0xa1, 0x65, 0x47, 0xd4, 0x4e, 0x82, 0xac,
+	0x83, 0xcd, 0x52, 0x6b, 0x67, 0x81, 0x5d, 0x04, 0x8b, 0x45, 0x2b, 0x27, 0x6e, 0x53, 0x54, 0x6d,
+	0x31, 0x6e, 0x53, 0xb4, 0x17, 0x61, 0x44, 0x8e, 0xe8, 0x81, 0x29, 0x52, 0xe5, 0x50, 0x8a, 0x15,
+	0xf4, 0xd4, 0x53, 0x0f, 0x3d, 0xe4, 0x50, 0xf4, 0xd2, 0x63, 0xd1, 0x4b, 0x3f, 0x49, 0x8e, 0xe9,
+	0xad, 0x27, 0xa6, 0x48, 0xd0, 0x53, 0x81, 0x1e, 0xf4, 0x09, 0x0a, 0xce, 0x0c, 0x69, 0x4a, 0x96,
+	0x6c, 0xa9, 0x40, 0x8a, 0x9e, 0x34, 0x7c, 0xef, 0xf7, 0xfe, 0xcf, 0x9b, 0x79, 0x23, 0xb0, 0xc3,
+	0x8e, 0x89, 0x1b, 0x74, 0xab, 0x66, 0xaf, 0x5d, 0xed, 0xed, 0x61, 0xa7, 0x73, 0x8c, 0xf7, 0xaa,
+	0xc1, 0xa9, 0xd1, 0xf1, 0xbd, 0xc0, 0x53, 0x37, 0x04, 0xd7, 0x30, 0x7b, 0x6d, 0x23, 0xe6, 0x96,
+	0x36, 0x6d, 0xcf, 0xf6, 0x38, 0xbf, 0x1a, 0xad, 0x04, 0xb4, 0x54, 0x36, 0x3d, 0xd6, 0xf6, 0x58,
+	0xb5, 0x89, 0x19, 0xa9, 0xf6, 0xf6, 0x9a, 0x24, 0xc0, 0x7b, 0x55, 0xd3, 0xa3, 0xae, 0xe4, 0xeb,
+	0xb6, 0xe7, 0xd9, 0x0e, 0xa9, 0xf2, 0xaf, 0x66, 0xb7, 0x55, 0x0d, 0x68, 0x9b, 0xb0, 0x00, 0xb7,
+	0x3b, 0x12, 0x70, 0x7d, 0x9c, 0x27, 0x91, 0x61, 0xc1, 0xde, 0x6c, 0x76, 0x7d, 0xdf, 0x7b, 0x5c,
+	0xed, 0xe0, 0xbe, 0xe3, 0x61, 0x4b, 0x50, 0xe1, 0x2f, 0x0a, 0xc8, 0xd7, 0x99, 0x7d, 0x80, 0x1d,
+	0x47, 0xbd, 0x0d, 0x16, 0x4c, 0xec, 0x38, 0xc4, 0xd7, 0x94, 0x8a, 0xb2, 0x5b, 0xac, 0xad, 0x0f,
+	0x42, 0x7d, 0xb9, 0x8f, 0xdb, 0xce, 0x3d, 0x28, 0xe8, 0x10, 0x49, 0x40, 0x02, 0x25, 0x5a, 0x66,
+	0x2c, 0x94, 0xc4, 0x50, 0xa2, 0xde, 0x02, 0xf3, 0x3d, 0xec, 0x74, 0x89, 0x96, 0xad, 0x28, 0xbb,
+	0xb9, 0xda, 0xda, 0x20, 0xd4, 0x97, 0x04, 0x92, 0x93, 0x21, 0x12, 0x6c, 0xf5, 0x06, 0xc8, 0x59,
+	0x38, 0xc0, 0x5a, 0xae, 0xa2, 0xec, 0x2e, 0xd5, 0x56, 0x07, 0xa1, 0xbe, 0x28, 0x60, 0x11, 0x15,
+	0x22, 0xce, 0x54, 0xff, 0x03, 0x16, 0xb1, 0x69, 0x92, 0x4e, 0xd0, 0xf0, 0x29, 0x3b, 0xd1, 0xe6,
+	0x2b, 0xca, 0x6e, 0xa1, 0x76, 0x75, 0x10, 0xea, 0xaa, 0xc0, 0xa6, 0x98, 0x10, 0x01, 0xf1, 0x85,
+	0xa2, 0x8f, 0xff, 0x81, 0x55, 0x19, 0x26, 0x22, 0xac, 0xe3, 0xb9, 0x8c, 0x44, 0x31, 0xf8, 0x84,
+	0x75, 0x9d, 0x80, 0x87, 0xbb, 0x94, 0x8e, 0x41, 0xd0, 0x21, 0x92, 0x00, 0xf8, 0x8d, 0x02, 0x16,
+	0x23, 0xd9, 0x43, 0x4c, 0x9d, 0xae, 0xcf, 0x7d, 0x35, 0x3d, 0x8b, 0x70, 0xc1, 0xe5, 0xb4, 0xaf,
+	0x11, 0x15, 0x22, 0xce, 0x8c, 0x7c, 0xf5, 0x49, 0x8f, 0xf8, 0x41, 0x83, 0xc7, 0x95, 0xe1, 0x46,
+	0x52, 0xbe, 0xa6, 0x98, 0x10, 0x01, 0xf1, 0x75, 0x3f, 0x0a, 0x92, 0x3b, 0x86, 0x99, 0xe7, 0x6a,
+	0xd9, 0xd1, 0xe4, 0x0a, 0x3a, 0x77, 0x8c, 0x2f, 0xbe, 0xce, 0x82, 0x62, 0x9d, 0xd9, 0xf7, 0x49,
+	0xc7, 0xf1, 0xfa, 0xb3, 0x14, 0x30, 0xa9, 0x4a, 0xe6, 0xd2, 0xaa, 0xf0, 0x48, 0xb3, 0xa3, 0x55,
+	0x49, 0x47, 0x5a, 0x01, 0x59, 0xdc, 0xa4, 0xbc, 0x72, 0xc5, 0xda, 0xca, 0x20, 0xd4, 0x81, 0xac,
+	0x46, 0x93, 0x42, 0x14, 0xb1, 0xd4, 0x7b, 0x20, 0xd7, 0x26, 0x01, 0xd6, 0xe6, 0x2b, 0xd9, 0xdd,
+	0xc5, 0xfd, 0x2b, 0x46, 0xbc, 0x09, 0x0f, 0x3c, 0x37, 0xf0, 0xb1, 0x19, 0xd4, 0x49, 0x80, 0xd3,
+	0xda, 0x23, 0x30, 0x44, 0x5c, 0x46, 0x35, 0x40, 0x81, 0xb2, 0x06, 0xf9, 0xf8, 0xcd, 0xa3, 0xba,
+	0xb6, 0xc0, 0x0b, 0xbe, 0x31, 0x08, 0xf5, 0x55, 0x01, 0xa4, 0xac, 0xf1, 0x20, 0xe2, 0x40, 0x94,
+	0xa7, 0x8c, 0xaf, 0xd4, 0x7f, 0x03, 0x40, 0x59, 0xc3, 0xef, 0xba, 0x51, 0x83, 0x68, 0x79, 0x2e,
+	0x71, 0x65, 0x10, 0xea, 0xeb, 0x89, 0x84, 0xe4, 0x41, 0x54, 0xa4, 0x0c, 0x89, 0x75, 0x94, 0x10,
+	0x6c, 0xb5, 0xa9, 0xab, 0x15, 0x78, 0x14, 0xa9, 0x84, 0x70, 0x32, 0x44, 0x82, 0x1d, 0x25, 0x84,
+	0x61, 0x27, 0xd0, 0x8a, 0xa3, 0x09, 0x89, 0xa8, 0x10, 0x71, 0x26, 0xfc, 0x3f, 0x58, 0x4f, 0xaa,
+	0xf2, 0x47, 0xf6, 0xdb, 0xaf, 0x0a, 0x00, 0x75, 0x66, 0xd7, 0xa9, 0xed, 0xe3, 0x80, 0x4b, 0x32,
+	0xe2, 0x5a, 0xe3, 0xea, 0x2a, 0xe8, 0x10, 0x49, 0x80, 0x5a, 0x05, 0x05, 0x53, 0xe6, 0x54, 0xb6,
+	0x66, 0x2a, 0x59, 0x31, 0x07, 0xa2, 0x04, 0xf4, 0x17, 0x28, 0x30, 0xdc, 0x04, 0xea, 0x59, 0xb0,
+	0x71, 0xba, 0xe0, 0xf7, 0x59, 0x9e, 0xc4, 0x47, 0xc4, 0xa7, 0xad, 0x7e, 0xac, 0xe6, 0xb5, 0xa6,
+	0xa2, 0x01, 0x96, 0x98, 0xd7, 0xf5, 0x4d, 0xd2, 0x68, 0x51, 0x87, 0x30, 0x2d, 0xcb, 0x63, 0xd1,
+	0x8d, 0x31, 0x67, 0xb8, 0x71, 0xc4, 0x81, 0x87, 0xd4, 0x21, 0xb5, 0xed, 0x67, 0xa1, 0x3e, 0x37,
+	0x08, 0xf5, 0x0d, 0xe9, 0x46, 0x4a, 0x05, 0x44, 0x8b, 0x2c, 0x01, 0x32, 0xf5, 0x10, 0xac, 0x99,
+	0x5e, 0xbb, 0x43, 0x1d, 0xe2, 0x37, 0x7a, 0xc4, 0x67, 0xd4, 0x73, 0x65, 0x4e, 0xb7, 0x07, 0xa1,
+	0x7e, 0x2d, 0xf6, 0x6c, 0x18, 0x01, 0xd1, 0x6a, 0x4c, 0x7a, 0x24, 0x28, 0xea, 0x43, 0xb0, 0x9e,
+	0xa0, 0x18, 0x09, 0x02, 0xea, 0xda, 0x8c, 0x9f, 0x85, 0xc5, 0xda, 0xce, 0x20, 0xd4, 0xb5, 0x11,
+	0x45, 0x31, 0x04, 0xa2, 0xc4, 0xfc, 0x91, 0x24, 0xa9, 0x6f, 0x80, 0x15, 0x93, 0xf8, 0x01, 0x6d,
+	0x51, 0x13, 0x07, 0xa4, 0x41, 0x2d, 0xde, 0x62, 0xb9, 0xda, 0xd6, 0x20, 0xd4, 0xaf, 0x48, 0x3d,
+	0x43, 0x7c, 0x88, 0x96, 0x53, 0x84, 0x87, 0x16, 0xdc, 0x06, 0x5b, 0xe7, 0xca, 0x94, 0x14, 0xf1,
+	0x3b, 0x85, 0x17, 0xf1, 0xd0, 0x27, 0xe4, 0x09, 0x49, 0x8a, 0xb8, 0x0f, 0x8a, 0x52, 0x47, 0x52,
+	0xc7, 0xcd, 0x41, 0xa8, 0xaf, 0x0d, 0xd9, 0x8b, 0x4a, 0x79, 0x06, 0x9b, 0xbd, 0x9a, 0x33, 0x9c,
+	0xa2, 0x22, 0x84, 0x61, 0x27, 0x93, 0x10, 0x9e, 0x80, 0x8d, 0x3a, 0xb3, 0x3f, 0x72, 0x5b, 0x7f,
+	0x7e, 0x0c, 0xf0, 0x3a, 0xd8, 0x1e, 0x63, 0x3b, 0x71, 0xed, 0x5b, 0xd1, 0x22, 0x47, 0x24, 0x38,
+	0x8a, 0x08, 0x9e, 0xcf, 0x8e, 0x69, 0xe7, 0xec, 0x24, 0x53, 0x2e, 0x3e, 0xc9, 0x66, 0xce, 0xe8,
+	0x1d, 0x90, 0x67, 0xc2, 0x8e, 0x4c, 0xa9, 0x3a, 0x08, 0xf5, 0x15, 0xb9, 0xeb, 0x05, 0x03, 0xa2,
+	0x18, 0xa2, 0x7e, 0x0e, 0x8a, 0x16, 0xa6, 0x4e, 0xbf, 0x61, 0xe2, 0x8e, 0x96, 0xe3, 0xad, 0xb4,
+	0x65, 0x88, 0x19, 0xc7, 0x88, 0x66, 0x1c, 0x43, 0xce, 0x38, 0xc6, 0x81, 0x47, 0xdd, 0xda, 0x7d,
+	0xd9, 0x44, 0x6b, 0xf1, 0x9d, 0x2f, 0x25, 0xe1, 0x0f, 0x2f, 0xf4, 0x5d, 0x9b, 0x06, 0xc7, 0xdd,
+	0xa6, 0x61, 0x7a, 0xed, 0xaa, 0x1c, 0x92, 0xc4, 0xcf, 0x3f, 0x99, 0x75, 0x52, 0x0d, 0xfa, 0x1d,
+	0xc2, 0xb8, 0x12, 0x86, 0x0a, 0x5c, 0xee, 0x00, 0x77, 0xa2, 0xf2, 0x30, 0xe2, 0x10, 0x33, 0xf0,
+	0x7c, 0xc6, 0x0f, 0xa5, 0xa1, 0xf2, 0x24, 0x2c, 0x88, 0xce, 0x60, 0x6a, 0x1d, 0x2c, 0x90, 0xd3,
+	0x0e, 0xf5, 0xfb, 0xbc, 0x07, 0x16, 0xf7, 0x4b, 0x86, 0x18, 0xb9, 0x8c, 0x78, 0xe4, 0x32, 0x3e,
+	0x8c, 0x47, 0xae, 0xda, 0x96, 0xf4, 0x57, 0xee, 0x28, 0x21, 0x07, 0x9f, 0xbe, 0xd0, 0x15, 0x24,
+	0x95, 0xc8, 0x5d, 0x35, 0x5c, 0x9c, 0xa4, 0x74, 0x3e, 0xd8, 0xac, 0x33, 0x1b, 0x91, 0x9e, 0x77,
+	0x42, 0xd2, 0xc5, 0x7b, 0x8d, 0xe7, 0x1b, 0x2c, 0x83, 0x9d, 0x71, 0x36, 0x13, 0x9f, 0xc2, 0x2c,
+	0x1f, 0x92, 0x8e, 0xcc, 0x63, 0x62, 0x75, 0x1d, 0xf2, 0x1a, 0x67, 0xc2, 0x78, 0xd6, 0xcb, 0x5e,
+	0x34, 0xeb, 0x55, 0x41, 0x81, 0xba, 0x01, 0xf1, 0x7b, 0xd8, 0xe1, 0xa7, 0x64, 0x6e, 0xe8, 0xde,
+	0x97, 0x1c, 0x88, 0x12, 0x50, 0x34, 0x28, 0xb4, 0xf1, 0x69, 0x74, 0xbb, 0x8b, 0xd3, 0x70, 0x48,
+	0x20, 0xe6, 0x40, 0x94, 0x6f, 0xe3, 0x53, 0xd4, 0x75, 0x99, 0xba, 0x07, 0x8a, 0x36, 0x66, 0x0d,
+	0x87, 0xb6, 0x69, 0x20, 0x8f, 0xbd, 0xd4, 0x1e, 0x49, 0x58, 0x10, 0x15, 0x6c, 0xcc, 0xde, 0x8d,
+	0x96, 0xd1, 0x4c, 0xd7, 0xf1, 0x49, 0x07, 0x53, 0xab, 0x61, 0x63, 0xc6, 0x87, 0x8b, 0x5c, 0x7a,
+	0xa6, 0x4b, 0x31, 0x21, 0x02, 0xf2, 0xeb, 0x2d, 0xcc, 0xd4, 0x2f, 0x94, 0x33, 0xc9, 0x16, 0x21,
+	0x5a, 0xe1, 0xb2, 0x86, 0x38, 0x94, 0x1b, 0x6c, 0x44, 0x71, 0x8b, 0x90, 0xd9, 0x5a, 0x22, 0x76,
+	0xe2, 0x90, 0x10, 0xf8, 0x5f, 0x70, 0x6d, 0xa4, 0xbe, 0xc9, 0x70, 0x72, 0x1d, 0x64, 0xa8, 0xc5,
+	0x6b, 0x9c, 0xab, 0x2d, 0x0f, 0x42, 0xbd, 0x28, 0xd3, 0x6c, 0x41, 0x94, 0xa1, 0x16, 0x6c, 0x82,
+	0xab, 0x7c, 0x7c, 0x76, 0x4d, 0xe2, 0xc4, 0xf2, 0xd6, 0xac, 0x1b, 0x44, 0xd8, 0xc8, 0x4c, 0xb2,
+	0x51, 0x01, 0xe5, 0xf1, 0x36, 0x92, 0x0d, 0xfa, 0x9b, 0x02, 0x96, 0xa2, 0x49, 0xa1, 0xeb, 0x04,
+	0x74, 0x56, 0xe3, 0xef, 0x83, 0xf9, 0x68, 0xc5, 0xb4, 0x0c, 0xcf, 0xfc, 0x8d, 0xb1, 0xb7, 0x7a,
+	0xa2, 0xf9, 0x81, 0x1b, 0xf8, 0xfd, 0xda, 0xa6, 0xac, 0xc1, 0xd2, 0x99, 0x4a, 0x06, 0x91, 0xd0,
+	0x13, 0xd9, 0xc6, 0x81, 0xd7, 0xa6, 0x26, 0xdf, 0xc5, 0x85, 0xb4, 0x6d, 0x41, 0x87, 0x48, 0x02,
+	0x46, 0x5f, 0x2d, 0xb9, 0xa9, 0x5f, 0x2d, 0x5f, 0x2a, 0x60, 0x65, 0xd8, 0xa7, 0x54, 0x97, 0x29,
+	0x53, 0xbf, 0xbc, 0x32, 0xd3, 0xbd, 0xbc, 0x2e, 0xea, 0x46, 0xe8, 0xf2, 0x03, 0x2b, 0x71, 0x26,
+	0xd9, 0x38, 0x8f, 0x40, 0x5e, 0x0c, 0xad, 0x4c, 0x53, 0x78, 0x66, 0x6f, 0x5e, 0x9c, 0x59, 0xc4,
+	0xc1, 0xb5, 0xab, 0x32, 0xb5, 0x2b, 0xe9, 0x01, 0x38, 0x6a, 0xce, 0x78, 0xf5, 0x95, 0x02, 0x56,
+	0x47, 0x84, 0x66, 0x98, 0xa0, 0xd5, 0xf7, 0x40, 0xbe, 0x25, 0x1e, 0x6b, 0x3c, 0xfa, 0xc5, 0xfd,
+	0xca, 0x58, 0xb7, 0x52, 0x8f, 0xba, 0xf4, 0x6d, 0x26, 0x45, 0x21, 0x8a, 0x95, 0xec, 0xff, 0x58,
+	0x00, 0xd9, 0x3a, 0xb3, 0xd5, 0x77, 0x40, 0x8e, 0xef, 0xbc, 0x9d, 0xf1, 0x51, 0x8a, 0x27, 0x66,
+	0xe9, 0xe6, 0x45, 0xdc, 0x24, 0x75, 0x1f, 0x80, 0x05, 0xf9, 0x70, 0x2b, 0x4f, 0xc2, 0x0b, 0x7e,
+	0xe9, 0xd6, 0xc5, 0xfc, 0x44, 0xe3, 0x11, 0xc8, 0xc7, 0x6f, 0x06, 0x7d, 0x92, 0x88, 0x04, 0x94,
+	0xfe, 0x7e, 0x09, 0x20, 0x51, 0x7a, 0x0c, 0x56, 0x46, 0x86, 0xf0, 0x89, 0xee, 0x0c, 0xe3, 0x4a,
+	0xc6, 0x74, 0xb8, 0xb4, 0xa5, 0x91, 0x49, 0x71, 0xa2, 0xa5, 0x61, 0x5c, 0xc9, 0x98, 0x0e, 0x97,
+	0x58, 0x72, 0xc1, 0xda, 0xb9, 0x89, 0x6e, 0x77, 0x92, 0x8e, 0x51, 0x64, 0xe9, 0x5f, 0xd3, 0x22,
+	0xd3, 0x91, 0x8d, 0x4e, 0x69, 0x93, 0x74, 0x0c, 0xe3, 0x4a, 0xc6, 0x74, 0xb8, 0xc4, 0xd2, 0x67,
+	0x60, 0x7d, 0xcc, 0x54, 0x31, 0x49, 0xc9, 0x39, 0x68, 0x69, 0x6f, 0x6a, 0x68, 0x62, 0xb2, 0x09,
+	0x96, 0x86, 0x66, 0x86, 0x89, 0xbb, 0x3f, 0x8d, 0x2a, 0xdd, 0x99, 0x06, 0x95, 0xd8, 0x78, 0x0c,
+	0x36, 0xc6, 0xdd, 0x3e, 0xff, 0x98, 0xdc, 0x68, 0xe7, 0xc0, 0xa5, 0xbb, 0x33, 0x80, 0x13, 0xc3,
+	0x9f, 0x80, 0xe2, 0xd9, 0x7d, 0xf3, 0xb7, 0x89, 0x3d, 0x13, 0x43, 0x4a, 0xb7, 0x2f, 0x85, 0xc4,
+	0xaa, 0x6b, 0x6f, 0x3f, 0x7b, 0x59, 0x56, 0x9e, 0xbf, 0x2c, 0x2b, 0x3f, 0xbf, 0x2c, 0x2b, 0x4f,
+	0x5f, 0x95, 0xe7, 0x9e, 0xbf, 0x2a, 0xcf, 0xfd, 0xf4, 0xaa, 0x3c, 0xf7, 0xa9, 0x91, 0xbe, 0xde,
+	0xa3, 0xe7, 0xc3, 0x49, 0xcb, 0xeb, 0xba, 0x16, 0x0e, 0xa8, 0xe7, 0x56, 0xe5, 0xdf, 0x7c, 0xa7,
+	0xfc, 0x8f, 0x3e, 0x7e, 0xd5, 0x37, 0x17, 0xf8, 0x84, 0x7a, 0xf7, 0xf7, 0x01, 0x00, 0x12, 0x2e,
+	0xbd, 0x44, 0x8d, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	Call(ctx context.Context, in *MsgCall, opts ...grpc.CallOption) (*MsgCallResponse, error)
+	Deploy(ctx context.Context, in *MsgDeploy, opts ...grpc.CallOption) (*MsgDeployResponse, error)
+	Migrate(ctx context.Context, in *MsgMigrate, opts ...grpc.CallOption) (*MsgMigrateResponse, error)
+	VerifyContract(ctx context.Context, in *MsgVerifyContract, opts ...grpc.CallOption) (*MsgVerifyContractResponse, error)
+	FreezeContract(ctx context.Context, in *MsgFreezeContract, opts ...grpc.CallOption) (*MsgFreezeContractResponse, error)
+	UnfreezeContract(ctx context.Context, in *MsgUnfreezeContract, opts ...grpc.CallOption) (*MsgUnfreezeContractResponse, error)
+	SetSponsorship(ctx context.Context, in *MsgSetSponsorship, opts ...grpc.CallOption) (*MsgSetSponsorshipResponse, error)
+	RevokeSponsorship(ctx context.Context, in *MsgRevokeSponsorship, opts ...grpc.CallOption) (*MsgRevokeSponsorshipResponse, error)
+	ScheduleCall(ctx context.Context, in *MsgScheduleCall, opts ...grpc.CallOption) (*MsgScheduleCallResponse, error)
+	CancelScheduledCall(ctx context.Context, in *MsgCancelScheduledCall, opts ...grpc.CallOption) (*MsgCancelScheduledCallResponse, error)
+	MultiCall(ctx context.Context, in *MsgMultiCall, opts ...grpc.CallOption) (*MsgMultiCallResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Call(ctx context.Context, in *MsgCall, opts ...grpc.CallOption) (*MsgCallResponse, error) {
+	out := new(MsgCallResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Msg/Call", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Deploy(ctx context.Context, in *MsgDeploy, opts ...grpc.CallOption) (*MsgDeployResponse, error) {
+	out := new(MsgDeployResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Msg/Deploy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Migrate(ctx context.Context, in *MsgMigrate, opts ...grpc.CallOption) (*MsgMigrateResponse, error) {
+	out := new(MsgMigrateResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Msg/Migrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VerifyContract(ctx context.Context, in *MsgVerifyContract, opts ...grpc.CallOption) (*MsgVerifyContractResponse, error) {
+	out := new(MsgVerifyContractResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Msg/VerifyContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeContract(ctx context.Context, in *MsgFreezeContract, opts ...grpc.CallOption) (*MsgFreezeContractResponse, error) {
+	out := new(MsgFreezeContractResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Msg/FreezeContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeContract(ctx context.Context, in *MsgUnfreezeContract, opts ...grpc.CallOption) (*MsgUnfreezeContractResponse, error) {
+	out := new(MsgUnfreezeContractResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Msg/UnfreezeContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSponsorship(ctx context.Context, in *MsgSetSponsorship, opts ...grpc.CallOption) (*MsgSetSponsorshipResponse, error) {
+	out := new(MsgSetSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Msg/SetSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeSponsorship(ctx context.Context, in *MsgRevokeSponsorship, opts ...grpc.CallOption) (*MsgRevokeSponsorshipResponse, error) {
+	out := new(MsgRevokeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Msg/RevokeSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleCall(ctx context.Context, in *MsgScheduleCall, opts ...grpc.CallOption) (*MsgScheduleCallResponse, error) {
+	out := new(MsgScheduleCallResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Msg/ScheduleCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledCall(ctx context.Context, in *MsgCancelScheduledCall, opts ...grpc.CallOption) (*MsgCancelScheduledCallResponse, error) {
+	out := new(MsgCancelScheduledCallResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Msg/CancelScheduledCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MultiCall(ctx context.Context, in *MsgMultiCall, opts ...grpc.CallOption) (*MsgMultiCallResponse, error) {
+	out := new(MsgMultiCallResponse)
+	err := c.cc.Invoke(ctx, "/shentu.cvm.v1alpha1.Msg/MultiCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	Call(context.Context, *MsgCall) (*MsgCallResponse, error)
+	Deploy(context.Context, *MsgDeploy) (*MsgDeployResponse, error)
+	Migrate(context.Context, *MsgMigrate) (*MsgMigrateResponse, error)
+	VerifyContract(context.Context, *MsgVerifyContract) (*MsgVerifyContractResponse, error)
+	FreezeContract(context.Context, *MsgFreezeContract) (*MsgFreezeContractResponse, error)
+	UnfreezeContract(context.Context, *MsgUnfreezeContract) (*MsgUnfreezeContractResponse, error)
+	SetSponsorship(context.Context, *MsgSetSponsorship) (*MsgSetSponsorshipResponse, error)
+	RevokeSponsorship(context.Context, *MsgRevokeSponsorship) (*MsgRevokeSponsorshipResponse, error)
+	ScheduleCall(context.Context, *MsgScheduleCall) (*MsgScheduleCallResponse, error)
+	CancelScheduledCall(context.Context, *MsgCancelScheduledCall) (*MsgCancelScheduledCallResponse, error)
+	MultiCall(context.Context, *MsgMultiCall) (*MsgMultiCallResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Call(ctx context.Context, req *MsgCall) (*MsgCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
+func (*UnimplementedMsgServer) Deploy(ctx context.Context, req *MsgDeploy) (*MsgDeployResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deploy not implemented")
+}
+func (*UnimplementedMsgServer) Migrate(ctx context.Context, req *MsgMigrate) (*MsgMigrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Migrate not implemented")
+}
+func (*UnimplementedMsgServer) VerifyContract(ctx context.Context, req *MsgVerifyContract) (*MsgVerifyContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyContract not implemented")
+}
+func (*UnimplementedMsgServer) FreezeContract(ctx context.Context, req *MsgFreezeContract) (*MsgFreezeContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeContract not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeContract(ctx context.Context, req *MsgUnfreezeContract) (*MsgUnfreezeContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeContract not implemented")
+}
+func (*UnimplementedMsgServer) SetSponsorship(ctx context.Context, req *MsgSetSponsorship) (*MsgSetSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) RevokeSponsorship(ctx context.Context, req *MsgRevokeSponsorship) (*MsgRevokeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleCall(ctx context.Context, req *MsgScheduleCall) (*MsgScheduleCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCall not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledCall(ctx context.Context, req *MsgCancelScheduledCall) (*MsgCancelScheduledCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledCall not implemented")
+}
+func (*UnimplementedMsgServer) MultiCall(ctx context.Context, req *MsgMultiCall) (*MsgMultiCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiCall not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Msg/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Call(ctx, req.(*MsgCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Deploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeploy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Deploy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Msg/Deploy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deploy(ctx, req.(*MsgDeploy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Migrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Msg/Migrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Migrate(ctx, req.(*MsgMigrate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VerifyContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifyContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VerifyContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Msg/VerifyContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifyContract(ctx, req.(*MsgVerifyContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Msg/FreezeContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeContract(ctx, req.(*MsgFreezeContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Msg/UnfreezeContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeContract(ctx, req.(*MsgUnfreezeContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Msg/SetSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSponsorship(ctx, req.(*MsgSetSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Msg/RevokeSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeSponsorship(ctx, req.(*MsgRevokeSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Msg/ScheduleCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleCall(ctx, req.(*MsgScheduleCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Msg/CancelScheduledCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledCall(ctx, req.(*MsgCancelScheduledCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MultiCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.cvm.v1alpha1.Msg/MultiCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiCall(ctx, req.(*MsgMultiCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "shentu.cvm.v1alpha1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Call",
+			Handler:    _Msg_Call_Handler,
+		},
+		{
+			MethodName: "Deploy",
+			Handler:    _Msg_Deploy_Handler,
+		},
+		{
+			MethodName: "Migrate",
+			Handler:    _Msg_Migrate_Handler,
+		},
+		{
+			MethodName: "VerifyContract",
+			Handler:    _Msg_VerifyContract_Handler,
+		},
+		{
+			MethodName: "FreezeContract",
+			Handler:    _Msg_FreezeContract_Handler,
+		},
+		{
+			MethodName: "UnfreezeContract",
+			Handler:    _Msg_UnfreezeContract_Handler,
+		},
+		{
+			MethodName: "SetSponsorship",
+			Handler:    _Msg_SetSponsorship_Handler,
+		},
+		{
+			MethodName: "RevokeSponsorship",
+			Handler:    _Msg_RevokeSponsorship_Handler,
+		},
+		{
+			MethodName: "ScheduleCall",
+			Handler:    _Msg_ScheduleCall_Handler,
+		},
+		{
+			MethodName: "CancelScheduledCall",
+			Handler:    _Msg_CancelScheduledCall_Handler,
+		},
+		{
+			MethodName: "MultiCall",
+			Handler:    _Msg_MultiCall_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shentu/cvm/v1alpha1/tx.proto",
+}
+
+func (m *MsgCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AcceptRisk {
+		i--
+		if m.AcceptRisk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Value != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Callee) > 0 {
+		i -= len(m.Callee)
+		copy(dAtA[i:], m.Callee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Callee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RevertData) > 0 {
+		i -= len(m.RevertData)
+		copy(dAtA[i:], m.RevertData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RevertData)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeploy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeploy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeploy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.IsRuntime {
+		i--
+		if m.IsRuntime {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IsEWASM {
+		i--
+		if m.IsEWASM {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Meta) > 0 {
+		for iNdEx := len(m.Meta) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Meta[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Value != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeployResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeployResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeployResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Meta) > 0 {
+		for iNdEx := len(m.Meta) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Meta[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CertificateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CertificateId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CompilerSettings) > 0 {
+		i -= len(m.CompilerSettings)
+		copy(dAtA[i:], m.CompilerSettings)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CompilerSettings)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CompilerVersion) > 0 {
+		i -= len(m.CompilerVersion)
+		copy(dAtA[i:], m.CompilerVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CompilerVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceFiles) > 0 {
+		for iNdEx := len(m.SourceFiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceFiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Certifier) > 0 {
+		i -= len(m.Certifier)
+		copy(dAtA[i:], m.Certifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Certifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Certifier) > 0 {
+		i -= len(m.Certifier)
+		copy(dAtA[i:], m.Certifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Certifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Selectors[iNdEx])
+			copy(dAtA[i:], m.Selectors[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Selectors[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DailyCap) > 0 {
+		for iNdEx := len(m.DailyCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PrepaidFee) > 0 {
+		for iNdEx := len(m.PrepaidFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrepaidFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.PrepaidGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PrepaidGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxRuns != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxRuns))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Callee) > 0 {
+		i -= len(m.Callee)
+		copy(dAtA[i:], m.Callee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Callee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AcceptRisk {
+		i--
+		if m.AcceptRisk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Atomic {
+		i--
+		if m.Atomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiCallEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiCallEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiCallEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Value != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Callee) > 0 {
+		i -= len(m.Callee)
+		copy(dAtA[i:], m.Callee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Callee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiCallResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiCallResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiCallResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failure != nil {
+		{
+			size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Callee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovTx(uint64(m.Value))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AcceptRisk {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *CallFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTx(uint64(m.Code))
+	}
+	l = len(m.RevertData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeploy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovTx(uint64(m.Value))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Meta) > 0 {
+		for _, e := range m.Meta {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.IsEWASM {
+		n += 2
+	}
+	if m.IsRuntime {
+		n += 2
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeployResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Meta) > 0 {
+		for _, e := range m.Meta {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMigrateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVerifyContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SourceFiles) > 0 {
+		for _, e := range m.SourceFiles {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.CompilerVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CompilerSettings)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CertificateId != 0 {
+		n += 1 + sovTx(uint64(m.CertificateId))
+	}
+	return n
+}
+
+func (m *MsgVerifyContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezeContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Certifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Certifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DailyCap) > 0 {
+		for _, e := range m.DailyCap {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Callee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.MaxRuns != 0 {
+		n += 1 + sovTx(uint64(m.MaxRuns))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	if m.PrepaidGas != 0 {
+		n += 1 + sovTx(uint64(m.PrepaidGas))
+	}
+	if len(m.PrepaidFee) > 0 {
+		for _, e := range m.PrepaidFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMultiCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Atomic {
+		n += 2
+	}
+	if m.AcceptRisk {
+		n += 2
+	}
+	return n
+}
+
+func (m *MultiCallEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Callee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovTx(uint64(m.Value))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMultiCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiCallResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptRisk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AcceptRisk = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevertData = append(m.RevertData[:0], dAtA[iNdEx:postIndex]...)
+			if m.RevertData == nil {
+				m.RevertData = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeploy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeploy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeploy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = append(m.Meta, &payload.ContractMeta{})
+			if err := m.Meta[len(m.Meta)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEWASM", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEWASM = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRuntime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRuntime = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeployResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeployResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeployResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = append(m.Meta, &payload.ContractMeta{})
+			if err := m.Meta[len(m.Meta)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVerifyContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifyContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifyContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceFiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceFiles = append(m.SourceFiles, SourceFile{})
+			if err := m.SourceFiles[len(m.SourceFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompilerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompilerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompilerSettings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompilerSettings = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateId", wireType)
+			}
+			m.CertificateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CertificateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVerifyContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifyContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifyContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyCap = append(m.DailyCap, types.Coin{})
+			if err := m.DailyCap[len(m.DailyCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
+			}
+			m.MaxRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRuns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidGas", wireType)
+			}
+			m.PrepaidGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrepaidGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrepaidFee = append(m.PrepaidFee, types.Coin{})
+			if err := m.PrepaidFee[len(m.PrepaidFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.IsEWASM {
-		n += 2
-	}
-	if m.IsRuntime {
-		n += 2
-	}
-	return n
-}
 
-func (m *MsgDeployResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCall) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -764,17 +6163,17 @@ func (m *MsgCall) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCall: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCall: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -784,27 +6183,67 @@ func (m *MsgCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Caller = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -832,13 +6271,13 @@ func (m *MsgCall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Callee = string(dAtA[iNdEx:postIndex])
+			m.Caller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Value = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -848,45 +6287,64 @@ func (m *MsgCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -911,7 +6369,7 @@ func (m *MsgCall) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCallResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMultiCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -934,17 +6392,17 @@ func (m *MsgCallResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCallResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMultiCall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMultiCall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -954,26 +6412,98 @@ func (m *MsgCallResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = append(m.Calls, MultiCallEntry{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Atomic = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptRisk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AcceptRisk = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -998,7 +6528,7 @@ func (m *MsgCallResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeploy) Unmarshal(dAtA []byte) error {
+func (m *MultiCallEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1021,15 +6551,15 @@ func (m *MsgDeploy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeploy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiCallEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeploy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiCallEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1057,7 +6587,7 @@ func (m *MsgDeploy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Caller = string(dAtA[iNdEx:postIndex])
+			m.Callee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -1080,7 +6610,7 @@ func (m *MsgDeploy) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1107,46 +6637,67 @@ func (m *MsgDeploy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Abi = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1173,51 +6724,11 @@ func (m *MsgDeploy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = append(m.Meta, &payload.ContractMeta{})
-			if err := m.Meta[len(m.Meta)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, MultiCallResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsEWASM", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsEWASM = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRuntime", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsRuntime = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1242,7 +6753,7 @@ func (m *MsgDeploy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeployResponse) Unmarshal(dAtA []byte) error {
+func (m *MultiCallResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1265,10 +6776,10 @@ func (m *MsgDeployResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeployResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiCallResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeployResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiCallResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1305,6 +6816,42 @@ func (m *MsgDeployResponse) Unmarshal(dAtA []byte) error {
 				m.Result = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failure == nil {
+				m.Failure = &CallFailure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
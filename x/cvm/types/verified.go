@@ -0,0 +1,63 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// CodeHash returns the hex encoded Keccak-256 hash of contract code, as the compilation
+// certificates of the code record it.
+func CodeHash(code []byte) string {
+	return hex.EncodeToString(crypto.Keccak256(code))
+}
+
+// MatchCodeHash returns whether a bytecode hash of a certificate is the given code hash. The
+// bytecode hash may be upper case and prefixed with 0x.
+func MatchCodeHash(bytecodeHash, codeHash string) bool {
+	bytecodeHash = strings.ToLower(bytecodeHash)
+	return strings.TrimPrefix(bytecodeHash, "0x") == codeHash
+}
+
+// ValidateSource runs stateless checks on the source code of a verified contract.
+func ValidateSource(sourceFiles []SourceFile, compilerVersion, compilerSettings string) error {
+	if len(sourceFiles) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no source files")
+	}
+	names := make(map[string]bool)
+	for _, file := range sourceFiles {
+		if file.Name == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty source file name")
+		}
+		if names[file.Name] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate source file %s", file.Name)
+		}
+		names[file.Name] = true
+	}
+	if compilerVersion == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty compiler version")
+	}
+	if compilerSettings != "" && !json.Valid([]byte(compilerSettings)) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "compiler settings are not valid JSON")
+	}
+	return nil
+}
+
+// Validate runs stateless checks on a verified contract.
+func (v VerifiedContract) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(v.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, v.Address)
+	}
+	if _, err := sdk.AccAddressFromBech32(v.Submitter); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, v.Submitter)
+	}
+	if hash, err := hex.DecodeString(v.CodeHash); err != nil || len(hash) != 32 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid code hash %s", v.CodeHash)
+	}
+	return ValidateSource(v.SourceFiles, v.CompilerVersion, v.CompilerSettings)
+}